@@ -0,0 +1,17 @@
+resource "prefect_automation" "alerts" {
+  # ...
+
+  lifecycle {
+    ignore_changes = [enabled]
+  }
+}
+
+# Silence an Automation during maintenance.
+#
+# Run it on demand with:
+#   terraform apply -invoke=action.prefect_automation_disable.alerts
+action "prefect_automation_disable" "alerts" {
+  config {
+    id = prefect_automation.alerts.id
+  }
+}
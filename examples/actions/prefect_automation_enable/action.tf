@@ -0,0 +1,9 @@
+# Enable an Automation once maintenance is over.
+#
+# Run it on demand with:
+#   terraform apply -invoke=action.prefect_automation_enable.alerts
+action "prefect_automation_enable" "alerts" {
+  config {
+    id = prefect_automation.alerts.id
+  }
+}
@@ -0,0 +1,19 @@
+# Pause the schedules of a Deployment every time its
+# configuration changes, so that the new version can be
+# checked before it runs on a schedule.
+action "prefect_deployment_schedules_pause" "nightly" {
+  config {
+    deployment_id = prefect_deployment.nightly.id
+  }
+}
+
+resource "terraform_data" "nightly_version" {
+  input = prefect_deployment.nightly.version
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.prefect_deployment_schedules_pause.nightly]
+    }
+  }
+}
@@ -0,0 +1,9 @@
+# Resume the schedules of a Deployment once it has been checked.
+#
+# Run it on demand with:
+#   terraform apply -invoke=action.prefect_deployment_schedules_resume.nightly
+action "prefect_deployment_schedules_resume" "nightly" {
+  config {
+    deployment_id = prefect_deployment.nightly.id
+  }
+}
@@ -0,0 +1,18 @@
+resource "prefect_global_concurrency_limit" "database" {
+  name  = "database-connections"
+  limit = 10
+
+  lifecycle {
+    ignore_changes = [active_slots]
+  }
+}
+
+# Release the slots left behind by crashed runs.
+#
+# Run it on demand with:
+#   terraform apply -invoke=action.prefect_global_concurrency_limit_reset.database
+action "prefect_global_concurrency_limit_reset" "database" {
+  config {
+    id = prefect_global_concurrency_limit.database.id
+  }
+}
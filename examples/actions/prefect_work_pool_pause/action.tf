@@ -0,0 +1,11 @@
+# Pause a Work Pool before maintenance, without changing the
+# configuration of the `prefect_work_pool` resource.
+#
+# Run it on demand with:
+#   terraform apply -invoke=action.prefect_work_pool_pause.maintenance
+action "prefect_work_pool_pause" "maintenance" {
+  config {
+    workspace_id = "00000000-0000-0000-0000-000000000000"
+    name         = "kubernetes-pool"
+  }
+}
@@ -0,0 +1,10 @@
+# Resume a Work Pool after maintenance.
+#
+# Run it on demand with:
+#   terraform apply -invoke=action.prefect_work_pool_resume.maintenance
+action "prefect_work_pool_resume" "maintenance" {
+  config {
+    workspace_id = "00000000-0000-0000-0000-000000000000"
+    name         = "kubernetes-pool"
+  }
+}
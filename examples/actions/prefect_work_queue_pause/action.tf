@@ -0,0 +1,11 @@
+# Pause a Work Queue while a database its flows use is migrated.
+#
+# Run it on demand with:
+#   terraform apply -invoke=action.prefect_work_queue_pause.migration
+action "prefect_work_queue_pause" "migration" {
+  config {
+    workspace_id   = "00000000-0000-0000-0000-000000000000"
+    work_pool_name = "kubernetes-pool"
+    name           = "etl"
+  }
+}
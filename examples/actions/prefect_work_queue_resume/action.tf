@@ -0,0 +1,11 @@
+# Resume a Work Queue once the migration is over.
+#
+# Run it on demand with:
+#   terraform apply -invoke=action.prefect_work_queue_resume.migration
+action "prefect_work_queue_resume" "migration" {
+  config {
+    workspace_id   = "00000000-0000-0000-0000-000000000000"
+    work_pool_name = "kubernetes-pool"
+    name           = "etl"
+  }
+}
@@ -0,0 +1,23 @@
+# A Service Account whose API key is only ever minted
+# for the duration of a Terraform run.
+resource "prefect_service_account" "deployer" {
+  name = "ci-deployer"
+}
+
+# Rotate the Service Account's key to mint a key that expires
+# in 30 minutes, and is revoked when the run finishes.
+ephemeral "prefect_service_account_api_key" "deployer" {
+  service_account_id = prefect_service_account.deployer.id
+  ttl                = "30m"
+}
+
+# Pass the key to another provider without storing it in state,
+# for example as a write-only Vault secret.
+resource "vault_kv_secret_v2" "deployer" {
+  mount = "secret"
+  name  = "prefect/ci-deployer"
+  data_json_wo = jsonencode({
+    PREFECT_API_KEY = ephemeral.prefect_service_account_api_key.deployer.key
+  })
+  data_json_wo_version = 1
+}
@@ -0,0 +1,20 @@
+# Mint a User API Key that expires in 15 minutes,
+# and is revoked when the run finishes.
+ephemeral "prefect_user_api_key" "example" {
+  user_id = "00000000-0000-0000-0000-000000000000"
+  name    = "terraform-run"
+  ttl     = "15m"
+}
+
+# Configure another provider with the key, without storing it in state.
+provider "kubernetes" {}
+
+resource "kubernetes_secret_v1" "prefect" {
+  metadata {
+    name = "prefect-api-key"
+  }
+  data_wo = {
+    PREFECT_API_KEY = ephemeral.prefect_user_api_key.example.key
+  }
+  data_wo_revision = 1
+}
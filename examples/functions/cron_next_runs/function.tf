@@ -0,0 +1,16 @@
+locals {
+  cron     = "0 9 * * 1-5"
+  timezone = "America/New_York"
+}
+
+# Preview the next five runs of the schedule,
+# for example with `terraform console`.
+output "next_runs" {
+  value = provider::prefect::cron_next_runs(local.cron, local.timezone, timestamp(), 5)
+}
+
+resource "prefect_deployment_schedule" "weekdays" {
+  deployment_id = prefect_deployment.example.id
+  cron          = local.cron
+  timezone      = local.timezone
+}
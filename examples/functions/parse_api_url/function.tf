@@ -0,0 +1,14 @@
+variable "prefect_api_url" {
+  type        = string
+  description = "Value of PREFECT_API_URL, such as https://api.prefect.cloud/api/accounts/<account_id>/workspaces/<workspace_id>"
+}
+
+locals {
+  prefect_api = provider::prefect::parse_api_url(var.prefect_api_url)
+}
+
+provider "prefect" {
+  endpoint     = local.prefect_api.endpoint
+  account_id   = local.prefect_api.account_id
+  workspace_id = local.prefect_api.workspace_id
+}
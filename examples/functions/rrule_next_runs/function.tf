@@ -0,0 +1,15 @@
+locals {
+  rrule = "FREQ=WEEKLY;BYDAY=MO,WE,FR;BYHOUR=9;BYMINUTE=0;BYSECOND=0"
+}
+
+# Preview the next five runs of the schedule,
+# for example with `terraform console`.
+output "next_runs" {
+  value = provider::prefect::rrule_next_runs(local.rrule, "Europe/London", "2025-01-01T00:00:00Z", 5)
+}
+
+resource "prefect_deployment_schedule" "mon_wed_fri" {
+  deployment_id = prefect_deployment.example.id
+  rrule         = local.rrule
+  timezone      = "Europe/London"
+}
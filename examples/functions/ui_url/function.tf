@@ -0,0 +1,20 @@
+resource "prefect_deployment" "example" {
+  name    = "example"
+  flow_id = prefect_flow.example.id
+}
+
+# Prefect Cloud
+output "deployment_url" {
+  value = provider::prefect::ui_url(
+    "https://api.prefect.cloud",
+    var.account_id,
+    var.workspace_id,
+    "deployment",
+    prefect_deployment.example.id,
+  )
+}
+
+# Prefect server
+output "work_pool_url" {
+  value = provider::prefect::ui_url("http://localhost:4200/api", null, null, "work_pool", prefect_work_pool.example.name)
+}
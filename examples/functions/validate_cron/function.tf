@@ -0,0 +1,9 @@
+variable "schedule" {
+  type        = string
+  description = "Cron schedule for the deployment"
+
+  validation {
+    condition     = provider::prefect::validate_cron(var.schedule)
+    error_message = "The schedule must be a valid cron expression, such as \"0 9 * * 1-5\"."
+  }
+}
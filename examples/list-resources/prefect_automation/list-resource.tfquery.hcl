@@ -0,0 +1,10 @@
+# Discover the Automations to import with `terraform query`.
+# `terraform query -generate-config-out=generated.tf` writes
+# an import block and configuration for each of them.
+list "prefect_automation" "all" {
+  provider = prefect
+
+  config {
+    workspace_id = "00000000-0000-0000-0000-000000000000"
+  }
+}
@@ -0,0 +1,11 @@
+# Discover the Work Queues to import with `terraform query`.
+# `terraform query -generate-config-out=generated.tf` writes
+# an import block and configuration for each of them.
+list "prefect_work_queue" "all" {
+  provider = prefect
+
+  config {
+    workspace_id   = "00000000-0000-0000-0000-000000000000"
+    work_pool_name = "my-work-pool"
+  }
+}
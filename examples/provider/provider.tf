@@ -40,3 +40,27 @@ provider "prefect" {
 provider "prefect" {
   endpoint = "http://localhost:4200"
 }
+
+# A self-hosted Prefect server behind an internal gateway may require
+# a private CA, a client certificate and extra routing headers.
+provider "prefect" {
+  endpoint         = "https://prefect.internal/api"
+  basic_auth_key   = var.prefect_basic_auth_key
+  ca_cert_file     = "/etc/ssl/certs/internal-ca.pem"
+  client_cert_file = "/etc/prefect/client.crt"
+  client_key_file  = "/etc/prefect/client.key"
+
+  extra_headers = {
+    "X-Gateway-Route" = "prefect"
+  }
+}
+
+# Prefect Cloud reached through a private-link hostname is not
+# detected from the endpoint, so list the hostname in `cloud_hosts`,
+# or set `mode = "cloud"` explicitly.
+provider "prefect" {
+  endpoint    = "https://prefect.privatelink.example.com/api"
+  cloud_hosts = ["prefect.privatelink.example.com"]
+  api_key     = var.prefect_api_key
+  account_id  = var.prefect_account_id
+}
@@ -0,0 +1,6 @@
+import {
+  to = prefect_account_member.example
+  identity = {
+    email = "marvin@prefect.io"
+  }
+}
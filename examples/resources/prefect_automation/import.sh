@@ -3,4 +3,6 @@ terraform import prefect_automation.my_automation 00000000-0000-0000-0000-000000
 #
 # or from a different workspace via automation_id,workspace_id
 terraform import prefect_automation.my_automation 00000000-0000-0000-0000-000000000000,11111111-1111-1111-1111-111111111111
-
+#
+# or from a link to the automation in the Prefect UI
+terraform import prefect_automation.my_automation https://app.prefect.cloud/account/00000000-0000-0000-0000-000000000000/workspace/11111111-1111-1111-1111-111111111111/automations/automation/22222222-2222-2222-2222-222222222222
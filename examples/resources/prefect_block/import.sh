@@ -3,4 +3,6 @@ terraform import prefect_block.my_block 00000000-0000-0000-0000-000000000000
 #
 # or from a different workspace via block_id,workspace_id
 terraform import prefect_block.my_block 00000000-0000-0000-0000-000000000000,11111111-1111-1111-1111-111111111111
-
+#
+# or from a link to the block in the Prefect UI
+terraform import prefect_block.my_block https://app.prefect.cloud/account/00000000-0000-0000-0000-000000000000/workspace/11111111-1111-1111-1111-111111111111/blocks/block/22222222-2222-2222-2222-222222222222
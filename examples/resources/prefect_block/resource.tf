@@ -69,3 +69,17 @@ resource "prefect_block" "my_dbt_run_operation_block" {
     "dbt_cli_profile" = { "$ref" : { "block_document_id" : prefect_block.my_dbt_cli_profile.id } }
   })
 }
+
+# example:
+# use the write-only `data_wo` attribute (Terraform 1.11 and later)
+# to keep secrets out of Terraform state. As the value is not stored,
+# increment `data_wo_version` whenever it changes.
+resource "prefect_block" "database_password" {
+  name      = "database-password"
+  type_slug = "secret"
+
+  data_wo = jsonencode({
+    "value" = var.database_password
+  })
+  data_wo_version = 1
+}
@@ -0,0 +1,7 @@
+import {
+  to = prefect_deployment.example
+  identity = {
+    id           = "00000000-0000-0000-0000-000000000000"
+    workspace_id = "11111111-1111-1111-1111-111111111111"
+  }
+}
@@ -3,3 +3,11 @@ terraform import prefect_deployment.example 00000000-0000-0000-0000-000000000000
 
 # or from a different workspace via deployment_id,workspace_id
 terraform import prefect_deployment.example 00000000-0000-0000-0000-000000000000,00000000-0000-0000-0000-000000000000
+
+# or from a link to the deployment in the Prefect UI
+terraform import prefect_deployment.example https://app.prefect.cloud/account/00000000-0000-0000-0000-000000000000/workspace/11111111-1111-1111-1111-111111111111/deployments/deployment/22222222-2222-2222-2222-222222222222
+
+# or by name, from the provider's workspace via flow_name/deployment_name,
+# or from a different workspace via workspace_handle/flow_name/deployment_name
+terraform import prefect_deployment.example my-flow/my-deployment
+terraform import prefect_deployment.example my-workspace/my-flow/my-deployment
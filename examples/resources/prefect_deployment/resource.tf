@@ -71,11 +71,64 @@ resource "prefect_deployment" "deployment" {
       requires = "prefect-aws>=0.3.4"
       bucket   = "some-bucket",
       folder   = "some-folder",
+    },
+    {
+      type              = "pip_install_requirements"
+      requirements_file = "requirements.txt"
+      stream_output     = true
+    },
+    {
+      type   = "run_shell_script"
+      id     = "get-commit-hash"
+      script = "git rev-parse --short HEAD"
+      env = {
+        "GIT_DIR" = ".git"
+      }
+    },
+    {
+      # Steps from other collections, such as private ones, are set with their
+      # fully-qualified function name and their arguments.
+      type     = "custom"
+      function = "my_collection.deployments.steps.pull_from_artifactory"
+      args = jsonencode({
+        "repository" : "flows",
+        "version" : "{{ get-commit-hash.stdout }}"
+      })
     }
   ]
+  # Run the same deployment hourly and nightly with different parameters.
+  # Schedules not listed here, such as ones added in the UI, are deleted.
+  schedules = [
+    {
+      slug       = "hourly"
+      interval   = 3600
+      parameters = jsonencode({ "mode" : "incremental" })
+    },
+    {
+      slug       = "nightly"
+      cron       = "0 0 * * *"
+      timezone   = "America/New_York"
+      parameters = jsonencode({ "mode" : "full" })
+    },
+  ]
   storage_document_id = prefect_block.test_gh_repository.id
-  version             = "v1.1.1"
-  work_pool_name      = "some-testing-pool"
-  work_queue_name     = "default"
+  # Run the deployment when new data lands, as an automation owned by the
+  # deployment, which is deleted with it.
+  triggers = [
+    {
+      name = "on-new-data"
+      event = {
+        posture = "Reactive"
+        expect  = ["s3.object.created"]
+        match = jsonencode({
+          "prefect.resource.id" : "s3.bucket.my-bucket"
+        })
+      }
+      parameters = jsonencode({ "mode" : "incremental" })
+    },
+  ]
+  version         = "v1.1.1"
+  work_pool_name  = "some-testing-pool"
+  work_queue_name = "default"
 }
 
@@ -0,0 +1,8 @@
+import {
+  to = prefect_deployment_schedule.example
+  identity = {
+    deployment_id = "00000000-0000-0000-0000-000000000000"
+    id            = "22222222-2222-2222-2222-222222222222"
+    workspace_id  = "11111111-1111-1111-1111-111111111111"
+  }
+}
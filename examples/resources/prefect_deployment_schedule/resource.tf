@@ -42,6 +42,11 @@ resource "prefect_deployment_schedule" "test_cron" {
   # Cron-specific fields
   cron   = "0 0 * * *"
   day_or = true
+
+  # Run the flow runs of this schedule with different parameters
+  # than the deployment.
+  slug       = "nightly"
+  parameters = jsonencode({ "mode" : "full" })
 }
 
 resource "prefect_deployment_schedule" "test_rrule" {
@@ -3,3 +3,6 @@ terraform import prefect_flow.example 00000000-0000-0000-0000-000000000000
 
 # or from a different workspace via flow_id,workspace_id
 terraform import prefect_flow.example 00000000-0000-0000-0000-000000000000,00000000-0000-0000-0000-000000000000
+
+# or from a link to the flow in the Prefect UI
+terraform import prefect_flow.example https://app.prefect.cloud/account/00000000-0000-0000-0000-000000000000/workspace/11111111-1111-1111-1111-111111111111/flows/flow/22222222-2222-2222-2222-222222222222
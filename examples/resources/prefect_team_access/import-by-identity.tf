@@ -0,0 +1,8 @@
+import {
+  to = prefect_team_access.example
+  identity = {
+    member_actor_id = "33333333-3333-3333-3333-333333333333"
+    member_id       = "22222222-2222-2222-2222-222222222222"
+    team_id         = "00000000-0000-0000-0000-000000000000"
+  }
+}
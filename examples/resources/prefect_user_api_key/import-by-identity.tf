@@ -0,0 +1,7 @@
+import {
+  to = prefect_user_api_key.example
+  identity = {
+    id      = "00000000-0000-0000-0000-000000000000"
+    user_id = "22222222-2222-2222-2222-222222222222"
+  }
+}
@@ -2,3 +2,12 @@ resource "prefect_variable" "example" {
   name  = "my_variable_name"
   value = "variable value goes here"
 }
+
+# Use the write-only `value_wo` attribute (Terraform 1.11 and later)
+# to keep the value out of Terraform state. As the value is not stored,
+# increment `value_wo_version` whenever it changes.
+resource "prefect_variable" "write_only" {
+  name             = "my_write_only_variable"
+  value_wo         = "variable value goes here"
+  value_wo_version = 1
+}
@@ -3,3 +3,6 @@ terraform import prefect_work_pool.example kubernetes-work-pool
 
 # or from a different workspace via name,workspace_id
 terraform import prefect_work_pool.example kubernetes-work-pool,00000000-0000-0000-0000-000000000000
+
+# or from a link to the work pool in the Prefect UI
+terraform import prefect_work_pool.example https://app.prefect.cloud/account/00000000-0000-0000-0000-000000000000/workspace/11111111-1111-1111-1111-111111111111/work-pools/work-pool/kubernetes-work-pool
@@ -0,0 +1,7 @@
+import {
+  to = prefect_work_pool_access.example
+  identity = {
+    work_pool_name = "my-work-pool"
+    workspace_id   = "11111111-1111-1111-1111-111111111111"
+  }
+}
@@ -0,0 +1,8 @@
+import {
+  to = prefect_work_queue.example
+  identity = {
+    name           = "my-work-queue"
+    work_pool_name = "my-work-pool"
+    workspace_id   = "11111111-1111-1111-1111-111111111111"
+  }
+}
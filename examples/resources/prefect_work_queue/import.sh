@@ -1,2 +1,10 @@
 # Prefect Work Queues can be imported using work_pool_name,work_queue_name,workspace_id
 terraform import prefect_work_queue.example kubernetes-work-pool,my-work-queue,00000000-0000-0000-0000-000000000000
+
+# or from a link to the work queue in the Prefect UI
+terraform import prefect_work_queue.example https://app.prefect.cloud/account/00000000-0000-0000-0000-000000000000/workspace/11111111-1111-1111-1111-111111111111/work-pools/work-pool/kubernetes-work-pool/queue/my-work-queue
+
+# or from the provider's workspace via work_pool_name/work_queue_name,
+# or from a different workspace via workspace_handle/work_pool_name/work_queue_name
+terraform import prefect_work_queue.example kubernetes-work-pool/my-work-queue
+terraform import prefect_work_queue.example my-workspace/kubernetes-work-pool/my-work-queue
@@ -1,4 +1,8 @@
 resource "prefect_workspace" "example" {
   name   = "My Workspace"
   handle = "my-workspace"
+
+  # Deleting a workspace deletes every object in it,
+  # so prevent it from being destroyed by accident.
+  deletion_protection = true
 }
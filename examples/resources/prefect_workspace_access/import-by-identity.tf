@@ -0,0 +1,8 @@
+import {
+  to = prefect_workspace_access.example
+  identity = {
+    accessor_type = "USER"
+    id            = "00000000-0000-0000-0000-000000000000"
+    workspace_id  = "11111111-1111-1111-1111-111111111111"
+  }
+}
@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"iter"
 	"time"
 
 	"github.com/google/uuid"
@@ -9,6 +10,7 @@ import (
 
 type AccountMembershipsClient interface {
 	List(ctx context.Context, emails []string) ([]*AccountMembership, error)
+	Iterate(ctx context.Context, emails []string, opts ListOptions) iter.Seq2[*AccountMembership, error]
 	Update(ctx context.Context, accountMembershipID uuid.UUID, payload *AccountMembershipUpdate) error
 	Delete(ctx context.Context, accountMembershipID uuid.UUID) error
 }
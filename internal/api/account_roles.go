@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"iter"
 
 	"github.com/google/uuid"
 )
@@ -9,6 +10,7 @@ import (
 type AccountRolesClient interface {
 	Get(ctx context.Context, roleID uuid.UUID) (*AccountRole, error)
 	List(ctx context.Context, roleNames []string) ([]*AccountRole, error)
+	Iterate(ctx context.Context, roleNames []string, opts ListOptions) iter.Seq2[*AccountRole, error]
 }
 
 // AccountRole is a representation of an account role.
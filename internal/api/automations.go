@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"iter"
 
 	"github.com/google/uuid"
 )
@@ -9,9 +10,13 @@ import (
 // AutomationsClient is a client for working with automations.
 type AutomationsClient interface {
 	Get(ctx context.Context, id uuid.UUID) (*Automation, error)
+	List(ctx context.Context) ([]*Automation, error)
+	Iterate(ctx context.Context, opts ListOptions) iter.Seq2[*Automation, error]
 	Create(ctx context.Context, data AutomationUpsert) (*Automation, error)
 	Update(ctx context.Context, id uuid.UUID, data AutomationUpsert) error
 	Delete(ctx context.Context, id uuid.UUID) error
+	ListRelatedTo(ctx context.Context, resourceID string) ([]*Automation, error)
+	DeleteOwnedBy(ctx context.Context, resourceID string) error
 }
 
 // Automation represents an automation response.
@@ -31,6 +36,10 @@ type AutomationUpsert struct {
 	Actions          []Action `json:"actions"`
 	ActionsOnTrigger []Action `json:"actions_on_trigger"`
 	ActionsOnResolve []Action `json:"actions_on_resolve"`
+
+	// OwnerResource is the ID of the resource that owns the automation, such
+	// as `prefect.deployment.<id>` for the triggers of a deployment.
+	OwnerResource *string `json:"owner_resource,omitempty"`
 }
 
 // Trigger defines the triggering conditions on an Automation.
@@ -2,13 +2,17 @@ package api
 
 import (
 	"context"
+	"iter"
 
 	"github.com/google/uuid"
 )
 
 type BlockDocumentClient interface {
 	Get(ctx context.Context, id uuid.UUID) (*BlockDocument, error)
+	GetWithoutSecrets(ctx context.Context, id uuid.UUID) (*BlockDocument, error)
 	GetByName(ctx context.Context, typeSlug, name string) (*BlockDocument, error)
+	List(ctx context.Context, typeSlugs []string) ([]*BlockDocument, error)
+	Iterate(ctx context.Context, typeSlugs []string, opts ListOptions) iter.Seq2[*BlockDocument, error]
 	Create(ctx context.Context, payload BlockDocumentCreate) (*BlockDocument, error)
 	Update(ctx context.Context, id uuid.UUID, payload BlockDocumentUpdate) error
 	Delete(ctx context.Context, id uuid.UUID) error
@@ -63,3 +67,21 @@ type BlockDocumentAccess struct {
 	ManageActors []ObjectActorAccess `json:"manage_actors"`
 	ViewActors   []ObjectActorAccess `json:"view_actors"`
 }
+
+// BlockDocumentFilter defines the search filter payload
+// when searching for named block documents by block type.
+// example request payload:
+// {"block_documents": {"is_anonymous": {"eq_": false}}, "block_types": {"slug": {"any_": ["secret"]}}, "include_secrets": false}.
+type BlockDocumentFilter struct {
+	BlockDocuments struct {
+		IsAnonymous struct {
+			Eq bool `json:"eq_"`
+		} `json:"is_anonymous"`
+	} `json:"block_documents"`
+	BlockTypes struct {
+		Slug struct {
+			Any []string `json:"any_"`
+		} `json:"slug"`
+	} `json:"block_types"`
+	IncludeSecrets bool `json:"include_secrets"`
+}
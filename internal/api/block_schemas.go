@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"iter"
 
 	"github.com/google/uuid"
 )
@@ -9,6 +10,7 @@ import (
 // BlockSchemaClient is a client for working with block schemas.
 type BlockSchemaClient interface {
 	List(ctx context.Context, blockTypeIDs []uuid.UUID) ([]*BlockSchema, error)
+	Iterate(ctx context.Context, blockTypeIDs []uuid.UUID, opts ListOptions) iter.Seq2[*BlockSchema, error]
 }
 
 // BlockSchema is a representation of a block schema.
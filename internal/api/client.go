@@ -8,6 +8,10 @@ import "github.com/google/uuid"
 type PrefectClient interface {
 	// Utility methods on the Client interface
 	GetEndpointHost() string
+	ServerInfo() ServerInfo
+	AdoptExisting() bool
+	DefaultAccountID() uuid.UUID
+	DefaultWorkspaceID() uuid.UUID
 
 	// API Client Factories - for instantiating a client for each API resource
 	Accounts(accountID uuid.UUID) (AccountsClient, error)
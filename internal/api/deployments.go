@@ -2,6 +2,12 @@ package api
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"reflect"
+	"slices"
+	"strings"
 
 	"github.com/google/uuid"
 )
@@ -11,6 +17,8 @@ type DeploymentsClient interface {
 	Create(ctx context.Context, data DeploymentCreate) (*Deployment, error)
 	Get(ctx context.Context, deploymentID uuid.UUID) (*Deployment, error)
 	GetByName(ctx context.Context, flowName, deploymentName string) (*Deployment, error)
+	List(ctx context.Context, flowIDs []uuid.UUID) ([]*Deployment, error)
+	Iterate(ctx context.Context, flowIDs []uuid.UUID, opts ListOptions) iter.Seq2[*Deployment, error]
 	Update(ctx context.Context, deploymentID uuid.UUID, data DeploymentUpdate) error
 	Delete(ctx context.Context, deploymentID uuid.UUID) error
 }
@@ -36,6 +44,7 @@ type Deployment struct {
 	Path                   string                         `json:"path"`
 	Paused                 bool                           `json:"paused"`
 	PullSteps              []PullStep                     `json:"pull_steps"`
+	Schedules              []*DeploymentSchedule          `json:"schedules"`
 	StorageDocumentID      uuid.UUID                      `json:"storage_document_id"`
 	Tags                   []string                       `json:"tags"`
 	Version                string                         `json:"version"`
@@ -108,6 +117,9 @@ type CurrentGlobalConcurrencyLimit struct {
 
 // PullStepCommon is a representation of the common fields for certain pull steps.
 type PullStepCommon struct {
+	// ID identifies the step, so that later steps can refer to its outputs.
+	ID *string `json:"id,omitempty"`
+
 	// Credentials is the credentials to use for the pull step.
 	// Used on all PullStep types.
 	Credentials *string `json:"credentials,omitempty"`
@@ -131,10 +143,19 @@ type PullStepGitClone struct {
 
 	// IncludeSubmodules determines whether to include submodules when cloning the repository.
 	IncludeSubmodules *bool `json:"include_submodules,omitempty"`
+
+	// CommitSha is the commit to check out. If not provided, the latest commit of the branch is used.
+	CommitSha *string `json:"commit_sha,omitempty"`
+
+	// Directories limits the clone to the given directories, with a sparse checkout.
+	Directories []string `json:"directories,omitzero"`
 }
 
 // PullStepSetWorkingDirectory is a representation of a pull step that sets the working directory.
 type PullStepSetWorkingDirectory struct {
+	// ID identifies the step, so that later steps can refer to its outputs.
+	ID *string `json:"id,omitempty"`
+
 	// The directory to set as the working directory.
 	Directory *string `json:"directory,omitempty"`
 }
@@ -150,11 +171,219 @@ type PullStepPullFrom struct {
 	Folder *string `json:"folder,omitempty"`
 }
 
+// PullStepRunShellScript is a representation of a pull step that runs a shell script.
+type PullStepRunShellScript struct {
+	PullStepCommon
+
+	// The script to run.
+	Script *string `json:"script,omitempty"`
+
+	// The directory to run the script in.
+	Directory *string `json:"directory,omitempty"`
+
+	// Environment variables to set for the script. An empty map is sent as
+	// `{}`, so that it is read back as configured, while nil is omitted.
+	Env map[string]string `json:"env,omitzero"`
+
+	// StreamOutput determines whether to stream the output of the script.
+	StreamOutput *bool `json:"stream_output,omitempty"`
+
+	// ExpandEnvVars determines whether to expand environment variables in the script.
+	ExpandEnvVars *bool `json:"expand_env_vars,omitempty"`
+}
+
+// PullStepPipInstallRequirements is a representation of a pull step that installs
+// the Python packages of a requirements file.
+type PullStepPipInstallRequirements struct {
+	PullStepCommon
+
+	// The requirements file to install.
+	RequirementsFile *string `json:"requirements_file,omitempty"`
+
+	// The directory of the requirements file.
+	Directory *string `json:"directory,omitempty"`
+
+	// StreamOutput determines whether to stream the output of pip.
+	StreamOutput *bool `json:"stream_output,omitempty"`
+}
+
+// PullStepCustom is a representation of any other pull step, such as a step
+// from a private collection, which is the fully-qualified name of a function
+// called with the given arguments.
+type PullStepCustom struct {
+	Function string
+	Args     map[string]any
+}
+
+// Fully-qualified names of the functions of the built-in pull steps.
+const (
+	PullStepFunctionGitClone                 = "prefect.deployments.steps.git_clone"
+	PullStepFunctionSetWorkingDirectory      = "prefect.deployments.steps.set_working_directory"
+	PullStepFunctionRunShellScript           = "prefect.deployments.steps.run_shell_script"
+	PullStepFunctionPipInstallRequirements   = "prefect.deployments.steps.pip_install_requirements"
+	PullStepFunctionPullFromAzureBlobStorage = "prefect_azure.deployments.steps.pull_from_azure_blob_storage"
+	PullStepFunctionPullFromGCS              = "prefect_gcp.deployments.steps.pull_from_gcs"
+	PullStepFunctionPullFromS3               = "prefect_aws.deployments.steps.pull_from_s3"
+)
+
+// BuiltInPullStepFunctions lists the functions of the pull steps that have
+// their own fields in PullStep. Other steps, and built-in steps with
+// arguments that their fields do not model, are decoded as a PullStepCustom.
+// See IsBuiltInPullStep.
+var BuiltInPullStepFunctions = []string{
+	PullStepFunctionGitClone,
+	PullStepFunctionSetWorkingDirectory,
+	PullStepFunctionRunShellScript,
+	PullStepFunctionPipInstallRequirements,
+	PullStepFunctionPullFromAzureBlobStorage,
+	PullStepFunctionPullFromGCS,
+	PullStepFunctionPullFromS3,
+}
+
 // PullStep contains instructions for preparing your flows for a deployment run.
+// Each pull step is a single-key object, mapping the fully-qualified name of
+// the step's function to its arguments.
 type PullStep struct {
-	PullStepGitClone                 *PullStepGitClone            `json:"prefect.deployments.steps.git_clone,omitempty"`
-	PullStepSetWorkingDirectory      *PullStepSetWorkingDirectory `json:"prefect.deployments.steps.set_working_directory,omitempty"`
-	PullStepPullFromAzureBlobStorage *PullStepPullFrom            `json:"prefect_azure.deployments.steps.pull_from_azure_blob_storage,omitempty"`
-	PullStepPullFromGCS              *PullStepPullFrom            `json:"prefect_gcp.deployments.steps.pull_from_gcs,omitempty"`
-	PullStepPullFromS3               *PullStepPullFrom            `json:"prefect_aws.deployments.steps.pull_from_s3,omitempty"`
+	PullStepGitClone                 *PullStepGitClone               `json:"prefect.deployments.steps.git_clone,omitempty"`
+	PullStepSetWorkingDirectory      *PullStepSetWorkingDirectory    `json:"prefect.deployments.steps.set_working_directory,omitempty"`
+	PullStepRunShellScript           *PullStepRunShellScript         `json:"prefect.deployments.steps.run_shell_script,omitempty"`
+	PullStepPipInstallRequirements   *PullStepPipInstallRequirements `json:"prefect.deployments.steps.pip_install_requirements,omitempty"`
+	PullStepPullFromAzureBlobStorage *PullStepPullFrom               `json:"prefect_azure.deployments.steps.pull_from_azure_blob_storage,omitempty"`
+	PullStepPullFromGCS              *PullStepPullFrom               `json:"prefect_gcp.deployments.steps.pull_from_gcs,omitempty"`
+	PullStepPullFromS3               *PullStepPullFrom               `json:"prefect_aws.deployments.steps.pull_from_s3,omitempty"`
+
+	// PullStepCustom holds any other step, so that steps that are not built
+	// in, or that have arguments their fields do not model, are kept as they
+	// are when a deployment is read and updated.
+	PullStepCustom *PullStepCustom `json:"-"`
+}
+
+// builtInPullStepArgs holds the arguments modelled by the fields of each
+// built-in pull step, keyed by the function of the step.
+var builtInPullStepArgs = func() map[string][]string {
+	args := map[string][]string{}
+
+	stepType := reflect.TypeFor[PullStep]()
+	for i := range stepType.NumField() {
+		field := stepType.Field(i)
+
+		function, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if function == "-" || field.Type.Kind() != reflect.Pointer {
+			continue
+		}
+
+		args[function] = jsonFieldNames(field.Type.Elem())
+	}
+
+	return args
+}()
+
+// jsonFieldNames returns the JSON names of the fields of a struct type,
+// including those of its embedded structs.
+func jsonFieldNames(structType reflect.Type) []string {
+	var names []string
+
+	for i := range structType.NumField() {
+		field := structType.Field(i)
+		if field.Anonymous {
+			names = append(names, jsonFieldNames(field.Type)...)
+
+			continue
+		}
+
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		names = append(names, name)
+	}
+
+	return names
+}
+
+// IsBuiltInPullStep reports whether a pull step is decoded into the field of
+// PullStep for its function: the function is built in, and each of its
+// arguments is modelled by a field of the step. Built-in steps with other
+// arguments, such as ones added in newer Prefect versions, are decoded as a
+// PullStepCustom, so that those arguments are not dropped.
+func IsBuiltInPullStep(function string, args map[string]any) bool {
+	fields, ok := builtInPullStepArgs[function]
+	if !ok {
+		return false
+	}
+
+	for arg := range args {
+		if !slices.Contains(fields, arg) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// builtInPullStep has the fields of PullStep, without its JSON methods.
+type builtInPullStep PullStep
+
+// MarshalJSON encodes a custom pull step as an object mapping its function
+// to its arguments, and built-in pull steps according to their fields.
+func (p PullStep) MarshalJSON() ([]byte, error) {
+	if p.PullStepCustom == nil {
+		return json.Marshal(builtInPullStep(p))
+	}
+
+	args := p.PullStepCustom.Args
+	if args == nil {
+		args = map[string]any{}
+	}
+
+	return json.Marshal(map[string]any{p.PullStepCustom.Function: args})
+}
+
+// UnmarshalJSON decodes a pull step, keeping steps that are not built in,
+// or that have arguments their fields do not model, as a PullStepCustom
+// rather than dropping them. See IsBuiltInPullStep.
+func (p *PullStep) UnmarshalJSON(data []byte) error {
+	var steps map[string]json.RawMessage
+	if err := json.Unmarshal(data, &steps); err != nil {
+		return fmt.Errorf("failed to decode pull step: %w", err)
+	}
+
+	for function, rawArgs := range steps {
+		var args map[string]any
+		if err := json.Unmarshal(rawArgs, &args); err != nil {
+			return fmt.Errorf("failed to decode arguments of pull step %q: %w", function, err)
+		}
+
+		if IsBuiltInPullStep(function, args) {
+			continue
+		}
+
+		if args == nil {
+			args = map[string]any{}
+		}
+
+		*p = PullStep{PullStepCustom: &PullStepCustom{Function: function, Args: args}}
+
+		return nil
+	}
+
+	if err := json.Unmarshal(data, (*builtInPullStep)(p)); err != nil {
+		return fmt.Errorf("failed to decode pull step: %w", err)
+	}
+
+	return nil
+}
+
+// DeploymentFilter defines the search filter payload
+// when searching for deployments by flow or by ID.
+// example request payload:
+// {"flows": {"id": {"any_": ["<flow_id>"]}}, "deployments": {"id": {"any_": ["<deployment_id>"]}}}.
+type DeploymentFilter struct {
+	Flows struct {
+		ID struct {
+			Any []uuid.UUID `json:"any_"`
+		} `json:"id"`
+	} `json:"flows"`
+	Deployments struct {
+		ID struct {
+			Any []uuid.UUID `json:"any_"`
+		} `json:"id"`
+	} `json:"deployments"`
 }
@@ -27,6 +27,13 @@ type DeploymentSchedulePayload struct {
 	Active           *bool   `json:"active,omitempty"`
 	MaxScheduledRuns float32 `json:"max_scheduled_runs,omitempty"`
 
+	// Slug identifies the schedule within its deployment.
+	Slug *string `json:"slug,omitempty"`
+
+	// Parameters override the parameters of the deployment for the flow
+	// runs of the schedule. An empty, non-nil map clears them on update.
+	Parameters map[string]any `json:"parameters,omitzero"`
+
 	// Cloud only
 	MaxActiveRuns float32 `json:"max_active_runs,omitempty"`
 	Catchup       bool    `json:"catchup,omitempty"`
@@ -0,0 +1,156 @@
+package api_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+)
+
+func TestPullStepJSON(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		json string
+		want api.PullStep
+	}{
+		{
+			name: "GitCloneWithCredentialsBlock",
+			json: `{"prefect.deployments.steps.git_clone": {"id": "clone", "repository": "https://github.com/org/repo", "credentials": "{{ prefect.blocks.github-credentials.repo }}"}}`,
+			want: api.PullStep{
+				PullStepGitClone: &api.PullStepGitClone{
+					PullStepCommon: api.PullStepCommon{
+						ID:          ptr.To("clone"),
+						Credentials: ptr.To("{{ prefect.blocks.github-credentials.repo }}"),
+					},
+					Repository: ptr.To("https://github.com/org/repo"),
+				},
+			},
+		},
+		{
+			name: "GitCloneWithCommitAndDirectories",
+			json: `{"prefect.deployments.steps.git_clone": {"repository": "https://github.com/org/repo", "commit_sha": "4f2e1c0", "directories": ["flows"]}}`,
+			want: api.PullStep{
+				PullStepGitClone: &api.PullStepGitClone{
+					Repository:  ptr.To("https://github.com/org/repo"),
+					CommitSha:   ptr.To("4f2e1c0"),
+					Directories: []string{"flows"},
+				},
+			},
+		},
+		{
+			name: "GitCloneWithUnmodelledArgs",
+			json: `{"prefect.deployments.steps.git_clone": {"repository": "https://github.com/org/repo", "branch": null, "depth": 1}}`,
+			want: api.PullStep{
+				PullStepCustom: &api.PullStepCustom{
+					Function: api.PullStepFunctionGitClone,
+					Args: map[string]any{
+						"repository": "https://github.com/org/repo",
+						"branch":     nil,
+						"depth":      float64(1),
+					},
+				},
+			},
+		},
+		{
+			name: "RunShellScript",
+			json: `{"prefect.deployments.steps.run_shell_script": {"script": "make build", "directory": "{{ clone.directory }}", "env": {"STAGE": "prod"}, "stream_output": false}}`,
+			want: api.PullStep{
+				PullStepRunShellScript: &api.PullStepRunShellScript{
+					Script:       ptr.To("make build"),
+					Directory:    ptr.To("{{ clone.directory }}"),
+					Env:          map[string]string{"STAGE": "prod"},
+					StreamOutput: ptr.To(false),
+				},
+			},
+		},
+		{
+			name: "RunShellScriptWithEmptyEnv",
+			json: `{"prefect.deployments.steps.run_shell_script": {"script": "make build", "env": {}}}`,
+			want: api.PullStep{
+				PullStepRunShellScript: &api.PullStepRunShellScript{
+					Script: ptr.To("make build"),
+					Env:    map[string]string{},
+				},
+			},
+		},
+		{
+			name: "PipInstallRequirements",
+			json: `{"prefect.deployments.steps.pip_install_requirements": {"requirements_file": "requirements.txt", "stream_output": true}}`,
+			want: api.PullStep{
+				PullStepPipInstallRequirements: &api.PullStepPipInstallRequirements{
+					RequirementsFile: ptr.To("requirements.txt"),
+					StreamOutput:     ptr.To(true),
+				},
+			},
+		},
+		{
+			name: "Custom",
+			json: `{"my_collection.deployments.steps.pull_from_artifactory": {"id": "pull", "repository": "flows", "retries": 3, "options": {"verify": true}}}`,
+			want: api.PullStep{
+				PullStepCustom: &api.PullStepCustom{
+					Function: "my_collection.deployments.steps.pull_from_artifactory",
+					Args: map[string]any{
+						"id":         "pull",
+						"repository": "flows",
+						"retries":    float64(3),
+						"options":    map[string]any{"verify": true},
+					},
+				},
+			},
+		},
+		{
+			name: "CustomWithoutArgs",
+			json: `{"my_collection.deployments.steps.prepare": {}}`,
+			want: api.PullStep{
+				PullStepCustom: &api.PullStepCustom{
+					Function: "my_collection.deployments.steps.prepare",
+					Args:     map[string]any{},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got api.PullStep
+			require.NoError(t, json.Unmarshal([]byte(tt.json), &got))
+			assert.Equal(t, tt.want, got)
+
+			// Encoding the decoded step gives back the step returned by the API.
+			encoded, err := json.Marshal(got)
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.json, string(encoded))
+		})
+	}
+}
+
+func TestIsBuiltInPullStep(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		function string
+		args     map[string]any
+		want     bool
+	}{
+		{name: "modelled arguments", function: api.PullStepFunctionGitClone, args: map[string]any{"repository": "repo", "credentials": "creds"}, want: true},
+		{name: "no arguments", function: api.PullStepFunctionSetWorkingDirectory, want: true},
+		{name: "unmodelled argument", function: api.PullStepFunctionGitClone, args: map[string]any{"repository": "repo", "depth": 1}, want: false},
+		{name: "argument of another step", function: api.PullStepFunctionSetWorkingDirectory, args: map[string]any{"credentials": "creds"}, want: false},
+		{name: "custom function", function: "my_collection.deployments.steps.prepare", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, api.IsBuiltInPullStep(tt.function, tt.args))
+		})
+	}
+}
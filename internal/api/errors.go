@@ -0,0 +1,175 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Sentinel errors that an *APIError matches with errors.Is,
+// based on the status code of the response.
+var (
+	ErrNotFound     = errors.New("not found")
+	ErrConflict     = errors.New("conflict")
+	ErrForbidden    = errors.New("forbidden")
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrValidation   = errors.New("validation error")
+)
+
+// ErrorResponse is the body of a FastAPI request validation error.
+type ErrorResponse struct {
+	Detail []ErrorDetail `json:"detail"`
+}
+
+// ErrorDetail describes a single validation failure.
+// Loc is the path to the offending value, eg. ["body", "job_variables", "image"].
+type ErrorDetail struct {
+	Loc  []string `json:"loc"`
+	Msg  string   `json:"msg"`
+	Type string   `json:"type"`
+}
+
+// UnmarshalJSON decodes an ErrorDetail, converting the list indexes
+// that FastAPI includes in `loc` into strings.
+func (d *ErrorDetail) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Loc  []any  `json:"loc"`
+		Msg  string `json:"msg"`
+		Type string `json:"type"`
+	}
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("failed to unmarshal error detail: %w", err)
+	}
+
+	d.Loc = make([]string, 0, len(raw.Loc))
+	for _, element := range raw.Loc {
+		switch value := element.(type) {
+		case string:
+			d.Loc = append(d.Loc, value)
+		case float64:
+			d.Loc = append(d.Loc, strconv.FormatFloat(value, 'f', -1, 64))
+		default:
+			d.Loc = append(d.Loc, fmt.Sprint(value))
+		}
+	}
+
+	d.Msg = raw.Msg
+	d.Type = raw.Type
+
+	return nil
+}
+
+// Field returns the location of the offending value without the leading
+// request section (body, query, path), eg. ["job_variables", "image"].
+func (d ErrorDetail) Field() []string {
+	if len(d.Loc) > 0 {
+		switch d.Loc[0] {
+		case "body", "query", "path", "header":
+			return d.Loc[1:]
+		}
+	}
+
+	return d.Loc
+}
+
+// APIError is returned by the client when the Prefect API responds
+// with an unexpected status code.
+type APIError struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+
+	// Status is the HTTP status line of the response, eg. "404 Not Found".
+	Status string
+
+	// Message is the `detail` of the response when it is a plain string,
+	// as returned for most non-validation errors.
+	Message string
+
+	// Details are the `detail` entries of the response when it is a
+	// FastAPI validation error.
+	Details []ErrorDetail
+
+	// Body is the raw response body.
+	Body string
+}
+
+// NewAPIError builds an APIError from a response status and body,
+// parsing the FastAPI `detail` field if present.
+func NewAPIError(statusCode int, status string, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: statusCode,
+		Status:     status,
+		Body:       string(body),
+	}
+
+	if apiErr.Status == "" {
+		apiErr.Status = fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode))
+	}
+
+	var response struct {
+		Detail json.RawMessage `json:"detail"`
+	}
+
+	if err := json.Unmarshal(body, &response); err != nil || len(response.Detail) == 0 {
+		return apiErr
+	}
+
+	if err := json.Unmarshal(response.Detail, &apiErr.Message); err == nil {
+		return apiErr
+	}
+
+	_ = json.Unmarshal(response.Detail, &apiErr.Details)
+
+	return apiErr
+}
+
+// Error implements the error interface.
+func (e *APIError) Error() string {
+	return fmt.Sprintf("status code=%s, error=%s", e.Status, e.Body)
+}
+
+// Is reports whether the error matches one of the sentinel errors.
+func (e *APIError) Is(target error) bool {
+	switch {
+	case errors.Is(target, ErrNotFound):
+		return e.StatusCode == http.StatusNotFound
+	case errors.Is(target, ErrConflict):
+		return e.StatusCode == http.StatusConflict
+	case errors.Is(target, ErrForbidden):
+		return e.StatusCode == http.StatusForbidden
+	case errors.Is(target, ErrUnauthorized):
+		return e.StatusCode == http.StatusUnauthorized
+	case errors.Is(target, ErrValidation):
+		return e.StatusCode == http.StatusUnprocessableEntity
+	default:
+		return false
+	}
+}
+
+// Summary returns a human-readable description of the error,
+// preferring the parsed details over the raw body.
+func (e *APIError) Summary() string {
+	if e.Message != "" {
+		return fmt.Sprintf("%s: %s", e.Status, e.Message)
+	}
+
+	if len(e.Details) == 0 {
+		return e.Error()
+	}
+
+	messages := make([]string, 0, len(e.Details))
+	for _, detail := range e.Details {
+		field := strings.Join(detail.Field(), ".")
+		if field == "" {
+			messages = append(messages, detail.Msg)
+		} else {
+			messages = append(messages, fmt.Sprintf("%s: %s", field, detail.Msg))
+		}
+	}
+
+	return fmt.Sprintf("%s: %s", e.Status, strings.Join(messages, "; "))
+}
@@ -0,0 +1,106 @@
+package api_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAPIError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		statusCode  int
+		body        string
+		wantMessage string
+		wantDetails []api.ErrorDetail
+	}{
+		{
+			name:        "string detail",
+			statusCode:  http.StatusNotFound,
+			body:        `{"detail": "Work pool not found."}`,
+			wantMessage: "Work pool not found.",
+		},
+		{
+			name:       "validation detail",
+			statusCode: http.StatusUnprocessableEntity,
+			body:       `{"detail": [{"loc": ["body", "tags", 0], "msg": "Input should be a valid string", "type": "string_type"}]}`,
+			wantDetails: []api.ErrorDetail{
+				{Loc: []string{"body", "tags", "0"}, Msg: "Input should be a valid string", Type: "string_type"},
+			},
+		},
+		{
+			name:       "non-JSON body",
+			statusCode: http.StatusBadGateway,
+			body:       `<html>Bad Gateway</html>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			apiErr := api.NewAPIError(tt.statusCode, "", []byte(tt.body))
+
+			assert.Equal(t, tt.statusCode, apiErr.StatusCode)
+			assert.Equal(t, tt.wantMessage, apiErr.Message)
+			assert.Equal(t, tt.wantDetails, apiErr.Details)
+			assert.Equal(t, tt.body, apiErr.Body)
+			assert.Contains(t, apiErr.Error(), fmt.Sprintf("status code=%d", tt.statusCode))
+		})
+	}
+}
+
+func TestAPIErrorIs(t *testing.T) {
+	t.Parallel()
+
+	sentinels := []error{api.ErrNotFound, api.ErrConflict, api.ErrForbidden, api.ErrUnauthorized, api.ErrValidation}
+
+	tests := []struct {
+		statusCode int
+		want       error
+	}{
+		{statusCode: http.StatusNotFound, want: api.ErrNotFound},
+		{statusCode: http.StatusConflict, want: api.ErrConflict},
+		{statusCode: http.StatusForbidden, want: api.ErrForbidden},
+		{statusCode: http.StatusUnauthorized, want: api.ErrUnauthorized},
+		{statusCode: http.StatusUnprocessableEntity, want: api.ErrValidation},
+		{statusCode: http.StatusInternalServerError, want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.statusCode), func(t *testing.T) {
+			t.Parallel()
+
+			// Wrap the error the same way the client and retry policy do.
+			err := fmt.Errorf("failed to get work pool: %w", api.NewAPIError(tt.statusCode, "", nil))
+
+			for _, sentinel := range sentinels {
+				assert.Equal(t, errors.Is(sentinel, tt.want), errors.Is(err, sentinel), "sentinel %q", sentinel)
+			}
+
+			var apiErr *api.APIError
+			require.ErrorAs(t, err, &apiErr)
+			assert.Equal(t, tt.statusCode, apiErr.StatusCode)
+		})
+	}
+}
+
+func TestAPIErrorSummary(t *testing.T) {
+	t.Parallel()
+
+	body := `{"detail": [
+		{"loc": ["body", "job_variables", "image"], "msg": "Field required", "type": "missing"},
+		{"loc": ["body"], "msg": "Extra inputs are not permitted", "type": "extra_forbidden"}
+	]}`
+
+	apiErr := api.NewAPIError(http.StatusUnprocessableEntity, "422 Unprocessable Entity", []byte(body))
+
+	assert.Equal(t, "422 Unprocessable Entity: job_variables.image: Field required; Extra inputs are not permitted", apiErr.Summary())
+}
@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"iter"
 
 	"github.com/google/uuid"
 )
@@ -11,6 +12,7 @@ type FlowsClient interface {
 	Create(ctx context.Context, data FlowCreate) (*Flow, error)
 	Get(ctx context.Context, flowID uuid.UUID) (*Flow, error)
 	List(ctx context.Context, handleNames []string) ([]*Flow, error)
+	Iterate(ctx context.Context, handleNames []string, opts ListOptions) iter.Seq2[*Flow, error]
 	Update(ctx context.Context, flowID uuid.UUID, data FlowUpdate) error
 	Delete(ctx context.Context, flowID uuid.UUID) error
 }
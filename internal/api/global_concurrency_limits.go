@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"iter"
 
 	"github.com/google/uuid"
 )
@@ -10,6 +11,8 @@ import (
 type GlobalConcurrencyLimitsClient interface {
 	Create(ctx context.Context, globalConcurrencyLimit GlobalConcurrencyLimitCreate) (*GlobalConcurrencyLimit, error)
 	Read(ctx context.Context, globalConcurrencyLimitID string) (*GlobalConcurrencyLimit, error)
+	List(ctx context.Context) ([]*GlobalConcurrencyLimit, error)
+	Iterate(ctx context.Context, opts ListOptions) iter.Seq2[*GlobalConcurrencyLimit, error]
 	Update(ctx context.Context, globalConcurrencyLimitID string, globalConcurrencyLimit GlobalConcurrencyLimitUpdate) error
 	Delete(ctx context.Context, globalConcurrencyLimitID string) error
 }
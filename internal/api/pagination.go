@@ -0,0 +1,32 @@
+package api
+
+import "iter"
+
+// ListOptions selects a window of the objects returned by an Iterate method.
+type ListOptions struct {
+	// Offset is the number of matching objects to skip.
+	Offset int64
+
+	// Limit is the maximum number of objects to return, or 0 for all of them.
+	Limit int64
+
+	// Sort is the server-side sort order, such as "NAME_ASC", for the
+	// endpoints that support one. Defaults to the endpoint's own order.
+	Sort string
+}
+
+// Collect gathers the objects from an Iterate method into a slice,
+// stopping at the first error.
+func Collect[T any](seq iter.Seq2[T, error]) ([]T, error) {
+	items := []T{}
+
+	for item, err := range seq {
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
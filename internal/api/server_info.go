@@ -0,0 +1,106 @@
+package api
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ServerFlavor is the kind of Prefect API the provider is connected to.
+type ServerFlavor string
+
+const (
+	// ServerFlavorUnknown is used when the server could not be detected.
+	ServerFlavorUnknown ServerFlavor = ""
+
+	// ServerFlavorCloud is Prefect Cloud.
+	ServerFlavorCloud ServerFlavor = "cloud"
+
+	// ServerFlavorOSS is a self-hosted Prefect server.
+	ServerFlavorOSS ServerFlavor = "oss"
+)
+
+// ServerInfo describes the Prefect API the provider is connected to,
+// as detected when the provider is configured.
+type ServerInfo struct {
+	Flavor ServerFlavor
+
+	// Version is the version of a self-hosted server, such as "3.1.4".
+	// It is empty for Prefect Cloud, or if the version could not be detected.
+	Version string
+
+	// PlanType is the Prefect Cloud plan of the default account, such as "PRO".
+	// It is empty for self-hosted servers, or if no default account is configured.
+	PlanType string
+}
+
+// IsCloud reports whether the provider is connected to Prefect Cloud.
+func (info ServerInfo) IsCloud() bool {
+	return info.Flavor == ServerFlavorCloud
+}
+
+// IsOSS reports whether the provider is connected to a self-hosted Prefect server.
+func (info ServerInfo) IsOSS() bool {
+	return info.Flavor == ServerFlavorOSS
+}
+
+// VersionAtLeast reports whether the server version is at least the minimum
+// version, and whether the comparison could be made at all. Only the numeric
+// major, minor and patch components are compared, so development and
+// pre-release suffixes such as "3.1.5.dev3" are ignored.
+func (info ServerInfo) VersionAtLeast(minimum string) (atLeast bool, known bool) {
+	version, ok := parseVersion(info.Version)
+	if !ok {
+		return false, false
+	}
+
+	minimumVersion, ok := parseVersion(minimum)
+	if !ok {
+		return false, false
+	}
+
+	for i := range version {
+		if version[i] != minimumVersion[i] {
+			return version[i] > minimumVersion[i], true
+		}
+	}
+
+	return true, true
+}
+
+// parseVersion parses the major, minor and patch components of a version.
+// Missing minor and patch components are treated as zero.
+func parseVersion(version string) ([3]int, bool) {
+	var parsed [3]int
+
+	version = strings.TrimPrefix(strings.TrimSpace(version), "v")
+	if version == "" {
+		return parsed, false
+	}
+
+	parts := strings.SplitN(version, ".", len(parsed)+1)
+	for i := 0; i < len(parsed) && i < len(parts); i++ {
+		// Stop at the first non-numeric character, such as in "0rc1" or "5+dirty".
+		digits := strings.IndexFunc(parts[i], func(r rune) bool { return r < '0' || r > '9' })
+		if digits == -1 {
+			digits = len(parts[i])
+		}
+
+		number, err := strconv.Atoi(parts[i][:digits])
+		if err != nil {
+			// Only the major component is required.
+			if i == 0 {
+				return parsed, false
+			}
+
+			break
+		}
+
+		parsed[i] = number
+
+		if digits < len(parts[i]) {
+			break
+		}
+	}
+
+	return parsed, true
+}
@@ -0,0 +1,43 @@
+package api_test
+
+import (
+	"testing"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServerInfoVersionAtLeast(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		version     string
+		minimum     string
+		wantAtLeast bool
+		wantKnown   bool
+	}{
+		{name: "equal", version: "3.0.0", minimum: "3.0.0", wantAtLeast: true, wantKnown: true},
+		{name: "newer patch", version: "3.1.4", minimum: "3.1.0", wantAtLeast: true, wantKnown: true},
+		{name: "newer major", version: "10.0.0", minimum: "3.2.0", wantAtLeast: true, wantKnown: true},
+		{name: "older minor", version: "3.0.9", minimum: "3.1.0", wantAtLeast: false, wantKnown: true},
+		{name: "older major", version: "2.20.16", minimum: "3.0.0", wantAtLeast: false, wantKnown: true},
+		{name: "development release", version: "3.1.5.dev3+12.gabc123", minimum: "3.1.5", wantAtLeast: true, wantKnown: true},
+		{name: "release candidate", version: "3.0.0rc20", minimum: "3.0.0", wantAtLeast: true, wantKnown: true},
+		{name: "missing components", version: "3", minimum: "3.0.0", wantAtLeast: true, wantKnown: true},
+		{name: "unknown version", version: "", minimum: "3.0.0", wantAtLeast: false, wantKnown: false},
+		{name: "invalid version", version: "latest", minimum: "3.0.0", wantAtLeast: false, wantKnown: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			info := api.ServerInfo{Flavor: api.ServerFlavorOSS, Version: tt.version}
+
+			atLeast, known := info.VersionAtLeast(tt.minimum)
+			assert.Equal(t, tt.wantAtLeast, atLeast)
+			assert.Equal(t, tt.wantKnown, known)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"iter"
 	"time"
 
 	"github.com/google/uuid"
@@ -10,6 +11,7 @@ import (
 type ServiceAccountsClient interface {
 	Create(ctx context.Context, request ServiceAccountCreateRequest) (*ServiceAccount, error)
 	List(ctx context.Context, names []string) ([]*ServiceAccount, error)
+	Iterate(ctx context.Context, names []string, opts ListOptions) iter.Seq2[*ServiceAccount, error]
 	Get(ctx context.Context, id string) (*ServiceAccount, error)
 	Update(ctx context.Context, id string, data ServiceAccountUpdateRequest) error
 	Delete(ctx context.Context, id string) error
@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"iter"
 )
 
 // TeamsClient is a client for working with teams.
@@ -9,6 +10,7 @@ type TeamsClient interface {
 	Create(ctx context.Context, payload TeamCreate) (*Team, error)
 	Read(ctx context.Context, teamID string) (*Team, error)
 	List(ctx context.Context, names []string) ([]*Team, error)
+	Iterate(ctx context.Context, names []string, opts ListOptions) iter.Seq2[*Team, error]
 	Update(ctx context.Context, teamID string, payload TeamUpdate) (*Team, error)
 	Delete(ctx context.Context, teamID string) error
 }
@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"iter"
 
 	"github.com/google/uuid"
 )
@@ -12,6 +13,7 @@ type VariablesClient interface {
 	Get(ctx context.Context, variableID uuid.UUID) (*Variable, error)
 	GetByName(ctx context.Context, name string) (*Variable, error)
 	List(ctx context.Context, filter VariableFilter) ([]Variable, error)
+	Iterate(ctx context.Context, filter VariableFilter, opts ListOptions) iter.Seq2[Variable, error]
 	Update(ctx context.Context, variableID uuid.UUID, variable VariableUpdate) error
 	Delete(ctx context.Context, variableID uuid.UUID) error
 }
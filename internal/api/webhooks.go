@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"iter"
 
 	"github.com/google/uuid"
 )
@@ -10,6 +11,7 @@ type WebhooksClient interface {
 	Create(ctx context.Context, request WebhookCreateRequest) (*Webhook, error)
 	Get(ctx context.Context, webhookID string) (*Webhook, error)
 	List(ctx context.Context, names []string) ([]*Webhook, error)
+	Iterate(ctx context.Context, names []string, opts ListOptions) iter.Seq2[*Webhook, error]
 	Update(ctx context.Context, webhookID string, request WebhookUpdateRequest) error
 	Delete(ctx context.Context, webhookID string) error
 }
@@ -40,16 +42,6 @@ type Webhook struct {
 	Slug        string    `json:"slug"`
 }
 
-type ErrorResponse struct {
-	Detail []ErrorDetail `json:"detail"`
-}
-
-type ErrorDetail struct {
-	Loc  []string `json:"loc"`
-	Msg  string   `json:"msg"`
-	Type string   `json:"type"`
-}
-
 // WebhookFilter defines filters when searching for webhooks.
 type WebhookFilter struct {
 	Webhooks struct {
@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"iter"
 
 	"github.com/google/uuid"
 )
@@ -10,6 +11,7 @@ import (
 type WorkPoolsClient interface {
 	Create(ctx context.Context, data WorkPoolCreate) (*WorkPool, error)
 	List(ctx context.Context, filter WorkPoolFilter) ([]*WorkPool, error)
+	Iterate(ctx context.Context, filter WorkPoolFilter, opts ListOptions) iter.Seq2[*WorkPool, error]
 	Get(ctx context.Context, name string) (*WorkPool, error)
 	Update(ctx context.Context, name string, data WorkPoolUpdate) error
 	Delete(ctx context.Context, name string) error
@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"iter"
 
 	"github.com/google/uuid"
 )
@@ -10,6 +11,7 @@ import (
 type WorkQueuesClient interface {
 	Create(ctx context.Context, data WorkQueueCreate) (*WorkQueue, error)
 	List(ctx context.Context, filter WorkQueueFilter) ([]*WorkQueue, error)
+	Iterate(ctx context.Context, filter WorkQueueFilter, opts ListOptions) iter.Seq2[*WorkQueue, error]
 	Get(ctx context.Context, name string) (*WorkQueue, error)
 	Update(ctx context.Context, name string, data WorkQueueUpdate) error
 	Delete(ctx context.Context, name string) error
@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"iter"
 
 	"github.com/google/uuid"
 )
@@ -11,6 +12,7 @@ type WorkspaceRolesClient interface {
 	Update(ctx context.Context, id uuid.UUID, data WorkspaceRoleUpsert) error
 	Delete(ctx context.Context, id uuid.UUID) error
 	List(ctx context.Context, roleNames []string) ([]*WorkspaceRole, error)
+	Iterate(ctx context.Context, roleNames []string, opts ListOptions) iter.Seq2[*WorkspaceRole, error]
 	Get(ctx context.Context, id uuid.UUID) (*WorkspaceRole, error)
 }
 
@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"iter"
 
 	"github.com/google/uuid"
 )
@@ -11,6 +12,7 @@ type WorkspacesClient interface {
 	Create(ctx context.Context, data WorkspaceCreate) (*Workspace, error)
 	Get(ctx context.Context, workspaceID uuid.UUID) (*Workspace, error)
 	List(ctx context.Context, handleNames []string) ([]*Workspace, error)
+	Iterate(ctx context.Context, handleNames []string, opts ListOptions) iter.Seq2[*Workspace, error]
 	Update(ctx context.Context, workspaceID uuid.UUID, data WorkspaceUpdate) error
 	Delete(ctx context.Context, workspaceID uuid.UUID) error
 }
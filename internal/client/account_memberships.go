@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -34,26 +35,32 @@ func (c *Client) AccountMemberships(accountID uuid.UUID) (api.AccountMemberships
 	}, nil
 }
 
-// List returns a list of account memberships, based on the provided filter.
+// List returns the account memberships with the given emails, or all account memberships
+// if none are given. Every page of results is fetched.
 func (c *AccountMembershipsClient) List(ctx context.Context, emails []string) ([]*api.AccountMembership, error) {
+	accountMemberships, err := api.Collect(c.Iterate(ctx, emails, api.ListOptions{}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list account memberships: %w", err)
+	}
+
+	return accountMemberships, nil
+}
+
+// Iterate returns an iterator over the account memberships with the given emails,
+// or all account memberships if none are given, fetching one page at a time.
+func (c *AccountMembershipsClient) Iterate(ctx context.Context, emails []string, opts api.ListOptions) iter.Seq2[*api.AccountMembership, error] {
 	filterQuery := api.AccountMembershipFilter{}
 	filterQuery.AccountMemberships.Email.Any = emails
 
 	cfg := requestConfig{
-		url:          fmt.Sprintf("%s/filter", c.routePrefix),
 		method:       http.MethodPost,
-		body:         filterQuery,
+		url:          c.routePrefix + "/filter",
 		apiKey:       c.apiKey,
 		basicAuthKey: c.basicAuthKey,
 		successCodes: successCodesStatusOK,
 	}
 
-	var accountMemberships []*api.AccountMembership
-	if err := requestWithDecodeResponse(ctx, c.hc, cfg, &accountMemberships); err != nil {
-		return nil, err
-	}
-
-	return accountMemberships, nil
+	return iterate[*api.AccountMembership](ctx, c.hc, cfg, &filterQuery, opts)
 }
 
 // Update updates the account membership for the given account membership ID and account role ID.
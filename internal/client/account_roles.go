@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -35,26 +36,32 @@ func (c *Client) AccountRoles(accountID uuid.UUID) (api.AccountRolesClient, erro
 	}, nil
 }
 
-// List returns a list of account roles, based on the provided filter.
+// List returns the account roles with the given names, or all account roles
+// if none are given. Every page of results is fetched.
 func (c *AccountRolesClient) List(ctx context.Context, roleNames []string) ([]*api.AccountRole, error) {
+	accountRoles, err := api.Collect(c.Iterate(ctx, roleNames, api.ListOptions{}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list account roles: %w", err)
+	}
+
+	return accountRoles, nil
+}
+
+// Iterate returns an iterator over the account roles with the given names,
+// or all account roles if none are given, fetching one page at a time.
+func (c *AccountRolesClient) Iterate(ctx context.Context, roleNames []string, opts api.ListOptions) iter.Seq2[*api.AccountRole, error] {
 	filterQuery := api.AccountRoleFilter{}
 	filterQuery.AccountRoles.Name.Any = roleNames
 
 	cfg := requestConfig{
-		url:          fmt.Sprintf("%s/filter", c.routePrefix),
 		method:       http.MethodPost,
-		body:         filterQuery,
+		url:          c.routePrefix + "/filter",
 		apiKey:       c.apiKey,
 		basicAuthKey: c.basicAuthKey,
 		successCodes: successCodesStatusOK,
 	}
 
-	var accountRoles []*api.AccountRole
-	if err := requestWithDecodeResponse(ctx, c.hc, cfg, &accountRoles); err != nil {
-		return nil, err
-	}
-
-	return accountRoles, nil
+	return iterate[*api.AccountRole](ctx, c.hc, cfg, &filterQuery, opts)
 }
 
 // Get returns an account role by ID.
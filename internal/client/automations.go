@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -30,7 +31,7 @@ func (c *Client) Automations(accountID uuid.UUID, workspaceID uuid.UUID) (api.Au
 		workspaceID = c.defaultWorkspaceID
 	}
 
-	if err := validateCloudEndpoint(c.endpoint, accountID, workspaceID); err != nil {
+	if err := validateCloudEndpoint(c.mode, accountID, workspaceID); err != nil {
 		return nil, err
 	}
 
@@ -38,7 +39,7 @@ func (c *Client) Automations(accountID uuid.UUID, workspaceID uuid.UUID) (api.Au
 		hc:           c.hc,
 		apiKey:       c.apiKey,
 		basicAuthKey: c.basicAuthKey,
-		routePrefix:  getWorkspaceScopedURL(c.endpoint, accountID, workspaceID, "automations"),
+		routePrefix:  getWorkspaceScopedURL(c.endpoint, c.mode, accountID, workspaceID, "automations"),
 	}, nil
 }
 
@@ -60,6 +61,29 @@ func (c *AutomationsClient) Get(ctx context.Context, id uuid.UUID) (*api.Automat
 	return &automation, nil
 }
 
+// List returns every automation. Every page of results is fetched.
+func (c *AutomationsClient) List(ctx context.Context) ([]*api.Automation, error) {
+	automations, err := api.Collect(c.Iterate(ctx, api.ListOptions{}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list automations: %w", err)
+	}
+
+	return automations, nil
+}
+
+// Iterate returns an iterator over every automation, fetching one page at a time.
+func (c *AutomationsClient) Iterate(ctx context.Context, opts api.ListOptions) iter.Seq2[*api.Automation, error] {
+	cfg := requestConfig{
+		method:       http.MethodPost,
+		url:          c.routePrefix + "/filter",
+		apiKey:       c.apiKey,
+		basicAuthKey: c.basicAuthKey,
+		successCodes: successCodesStatusOK,
+	}
+
+	return iterate[*api.Automation](ctx, c.hc, cfg, nil, opts)
+}
+
 func (c *AutomationsClient) Create(ctx context.Context, payload api.AutomationUpsert) (*api.Automation, error) {
 	cfg := requestConfig{
 		method:       http.MethodPost,
@@ -115,3 +139,43 @@ func (c *AutomationsClient) Delete(ctx context.Context, id uuid.UUID) error {
 
 	return nil
 }
+
+// ListRelatedTo returns the automations related to a resource, such as the
+// automations owned by it.
+func (c *AutomationsClient) ListRelatedTo(ctx context.Context, resourceID string) ([]*api.Automation, error) {
+	cfg := requestConfig{
+		method:       http.MethodGet,
+		url:          fmt.Sprintf("%s/related-to/%s", c.routePrefix, resourceID),
+		body:         http.NoBody,
+		apiKey:       c.apiKey,
+		basicAuthKey: c.basicAuthKey,
+		successCodes: successCodesStatusOK,
+	}
+
+	var automations []*api.Automation
+	if err := requestWithDecodeResponse(ctx, c.hc, cfg, &automations); err != nil {
+		return nil, fmt.Errorf("failed to list automations related to %s: %w", resourceID, err)
+	}
+
+	return automations, nil
+}
+
+// DeleteOwnedBy deletes the automations owned by a resource.
+func (c *AutomationsClient) DeleteOwnedBy(ctx context.Context, resourceID string) error {
+	cfg := requestConfig{
+		method:       http.MethodDelete,
+		url:          fmt.Sprintf("%s/owned-by/%s", c.routePrefix, resourceID),
+		body:         http.NoBody,
+		apiKey:       c.apiKey,
+		basicAuthKey: c.basicAuthKey,
+		successCodes: successCodesStatusAcceptedOrNoContent,
+	}
+
+	resp, err := request(ctx, c.hc, cfg)
+	if err != nil {
+		return fmt.Errorf("failed to delete automations owned by %s: %w", resourceID, err)
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 	"strings"
 
@@ -31,7 +32,7 @@ func (c *Client) BlockDocuments(accountID uuid.UUID, workspaceID uuid.UUID) (api
 		workspaceID = c.defaultWorkspaceID
 	}
 
-	if err := validateCloudEndpoint(c.endpoint, accountID, workspaceID); err != nil {
+	if err := validateCloudEndpoint(c.mode, accountID, workspaceID); err != nil {
 		return nil, err
 	}
 
@@ -39,21 +40,33 @@ func (c *Client) BlockDocuments(accountID uuid.UUID, workspaceID uuid.UUID) (api
 		hc:           c.hc,
 		apiKey:       c.apiKey,
 		basicAuthKey: c.basicAuthKey,
-		routePrefix:  getWorkspaceScopedURL(c.endpoint, accountID, workspaceID, "block_documents"),
+		routePrefix:  getWorkspaceScopedURL(c.endpoint, c.mode, accountID, workspaceID, "block_documents"),
 	}, nil
 }
 
+// Get returns the block document with the given ID, including the values of
+// its secret fields.
 func (c *BlockDocumentClient) Get(ctx context.Context, id uuid.UUID) (*api.BlockDocument, error) {
-	reqURL := fmt.Sprintf("%s/%s", c.routePrefix, id.String())
-	reqURL = fmt.Sprintf("%s?include_secrets=true", reqURL)
+	return c.get(ctx, id, true)
+}
+
+// GetWithoutSecrets returns the block document with the given ID, with the
+// values of its secret fields masked by the server.
+func (c *BlockDocumentClient) GetWithoutSecrets(ctx context.Context, id uuid.UUID) (*api.BlockDocument, error) {
+	return c.get(ctx, id, false)
+}
+
+func (c *BlockDocumentClient) get(ctx context.Context, id uuid.UUID, includeSecrets bool) (*api.BlockDocument, error) {
+	reqURL := fmt.Sprintf("%s/%s?include_secrets=%t", c.routePrefix, id.String(), includeSecrets)
 
 	cfg := requestConfig{
-		method:       http.MethodGet,
-		url:          reqURL,
-		body:         http.NoBody,
-		apiKey:       c.apiKey,
-		basicAuthKey: c.basicAuthKey,
-		successCodes: successCodesStatusOK,
+		method:        http.MethodGet,
+		url:           reqURL,
+		body:          http.NoBody,
+		apiKey:        c.apiKey,
+		basicAuthKey:  c.basicAuthKey,
+		successCodes:  successCodesStatusOK,
+		retryNotFound: true,
 	}
 
 	var blockDocument api.BlockDocument
@@ -71,12 +84,13 @@ func (c *BlockDocumentClient) GetByName(ctx context.Context, typeSlug, name stri
 	reqURL = fmt.Sprintf("%s?include_secrets=true", reqURL)
 
 	cfg := requestConfig{
-		method:       http.MethodGet,
-		url:          reqURL,
-		body:         http.NoBody,
-		apiKey:       c.apiKey,
-		basicAuthKey: c.basicAuthKey,
-		successCodes: successCodesStatusOK,
+		method:        http.MethodGet,
+		url:           reqURL,
+		body:          http.NoBody,
+		apiKey:        c.apiKey,
+		basicAuthKey:  c.basicAuthKey,
+		successCodes:  successCodesStatusOK,
+		retryNotFound: true,
 	}
 
 	var blockDocument api.BlockDocument
@@ -87,6 +101,36 @@ func (c *BlockDocumentClient) GetByName(ctx context.Context, typeSlug, name stri
 	return &blockDocument, nil
 }
 
+// List returns the named block documents of the given block types,
+// or of all block types if none are given. Every page of results is fetched.
+// Secret values are not included.
+func (c *BlockDocumentClient) List(ctx context.Context, typeSlugs []string) ([]*api.BlockDocument, error) {
+	blockDocuments, err := api.Collect(c.Iterate(ctx, typeSlugs, api.ListOptions{}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list block documents: %w", err)
+	}
+
+	return blockDocuments, nil
+}
+
+// Iterate returns an iterator over the named block documents of the given block types,
+// or of all block types if none are given, fetching one page at a time.
+// Secret values are not included.
+func (c *BlockDocumentClient) Iterate(ctx context.Context, typeSlugs []string, opts api.ListOptions) iter.Seq2[*api.BlockDocument, error] {
+	filterQuery := api.BlockDocumentFilter{}
+	filterQuery.BlockTypes.Slug.Any = typeSlugs
+
+	cfg := requestConfig{
+		method:       http.MethodPost,
+		url:          c.routePrefix + "/filter",
+		apiKey:       c.apiKey,
+		basicAuthKey: c.basicAuthKey,
+		successCodes: successCodesStatusOK,
+	}
+
+	return iterate[*api.BlockDocument](ctx, c.hc, cfg, &filterQuery, opts)
+}
+
 func (c *BlockDocumentClient) Create(ctx context.Context, payload api.BlockDocumentCreate) (*api.BlockDocument, error) {
 	cfg := requestConfig{
 		method:       http.MethodPost,
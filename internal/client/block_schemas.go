@@ -2,19 +2,27 @@ package client
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"iter"
 	"net/http"
+	"slices"
 
 	"github.com/google/uuid"
 	"github.com/prefecthq/terraform-provider-prefect/internal/api"
 )
 
+// errNoBlockSchemas keeps an empty list of block schemas out of the read
+// cache, as block schemas are created asynchronously after a workspace.
+var errNoBlockSchemas = errors.New("no block schemas found")
+
 // BlockSchemaClient is a client for working with block schemas.
 type BlockSchemaClient struct {
 	hc           *http.Client
 	routePrefix  string
 	apiKey       string
 	basicAuthKey string
+	cache        *cache
 }
 
 // BlockSchemas returns a BlockSchemaClient.
@@ -28,7 +36,7 @@ func (c *Client) BlockSchemas(accountID uuid.UUID, workspaceID uuid.UUID) (api.B
 		workspaceID = c.defaultWorkspaceID
 	}
 
-	if err := validateCloudEndpoint(c.endpoint, accountID, workspaceID); err != nil {
+	if err := validateCloudEndpoint(c.mode, accountID, workspaceID); err != nil {
 		return nil, err
 	}
 
@@ -36,28 +44,48 @@ func (c *Client) BlockSchemas(accountID uuid.UUID, workspaceID uuid.UUID) (api.B
 		hc:           c.hc,
 		apiKey:       c.apiKey,
 		basicAuthKey: c.basicAuthKey,
-		routePrefix:  getWorkspaceScopedURL(c.endpoint, accountID, workspaceID, "block_schemas"),
+		routePrefix:  getWorkspaceScopedURL(c.endpoint, c.mode, accountID, workspaceID, "block_schemas"),
+		cache:        c.cache,
 	}, nil
 }
 
-// List gets a list of BlockSchemas for a given list of block type slugs.
+// List returns the block schemas for the given block type IDs. Every page of results is fetched.
+// With the read cache enabled, the block schemas of the same block types are only listed once.
 func (c *BlockSchemaClient) List(ctx context.Context, blockTypeIDs []uuid.UUID) ([]*api.BlockSchema, error) {
-	filterQuery := &api.BlockSchemaFilter{}
+	key := fmt.Sprintf("%s/filter?block_type_ids=%v", c.routePrefix, blockTypeIDs)
+
+	blockSchemas, err := cachedLookup(ctx, c.cache, key, func(ctx context.Context) ([]*api.BlockSchema, error) {
+		blockSchemas, err := api.Collect(c.Iterate(ctx, blockTypeIDs, api.ListOptions{}))
+		if err == nil && len(blockSchemas) == 0 {
+			return nil, errNoBlockSchemas
+		}
+
+		return blockSchemas, err
+	})
+	if errors.Is(err, errNoBlockSchemas) {
+		return []*api.BlockSchema{}, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list block schemas: %w", err)
+	}
+
+	// The cached list is shared, so callers get their own copy.
+	return slices.Clone(blockSchemas), nil
+}
+
+// Iterate returns an iterator over the block schemas for the given block type IDs, fetching one page at a time.
+func (c *BlockSchemaClient) Iterate(ctx context.Context, blockTypeIDs []uuid.UUID, opts api.ListOptions) iter.Seq2[*api.BlockSchema, error] {
+	filterQuery := api.BlockSchemaFilter{}
 	filterQuery.BlockSchemas.BlockTypeID.Any = blockTypeIDs
 
 	cfg := requestConfig{
 		method:       http.MethodPost,
 		url:          c.routePrefix + "/filter",
-		body:         filterQuery,
 		apiKey:       c.apiKey,
 		basicAuthKey: c.basicAuthKey,
 		successCodes: successCodesStatusOK,
 	}
 
-	var blockSchemas []*api.BlockSchema
-	if err := requestWithDecodeResponse(ctx, c.hc, cfg, &blockSchemas); err != nil {
-		return nil, fmt.Errorf("failed to get block schemas: %w", err)
-	}
-
-	return blockSchemas, nil
+	return iterate[*api.BlockSchema](ctx, c.hc, cfg, &filterQuery, opts)
 }
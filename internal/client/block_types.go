@@ -17,6 +17,7 @@ type BlockTypeClient struct {
 	routePrefix  string
 	apiKey       string
 	basicAuthKey string
+	cache        *cache
 }
 
 // BlockTypes returns a BlockTypeClient.
@@ -30,7 +31,7 @@ func (c *Client) BlockTypes(accountID uuid.UUID, workspaceID uuid.UUID) (api.Blo
 		workspaceID = c.defaultWorkspaceID
 	}
 
-	if err := validateCloudEndpoint(c.endpoint, accountID, workspaceID); err != nil {
+	if err := validateCloudEndpoint(c.mode, accountID, workspaceID); err != nil {
 		return nil, err
 	}
 
@@ -38,15 +39,30 @@ func (c *Client) BlockTypes(accountID uuid.UUID, workspaceID uuid.UUID) (api.Blo
 		hc:           c.hc,
 		apiKey:       c.apiKey,
 		basicAuthKey: c.basicAuthKey,
-		routePrefix:  getWorkspaceScopedURL(c.endpoint, accountID, workspaceID, "block_types"),
+		routePrefix:  getWorkspaceScopedURL(c.endpoint, c.mode, accountID, workspaceID, "block_types"),
+		cache:        c.cache,
 	}, nil
 }
 
 // GetBySlug returns details for a block type by slug.
+// With the read cache enabled, each block type is only fetched once.
 func (c *BlockTypeClient) GetBySlug(ctx context.Context, slug string) (*api.BlockType, error) {
+	url := c.routePrefix + "/slug/" + slug
+
+	blockType, err := cachedLookup(ctx, c.cache, url, func(ctx context.Context) (api.BlockType, error) {
+		return c.getBySlug(ctx, url)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &blockType, nil
+}
+
+func (c *BlockTypeClient) getBySlug(ctx context.Context, url string) (api.BlockType, error) {
 	cfg := requestConfig{
 		method:       http.MethodGet,
-		url:          c.routePrefix + "/slug/" + slug,
+		url:          url,
 		body:         http.NoBody,
 		apiKey:       c.apiKey,
 		basicAuthKey: c.basicAuthKey,
@@ -55,8 +71,8 @@ func (c *BlockTypeClient) GetBySlug(ctx context.Context, slug string) (*api.Bloc
 
 	var blockType api.BlockType
 	if err := requestWithDecodeResponse(ctx, c.hc, cfg, &blockType); err != nil {
-		return nil, fmt.Errorf("failed to get block type: %w", err)
+		return blockType, fmt.Errorf("failed to get block type: %w", err)
 	}
 
-	return &blockType, nil
+	return blockType, nil
 }
@@ -0,0 +1,238 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// batchWindow is how long the first Get of a batch waits for concurrent
+	// Gets of the same object type to join it, before the batch is fetched.
+	batchWindow = 10 * time.Millisecond
+
+	// maxBatchSize is the number of objects after which a batch is fetched
+	// right away, to keep the filter request and its response small.
+	maxBatchSize = 200
+)
+
+// cache is a read-through cache shared by the sub-clients of a Client,
+// enabled with WithReadCache. The provider creates a new Client on every
+// configure, so cached values never outlive a single plan or apply.
+//
+// It holds three kinds of values:
+//   - objects fetched in batches, such as deployments, which are dropped on
+//     writes that may change them;
+//   - the results of reads of other mutable objects, such as work pools,
+//     which are also dropped on writes;
+//   - the results of lookups of immutable objects, such as block types,
+//     which are kept for the lifetime of the Client.
+//
+// A nil *cache is valid and disables caching.
+type cache struct {
+	mu sync.Mutex
+
+	// objects holds the objects fetched in batches, keyed by their URL.
+	objects map[string]any
+	// batches holds the batch waiting to be fetched for each route prefix.
+	batches map[string]*batch
+	// reads holds the results of reads of mutable objects, keyed by request.
+	reads map[string]*lookup
+	// lookups holds the results of immutable lookups, keyed by request.
+	lookups map[string]*lookup
+
+	// generation is incremented on every invalidation, so that batches
+	// fetched before a write do not cache objects the write may change.
+	generation int
+}
+
+// batch is a set of objects of the same type fetched with a single request.
+type batch struct {
+	ids   []uuid.UUID
+	once  sync.Once
+	done  chan struct{}
+	fetch func()
+}
+
+// lookup is the result of an immutable lookup, which is ready once done is
+// closed, so that concurrent lookups of the same object make one request.
+type lookup struct {
+	done  chan struct{}
+	value any
+	err   error
+}
+
+func newCache() *cache {
+	return &cache{
+		objects: map[string]any{},
+		batches: map[string]*batch{},
+		reads:   map[string]*lookup{},
+		lookups: map[string]*lookup{},
+	}
+}
+
+// batchGet returns the object with the given ID from the cache, or fetches
+// it along with the objects requested by concurrent calls for the same route
+// prefix, using a single call to list. It reports false if the object could
+// not be fetched this way, such as when it does not exist, in which case the
+// caller falls back to fetching it on its own, to report the error as usual.
+func batchGet[T any](
+	ctx context.Context,
+	c *cache,
+	routePrefix string,
+	id uuid.UUID,
+	list func(ctx context.Context, ids []uuid.UUID) ([]T, error),
+	idOf func(T) uuid.UUID,
+) (T, bool) {
+	key := routePrefix + "/" + id.String()
+
+	c.mu.Lock()
+
+	if object, ok := c.objects[key].(T); ok {
+		c.mu.Unlock()
+
+		return object, true
+	}
+
+	pending, ok := c.batches[routePrefix]
+	if !ok {
+		pending = &batch{done: make(chan struct{})}
+
+		// The batch is shared by all of its callers, so it is not canceled
+		// along with the context of the caller that happened to start it.
+		batchCtx := context.WithoutCancel(ctx)
+		pending.fetch = func() {
+			c.mu.Lock()
+			if c.batches[routePrefix] == pending {
+				delete(c.batches, routePrefix)
+			}
+			ids := pending.ids
+			generation := c.generation
+			c.mu.Unlock()
+
+			objects, err := list(batchCtx, ids)
+
+			c.mu.Lock()
+			if err == nil && generation == c.generation {
+				for _, object := range objects {
+					c.objects[routePrefix+"/"+idOf(object).String()] = object
+				}
+			}
+			c.mu.Unlock()
+
+			close(pending.done)
+		}
+
+		c.batches[routePrefix] = pending
+		time.AfterFunc(batchWindow, func() { pending.once.Do(pending.fetch) })
+	}
+
+	pending.ids = append(pending.ids, id)
+	if len(pending.ids) >= maxBatchSize {
+		delete(c.batches, routePrefix)
+		go pending.once.Do(pending.fetch)
+	}
+
+	c.mu.Unlock()
+
+	var zero T
+
+	select {
+	case <-pending.done:
+	case <-ctx.Done():
+		return zero, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	object, ok := c.objects[key].(T)
+
+	return object, ok
+}
+
+// cachedLookup returns the result of an immutable lookup from the cache,
+// or calls fetch and caches its result. Failed lookups are not cached.
+// Callers share the cached value, so they must not modify it.
+func cachedLookup[T any](ctx context.Context, c *cache, key string, fetch func(ctx context.Context) (T, error)) (T, error) {
+	if c == nil {
+		return fetch(ctx)
+	}
+
+	return sharedLookup(ctx, c, c.lookups, key, fetch)
+}
+
+// cachedRead is like cachedLookup, for reads of mutable objects, whose
+// results are dropped by invalidate.
+func cachedRead[T any](ctx context.Context, c *cache, key string, fetch func(ctx context.Context) (T, error)) (T, error) {
+	if c == nil {
+		return fetch(ctx)
+	}
+
+	return sharedLookup(ctx, c, c.reads, key, fetch)
+}
+
+// sharedLookup returns the result for key from results, or calls fetch and
+// stores its result, so that concurrent lookups of the same key make one
+// request. Failed lookups are not stored.
+func sharedLookup[T any](ctx context.Context, c *cache, results map[string]*lookup, key string, fetch func(ctx context.Context) (T, error)) (T, error) {
+	c.mu.Lock()
+
+	if result, ok := results[key]; ok {
+		c.mu.Unlock()
+
+		var zero T
+
+		select {
+		case <-result.done:
+		case <-ctx.Done():
+			return zero, ctx.Err()
+		}
+
+		if result.err != nil {
+			// The concurrent lookup failed, so make this one on its own.
+			return fetch(ctx)
+		}
+
+		value, _ := result.value.(T)
+
+		return value, nil
+	}
+
+	result := &lookup{done: make(chan struct{})}
+	results[key] = result
+	c.mu.Unlock()
+
+	value, err := fetch(ctx)
+	result.value, result.err = value, err
+
+	if err != nil {
+		c.mu.Lock()
+		if results[key] == result {
+			delete(results, key)
+		}
+		c.mu.Unlock()
+	}
+
+	close(result.done)
+
+	return value, err
+}
+
+// invalidate drops the objects fetched in batches and the results of reads
+// of mutable objects, after a write that may change them. Writes are rare compared to reads during a refresh, so every
+// object is dropped, rather than tracking which objects a write affects.
+func (c *cache) invalidate() {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	clear(c.objects)
+	clear(c.reads)
+	c.generation++
+}
@@ -0,0 +1,238 @@
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/client"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils/fakeserver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newWorkspaceClient returns a client for a new workspace on a fake server.
+func newWorkspaceClient(t *testing.T, opts ...client.Option) *client.Client {
+	t.Helper()
+
+	server := fakeserver.New(fakeserver.WithAPIKey("pnu_fake"))
+	server.Start()
+	t.Cleanup(server.Close)
+
+	accountClient, err := client.New(
+		client.WithEndpoint(server.URL()+"/api", server.URL()),
+		client.WithAPIKey("pnu_fake"),
+		client.WithDefaults(server.AccountID(), uuid.Nil),
+	)
+	require.NoError(t, err)
+
+	workspaces, err := accountClient.Workspaces(uuid.Nil)
+	require.NoError(t, err)
+
+	workspace, err := workspaces.Create(context.Background(), api.WorkspaceCreate{Name: "test", Handle: "test"})
+	require.NoError(t, err)
+
+	prefectClient, err := client.New(append([]client.Option{
+		client.WithEndpoint(server.URL()+"/api", server.URL()),
+		client.WithAPIKey("pnu_fake"),
+		client.WithDefaults(server.AccountID(), workspace.ID),
+	}, opts...)...)
+	require.NoError(t, err)
+
+	return prefectClient
+}
+
+// createDeployments creates deployments with one schedule each, returning their IDs.
+func createDeployments(t *testing.T, prefectClient *client.Client, count int) []uuid.UUID {
+	t.Helper()
+
+	ctx := context.Background()
+
+	flows, err := prefectClient.Flows(uuid.Nil, uuid.Nil)
+	require.NoError(t, err)
+
+	flow, err := flows.Create(ctx, api.FlowCreate{Name: "flow"})
+	require.NoError(t, err)
+
+	deployments, err := prefectClient.Deployments(uuid.Nil, uuid.Nil)
+	require.NoError(t, err)
+
+	schedules, err := prefectClient.DeploymentSchedule(uuid.Nil, uuid.Nil)
+	require.NoError(t, err)
+
+	ids := make([]uuid.UUID, 0, count)
+
+	for i := range count {
+		deployment, err := deployments.Create(ctx, api.DeploymentCreate{Name: fmt.Sprintf("deployment-%02d", i), FlowID: flow.ID})
+		require.NoError(t, err)
+
+		_, err = schedules.Create(ctx, deployment.ID, []api.DeploymentSchedulePayload{{Schedule: api.Schedule{Interval: 3600}}})
+		require.NoError(t, err)
+
+		ids = append(ids, deployment.ID)
+	}
+
+	return ids
+}
+
+// readDeployments concurrently gets the given deployments and reads their
+// schedules, returning the number of requests made.
+func readDeployments(t *testing.T, prefectClient *client.Client, ids []uuid.UUID) int {
+	t.Helper()
+
+	ctx, stats := client.ContextWithCallStats(context.Background())
+
+	deployments, err := prefectClient.Deployments(uuid.Nil, uuid.Nil)
+	require.NoError(t, err)
+
+	schedules, err := prefectClient.DeploymentSchedule(uuid.Nil, uuid.Nil)
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+
+	for _, id := range ids {
+		wg.Add(2)
+
+		go func() {
+			defer wg.Done()
+
+			deployment, err := deployments.Get(ctx, id)
+			if assert.NoError(t, err) {
+				assert.Equal(t, id, deployment.ID)
+			}
+		}()
+
+		go func() {
+			defer wg.Done()
+
+			deploymentSchedules, err := schedules.Read(ctx, id)
+			if assert.NoError(t, err) && assert.Len(t, deploymentSchedules, 1) {
+				assert.Equal(t, id, deploymentSchedules[0].DeploymentID)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return stats.Requests()
+}
+
+func TestReadCacheBatchesDeploymentReads(t *testing.T) {
+	t.Parallel()
+
+	prefectClient := newWorkspaceClient(t, client.WithReadCache(true))
+	ids := createDeployments(t, prefectClient, 20)
+
+	// Concurrent reads are fetched with a few filter requests, rather than one request per read.
+	assert.Less(t, readDeployments(t, prefectClient, ids), 5)
+
+	// Deployments that were already fetched are read from the cache.
+	assert.Equal(t, 0, readDeployments(t, prefectClient, ids))
+
+	deployments, err := prefectClient.Deployments(uuid.Nil, uuid.Nil)
+	require.NoError(t, err)
+
+	// Writes drop the cached deployments.
+	require.NoError(t, deployments.Update(context.Background(), ids[0], api.DeploymentUpdate{Description: "updated"}))
+
+	deployment, err := deployments.Get(context.Background(), ids[0])
+	require.NoError(t, err)
+	assert.Equal(t, "updated", deployment.Description)
+
+	// Deployments that do not exist are reported as usual.
+	_, err = deployments.Get(context.Background(), uuid.New())
+	require.ErrorIs(t, err, api.ErrNotFound)
+}
+
+func TestReadCacheDisabledByDefault(t *testing.T) {
+	t.Parallel()
+
+	prefectClient := newWorkspaceClient(t)
+	ids := createDeployments(t, prefectClient, 5)
+
+	assert.Equal(t, 10, readDeployments(t, prefectClient, ids))
+	assert.Equal(t, 10, readDeployments(t, prefectClient, ids))
+}
+
+func TestReadCacheImmutableLookups(t *testing.T) {
+	t.Parallel()
+
+	prefectClient := newWorkspaceClient(t, client.WithReadCache(true))
+	ctx, stats := client.ContextWithCallStats(context.Background())
+
+	blockTypes, err := prefectClient.BlockTypes(uuid.Nil, uuid.Nil)
+	require.NoError(t, err)
+
+	blockSchemas, err := prefectClient.BlockSchemas(uuid.Nil, uuid.Nil)
+	require.NoError(t, err)
+
+	collections, err := prefectClient.Collections(uuid.Nil, uuid.Nil)
+	require.NoError(t, err)
+
+	for range 3 {
+		blockType, err := blockTypes.GetBySlug(ctx, "json")
+		require.NoError(t, err)
+		assert.Equal(t, "json", blockType.Slug)
+
+		schemas, err := blockSchemas.List(ctx, []uuid.UUID{blockType.ID})
+		require.NoError(t, err)
+		assert.Len(t, schemas, 1)
+
+		_, err = collections.GetWorkerMetadataViews(ctx)
+		require.NoError(t, err)
+
+		// Failed lookups are not cached.
+		_, err = blockTypes.GetBySlug(ctx, "missing")
+		require.ErrorIs(t, err, api.ErrNotFound)
+	}
+
+	// One request each for the block type, its schemas and the worker metadata, and one per failed lookup.
+	assert.Equal(t, 6, stats.Requests())
+}
+
+func TestReadCacheWorkPoolReads(t *testing.T) {
+	t.Parallel()
+
+	prefectClient := newWorkspaceClient(t, client.WithReadCache(true))
+
+	workPools, err := prefectClient.WorkPools(uuid.Nil, uuid.Nil)
+	require.NoError(t, err)
+
+	_, err = workPools.Create(context.Background(), api.WorkPoolCreate{Name: "pool", Type: "process"})
+	require.NoError(t, err)
+
+	ctx, stats := client.ContextWithCallStats(context.Background())
+
+	var wg sync.WaitGroup
+
+	for range 10 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			pool, err := workPools.Get(ctx, "pool")
+			if assert.NoError(t, err) {
+				assert.Equal(t, "pool", pool.Name)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	// Concurrent reads of the same work pool make a single request.
+	assert.Equal(t, 1, stats.Requests())
+
+	// Writes drop the cached work pools.
+	description := "updated"
+	require.NoError(t, workPools.Update(ctx, "pool", api.WorkPoolUpdate{Description: &description}))
+
+	pool, err := workPools.Get(ctx, "pool")
+	require.NoError(t, err)
+	require.NotNil(t, pool.Description)
+	assert.Equal(t, "updated", *pool.Description)
+	assert.Equal(t, 3, stats.Requests())
+}
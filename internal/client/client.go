@@ -2,15 +2,21 @@ package client
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 
 	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+	"golang.org/x/net/http/httpguts"
+	"golang.org/x/time/rate"
 
 	retryablehttp "github.com/hashicorp/go-retryablehttp"
 )
@@ -36,13 +42,37 @@ func New(opts ...Option) (*Client, error) {
 	// by providing a custom function for determining whether or not to retry.
 	retryableClient.CheckRetry = checkRetryPolicy
 
+	// Every attempt goes through a shared throttle, so that rate and
+	// concurrency limits apply across all sub-clients. Both limits are
+	// disabled until configured with WithRateLimit and WithMaxConcurrentRequests.
+	//
+	// Inside the throttle, each attempt is counted and, if enabled with
+	// WithHTTPTracing, logged with its timing.
+	//
+	// Extra headers are added before tracing, so they appear in traces.
+	transport, ok := retryableClient.HTTPClient.Transport.(*http.Transport)
+	if !ok {
+		transport = http.DefaultTransport.(*http.Transport).Clone()
+	}
+
+	tracing := &tracingTransport{next: transport, sensitiveHeaders: sensitiveHeaders}
+	headers := &headerTransport{next: tracing}
+	throttle := &throttledTransport{next: headers}
+	retryableClient.HTTPClient.Transport = throttle
+
 	// Finally, convert the retryablehttp client to a standard http client.
 	// This allows us to retain the `http.Client` interface, and avoid specifying
 	// the `retryablehttp.Client` interface in our client methods.
 	httpClient := retryableClient.StandardClient()
 
 	client := &Client{
-		hc: httpClient,
+		hc:          httpClient,
+		retryClient: retryableClient,
+		transport:   transport,
+		throttle:    throttle,
+		tracing:     tracing,
+		headers:     headers,
+		mode:        ModeAuto,
 	}
 
 	var errs []error
@@ -58,6 +88,12 @@ func New(opts ...Option) (*Client, error) {
 		return nil, errors.Join(errs...)
 	}
 
+	// In auto mode, Prefect Cloud is detected from the endpoint host once all
+	// options are applied, so that the order of the options does not matter.
+	if client.mode == ModeAuto && helpers.IsCloudEndpoint(client.endpoint, client.cloudHosts...) {
+		client.mode = ModeCloud
+	}
+
 	return client, nil
 }
 
@@ -81,6 +117,39 @@ func WithEndpoint(endpoint string, host string) Option {
 	}
 }
 
+// WithMode configures whether the client addresses Prefect Cloud or a
+// self-hosted Prefect server. Defaults to ModeAuto.
+func WithMode(mode Mode) Option {
+	return func(client *Client) error {
+		switch mode {
+		case "":
+			client.mode = ModeAuto
+		case ModeAuto, ModeCloud, ModeServer:
+			client.mode = mode
+		default:
+			return fmt.Errorf("mode %q must be one of %q, %q or %q", mode, ModeAuto, ModeCloud, ModeServer)
+		}
+
+		return nil
+	}
+}
+
+// WithCloudHosts configures additional hostnames, such as private-link
+// hostnames, that are detected as Prefect Cloud in auto mode.
+func WithCloudHosts(hosts []string) Option {
+	return func(client *Client) error {
+		for _, host := range hosts {
+			if host == "" || strings.ContainsAny(host, "/:") {
+				return fmt.Errorf("cloud host %q must be a hostname, without a scheme, port or path", host)
+			}
+		}
+
+		client.cloudHosts = hosts
+
+		return nil
+	}
+}
+
 // WithAPIKey configures the API Key to use to authenticate to Prefect.
 func WithAPIKey(apiKey string) Option {
 	return func(client *Client) error {
@@ -99,6 +168,262 @@ func WithBasicAuthKey(basicAuthKey string) Option {
 	}
 }
 
+// WithRetryPolicy configures how many times a failed request is retried,
+// and the minimum and maximum time to wait between attempts.
+func WithRetryPolicy(maxRetries int, waitMin, waitMax time.Duration) Option {
+	return func(client *Client) error {
+		if maxRetries < 0 {
+			return fmt.Errorf("max retries must not be negative, got %d", maxRetries)
+		}
+
+		if waitMin < 0 || waitMax < waitMin {
+			return fmt.Errorf("retry wait must satisfy 0 <= min <= max, got min=%s and max=%s", waitMin, waitMax)
+		}
+
+		client.retryClient.RetryMax = maxRetries
+		client.retryClient.RetryWaitMin = waitMin
+		client.retryClient.RetryWaitMax = waitMax
+
+		return nil
+	}
+}
+
+// WithRetryTimeout configures the total time allowed for a request,
+// including all of its retries. A zero value means no timeout.
+func WithRetryTimeout(timeout time.Duration) Option {
+	return func(client *Client) error {
+		if timeout < 0 {
+			return fmt.Errorf("retry timeout must not be negative, got %s", timeout)
+		}
+
+		client.hc.Timeout = timeout
+
+		return nil
+	}
+}
+
+// WithRateLimit configures the maximum sustained number of requests per second,
+// and how many requests may be sent in a burst above that rate.
+// A zero rate means no rate limit.
+func WithRateLimit(requestsPerSecond float64, burst int) Option {
+	return func(client *Client) error {
+		if requestsPerSecond < 0 {
+			return fmt.Errorf("rate limit must not be negative, got %v", requestsPerSecond)
+		}
+
+		if requestsPerSecond == 0 {
+			client.throttle.limiter = nil
+
+			return nil
+		}
+
+		if burst < 1 {
+			return fmt.Errorf("rate limit burst must be at least 1, got %d", burst)
+		}
+
+		client.throttle.limiter = rate.NewLimiter(rate.Limit(requestsPerSecond), burst)
+
+		return nil
+	}
+}
+
+// WithMaxConcurrentRequests configures the maximum number of requests
+// that may be in flight at the same time. A zero value means no limit.
+func WithMaxConcurrentRequests(maxConcurrentRequests int) Option {
+	return func(client *Client) error {
+		if maxConcurrentRequests < 0 {
+			return fmt.Errorf("max concurrent requests must not be negative, got %d", maxConcurrentRequests)
+		}
+
+		if maxConcurrentRequests == 0 {
+			client.throttle.inFlight = nil
+
+			return nil
+		}
+
+		client.throttle.inFlight = make(chan struct{}, maxConcurrentRequests)
+
+		return nil
+	}
+}
+
+// WithHTTPTracing configures whether every API request and response is
+// logged at TRACE level, including headers and bodies with sensitive
+// values redacted.
+func WithHTTPTracing(enabled bool) Option {
+	return func(client *Client) error {
+		client.tracing.enabled = enabled
+
+		return nil
+	}
+}
+
+// WithReadCache configures whether the client caches reads for the lifetime
+// of the client. Concurrent gets of deployments and their schedules are
+// fetched with a single filter request, and lookups of immutable objects, such
+// as block types, block schemas and worker metadata, are made only once.
+// Work pools are also fetched once, such as when validating the job variables
+// of many deployments. Writes through the client drop the cached deployments
+// and work pools.
+func WithReadCache(enabled bool) Option {
+	return func(client *Client) error {
+		client.cache = nil
+		if enabled {
+			client.cache = newCache()
+		}
+
+		return nil
+	}
+}
+
+// WithAdoptExisting configures whether resources adopt existing objects
+// with the same name on create by default. Resources can override this.
+func WithAdoptExisting(adoptExisting bool) Option {
+	return func(client *Client) error {
+		client.adoptExisting = adoptExisting
+
+		return nil
+	}
+}
+
+// WithCACertificates configures additional PEM-encoded certificate authorities
+// to trust when verifying the server's certificate, such as a private CA for a
+// self-hosted Prefect server. The system certificate pool is still trusted.
+func WithCACertificates(pemData []byte) Option {
+	return func(client *Client) error {
+		if len(pemData) == 0 {
+			return nil
+		}
+
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+
+		if !pool.AppendCertsFromPEM(pemData) {
+			return fmt.Errorf("no valid PEM-encoded certificates found in the CA bundle")
+		}
+
+		client.tlsConfig().RootCAs = pool
+
+		return nil
+	}
+}
+
+// WithClientCertificate configures a PEM-encoded client certificate and key
+// to present to the server, for servers that require mutual TLS.
+func WithClientCertificate(certPEM, keyPEM []byte) Option {
+	return func(client *Client) error {
+		if len(certPEM) == 0 && len(keyPEM) == 0 {
+			return nil
+		}
+
+		certificate, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return fmt.Errorf("invalid client certificate or key: %w", err)
+		}
+
+		tlsConfig := client.tlsConfig()
+		tlsConfig.Certificates = append(tlsConfig.Certificates, certificate)
+
+		return nil
+	}
+}
+
+// WithInsecureSkipVerify configures whether the server's certificate is
+// verified. This should only be disabled for development.
+func WithInsecureSkipVerify(insecureSkipVerify bool) Option {
+	return func(client *Client) error {
+		if !insecureSkipVerify {
+			return nil
+		}
+
+		//nolint:gosec // explicitly requested by the user, for development only
+		client.tlsConfig().InsecureSkipVerify = true
+
+		return nil
+	}
+}
+
+// WithProxy configures an explicit HTTP(S) proxy to send requests through.
+// When not set, the proxy is read from the HTTP_PROXY, HTTPS_PROXY and
+// NO_PROXY environment variables.
+func WithProxy(proxyURL string) Option {
+	return func(client *Client) error {
+		if proxyURL == "" {
+			return nil
+		}
+
+		parsed, err := url.Parse(proxyURL)
+		if err != nil {
+			return fmt.Errorf("proxy is not a valid url: %w", err)
+		}
+
+		if parsed.Scheme != "http" && parsed.Scheme != "https" && parsed.Scheme != "socks5" {
+			return fmt.Errorf("proxy %q must use the http, https or socks5 scheme", parsed.Redacted())
+		}
+
+		client.transport.Proxy = http.ProxyURL(parsed)
+
+		return nil
+	}
+}
+
+// WithRequestTimeout configures the time allowed for a single attempt of a
+// request. Unlike WithRetryTimeout, a timed out attempt is retried.
+// A zero value means no timeout.
+func WithRequestTimeout(timeout time.Duration) Option {
+	return func(client *Client) error {
+		if timeout < 0 {
+			return fmt.Errorf("request timeout must not be negative, got %s", timeout)
+		}
+
+		client.retryClient.HTTPClient.Timeout = timeout
+
+		return nil
+	}
+}
+
+// WithExtraHeaders configures static headers to send with every request, such
+// as routing headers required by a gateway in front of a self-hosted server.
+// Extra headers cannot override the Authorization, Content-Type and Accept
+// headers set by the client. Their values are redacted from HTTP traces.
+func WithExtraHeaders(headers map[string]string) Option {
+	return func(client *Client) error {
+		extraHeaders := make(http.Header, len(headers))
+
+		for name, value := range headers {
+			if !httpguts.ValidHeaderFieldName(name) {
+				return fmt.Errorf("extra header name %q is not a valid HTTP header name", name)
+			}
+
+			if !httpguts.ValidHeaderFieldValue(value) {
+				return fmt.Errorf("extra header %q has an invalid value", name)
+			}
+
+			extraHeaders.Set(name, value)
+		}
+
+		client.headers.extra = extraHeaders
+
+		for name := range extraHeaders {
+			client.tracing.sensitiveHeaders = append(client.tracing.sensitiveHeaders, name)
+		}
+
+		return nil
+	}
+}
+
+// tlsConfig returns the TLS configuration of the underlying transport,
+// creating it if needed.
+func (c *Client) tlsConfig() *tls.Config {
+	if c.transport.TLSClientConfig == nil {
+		c.transport.TLSClientConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+
+	return c.transport.TLSClientConfig
+}
+
 // WithDefaults configures the default account and workspace ID.
 func WithDefaults(accountID uuid.UUID, workspaceID uuid.UUID) Option {
 	return func(client *Client) error {
@@ -120,21 +445,29 @@ func checkRetryPolicy(ctx context.Context, resp *http.Response, err error) (bool
 		return true, err
 	}
 
-	// If the response is a 409 (StatusConflict), that means the request
-	// eventually succeeded and we don't need to make the request again.
+	// If the response is a 409 (StatusConflict), the object already exists,
+	// possibly because an earlier attempt of the request succeeded, so making
+	// the request again would not help. The conflict is returned to the
+	// caller as an api.ErrConflict, so that resources can adopt the object.
 	if resp.StatusCode == http.StatusConflict {
 		return false, nil
 	}
 
-	// If the response is a 404 (NotFound), try again. This is particularly
-	// relevant for block-related objects that are created asynchronously.
+	// If the response is a 404 (NotFound), try again if the caller opted in.
+	// This is only relevant for block-related objects that are created
+	// asynchronously - for everything else, a 404 is definitive and retrying
+	// would only delay removing a deleted object from the state.
 	if resp.StatusCode == http.StatusNotFound {
-		// NOTE: we encode the status code in the error object as a workaround
-		// in cases where we want access to the status code on a failed client.Do() call
-		// due to exhausted retries.
-		// go-retryablehttp does not return the response object on exhausted retries.
+		if !retryNotFoundFromContext(ctx) {
+			return false, nil
+		}
+
+		// NOTE: we return an *api.APIError as a workaround in cases where we want
+		// access to the status code on a failed client.Do() call due to exhausted
+		// retries. go-retryablehttp does not return the response object on
+		// exhausted retries, but it does wrap the error returned from here.
 		// https://github.com/hashicorp/go-retryablehttp/blob/main/client.go#L811-L825
-		return true, fmt.Errorf("status_code=%d, error=%w", resp.StatusCode, err)
+		return true, api.NewAPIError(resp.StatusCode, resp.Status, nil)
 	}
 
 	// Fall back to the default retry policy for any other status codes.
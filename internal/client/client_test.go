@@ -0,0 +1,213 @@
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newCountingServer returns a server that responds with the given status codes
+// in order, repeating the last one, and a counter of the requests it received.
+func newCountingServer(t *testing.T, headers http.Header, statusCodes ...int) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var count atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		attempt := int(count.Add(1)) - 1
+		statusCode := statusCodes[min(attempt, len(statusCodes)-1)]
+
+		for key, values := range headers {
+			w.Header()[key] = values
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+
+		if statusCode == http.StatusOK {
+			_, _ = w.Write([]byte(`{}`))
+		} else {
+			_, _ = w.Write([]byte(`{"detail": "error"}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &count
+}
+
+func newClient(t *testing.T, server *httptest.Server, opts ...client.Option) *client.Client {
+	t.Helper()
+
+	prefectClient, err := client.New(append([]client.Option{
+		client.WithEndpoint(server.URL+"/api", server.URL),
+		client.WithRetryPolicy(2, time.Millisecond, 5*time.Millisecond),
+	}, opts...)...)
+	require.NoError(t, err)
+
+	return prefectClient
+}
+
+func TestNotFoundIsNotRetriedByDefault(t *testing.T) {
+	t.Parallel()
+
+	server, count := newCountingServer(t, nil, http.StatusNotFound)
+	prefectClient := newClient(t, server)
+
+	workPools, err := prefectClient.WorkPools(uuid.Nil, uuid.Nil)
+	require.NoError(t, err)
+
+	_, err = workPools.Get(context.Background(), "missing")
+	require.ErrorIs(t, err, api.ErrNotFound)
+	assert.Equal(t, int32(1), count.Load())
+}
+
+func TestConflictIsNotRetried(t *testing.T) {
+	t.Parallel()
+
+	server, count := newCountingServer(t, nil, http.StatusConflict)
+	prefectClient := newClient(t, server)
+
+	workPools, err := prefectClient.WorkPools(uuid.Nil, uuid.Nil)
+	require.NoError(t, err)
+
+	_, err = workPools.Create(context.Background(), api.WorkPoolCreate{Name: "existing"})
+	require.ErrorIs(t, err, api.ErrConflict)
+	assert.Equal(t, int32(1), count.Load())
+}
+
+func TestNotFoundIsRetriedForBlockDocuments(t *testing.T) {
+	t.Parallel()
+
+	server, count := newCountingServer(t, nil, http.StatusNotFound, http.StatusOK)
+	prefectClient := newClient(t, server)
+
+	blockDocuments, err := prefectClient.BlockDocuments(uuid.Nil, uuid.Nil)
+	require.NoError(t, err)
+
+	_, err = blockDocuments.Get(context.Background(), uuid.New())
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), count.Load())
+}
+
+func TestNotFoundIsNotRetriedForBlockDocumentWrites(t *testing.T) {
+	t.Parallel()
+
+	server, count := newCountingServer(t, nil, http.StatusNotFound)
+	prefectClient := newClient(t, server)
+
+	blockDocuments, err := prefectClient.BlockDocuments(uuid.Nil, uuid.Nil)
+	require.NoError(t, err)
+
+	err = blockDocuments.Delete(context.Background(), uuid.New())
+	require.ErrorIs(t, err, api.ErrNotFound)
+	assert.Equal(t, int32(1), count.Load())
+}
+
+func TestNotFoundRetriesExhausted(t *testing.T) {
+	t.Parallel()
+
+	server, count := newCountingServer(t, nil, http.StatusNotFound)
+	prefectClient := newClient(t, server)
+
+	blockDocuments, err := prefectClient.BlockDocuments(uuid.Nil, uuid.Nil)
+	require.NoError(t, err)
+
+	_, err = blockDocuments.Get(context.Background(), uuid.New())
+	require.ErrorIs(t, err, api.ErrNotFound)
+	assert.Equal(t, int32(3), count.Load())
+}
+
+func TestRetryPolicy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		headers     http.Header
+		statusCodes []int
+		maxRetries  int
+		wantErr     bool
+		wantCount   int32
+	}{
+		{
+			name:        "server errors are retried up to the maximum",
+			statusCodes: []int{http.StatusInternalServerError},
+			maxRetries:  2,
+			wantErr:     true,
+			wantCount:   3,
+		},
+		{
+			name:        "retries can be disabled",
+			statusCodes: []int{http.StatusInternalServerError},
+			maxRetries:  0,
+			wantErr:     true,
+			wantCount:   1,
+		},
+		{
+			name:        "rate limited requests honour Retry-After",
+			headers:     http.Header{"Retry-After": []string{"0"}},
+			statusCodes: []int{http.StatusTooManyRequests, http.StatusServiceUnavailable, http.StatusOK},
+			maxRetries:  2,
+			wantCount:   3,
+		},
+		{
+			name:        "conflicts are not retried",
+			statusCodes: []int{http.StatusConflict},
+			maxRetries:  2,
+			wantErr:     true,
+			wantCount:   1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server, count := newCountingServer(t, tt.headers, tt.statusCodes...)
+			prefectClient := newClient(t, server, client.WithRetryPolicy(tt.maxRetries, time.Millisecond, 5*time.Millisecond))
+
+			workPools, err := prefectClient.WorkPools(uuid.Nil, uuid.Nil)
+			require.NoError(t, err)
+
+			_, err = workPools.Get(context.Background(), "pool")
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.wantCount, count.Load())
+		})
+	}
+}
+
+func TestRetryOptionsValidation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		opt  client.Option
+	}{
+		{name: "negative retries", opt: client.WithRetryPolicy(-1, time.Second, time.Second)},
+		{name: "negative minimum wait", opt: client.WithRetryPolicy(1, -time.Second, time.Second)},
+		{name: "maximum wait below minimum", opt: client.WithRetryPolicy(1, time.Minute, time.Second)},
+		{name: "negative timeout", opt: client.WithRetryTimeout(-time.Second)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := client.New(tt.opt)
+			require.Error(t, err)
+		})
+	}
+}
@@ -3,11 +3,11 @@ package client
 import (
 	"context"
 	"fmt"
+	"maps"
 	"net/http"
 
 	"github.com/google/uuid"
 	"github.com/prefecthq/terraform-provider-prefect/internal/api"
-	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
 )
 
 var _ = api.CollectionsClient(&CollectionsClient{})
@@ -17,6 +17,8 @@ type CollectionsClient struct {
 	apiKey       string
 	basicAuthKey string
 	routePrefix  string
+	cloud        bool
+	cache        *cache
 }
 
 // Collections returns an CollectionsClient.
@@ -31,7 +33,7 @@ func (c *Client) Collections(accountID, workspaceID uuid.UUID) (api.CollectionsC
 		workspaceID = c.defaultWorkspaceID
 	}
 
-	if err := validateCloudEndpoint(c.endpoint, accountID, workspaceID); err != nil {
+	if err := validateCloudEndpoint(c.mode, accountID, workspaceID); err != nil {
 		return nil, err
 	}
 
@@ -39,7 +41,9 @@ func (c *Client) Collections(accountID, workspaceID uuid.UUID) (api.CollectionsC
 		hc:           c.hc,
 		apiKey:       c.apiKey,
 		basicAuthKey: c.basicAuthKey,
-		routePrefix:  getWorkspaceScopedURL(c.endpoint, accountID, workspaceID, "collections"),
+		routePrefix:  getWorkspaceScopedURL(c.endpoint, c.mode, accountID, workspaceID, "collections"),
+		cache:        c.cache,
+		cloud:        c.mode == ModeCloud,
 	}, nil
 }
 
@@ -47,12 +51,24 @@ func (c *Client) Collections(accountID, workspaceID uuid.UUID) (api.CollectionsC
 // This endpoint serves base job configurations for the primary worker types.
 func (c *CollectionsClient) GetWorkerMetadataViews(ctx context.Context) (api.WorkerTypeByPackage, error) {
 	routeSuffix := "views/aggregate-worker-metadata"
-	if helpers.IsCloudEndpoint(c.routePrefix) {
+	if c.cloud {
 		routeSuffix = "work_pool_types"
 	}
 
 	url := fmt.Sprintf("%s/%s", c.routePrefix, routeSuffix)
 
+	workerTypeByPackage, err := cachedLookup(ctx, c.cache, url, func(ctx context.Context) (api.WorkerTypeByPackage, error) {
+		return c.getWorkerMetadataViews(ctx, url)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The cached views are shared, so callers get their own copy.
+	return maps.Clone(workerTypeByPackage), nil
+}
+
+func (c *CollectionsClient) getWorkerMetadataViews(ctx context.Context, url string) (api.WorkerTypeByPackage, error) {
 	cfg := requestConfig{
 		method:       http.MethodGet,
 		url:          url,
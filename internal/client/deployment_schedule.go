@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"slices"
 
 	"github.com/google/uuid"
 	"github.com/prefecthq/terraform-provider-prefect/internal/api"
@@ -16,6 +17,7 @@ type DeploymentScheduleClient struct {
 	routePrefix  string
 	apiKey       string
 	basicAuthKey string
+	cache        *cache
 }
 
 // DeploymentSchedule returns a DeploymentScheduleClient.
@@ -30,19 +32,23 @@ func (c *Client) DeploymentSchedule(accountID, workspaceID uuid.UUID) (api.Deplo
 		workspaceID = c.defaultWorkspaceID
 	}
 
-	if err := validateCloudEndpoint(c.endpoint, accountID, workspaceID); err != nil {
+	if err := validateCloudEndpoint(c.mode, accountID, workspaceID); err != nil {
 		return nil, err
 	}
 
 	return &DeploymentScheduleClient{
 		hc:           c.hc,
-		routePrefix:  getWorkspaceScopedURL(c.endpoint, accountID, workspaceID, "deployments"),
+		routePrefix:  getWorkspaceScopedURL(c.endpoint, c.mode, accountID, workspaceID, "deployments"),
+		cache:        c.cache,
 		apiKey:       c.apiKey,
 		basicAuthKey: c.basicAuthKey,
 	}, nil
 }
 
 func (c *DeploymentScheduleClient) Create(ctx context.Context, deploymentID uuid.UUID, payload []api.DeploymentSchedulePayload) ([]*api.DeploymentSchedule, error) {
+	// Cached deployments include their schedules.
+	defer c.cache.invalidate()
+
 	cfg := requestConfig{
 		method:       http.MethodPost,
 		url:          fmt.Sprintf("%s/%s/schedules", c.routePrefix, deploymentID.String()),
@@ -60,7 +66,15 @@ func (c *DeploymentScheduleClient) Create(ctx context.Context, deploymentID uuid
 	return schedules, nil
 }
 
+// Read returns the schedules of a deployment. With the read cache enabled,
+// they are read from the deployment, which is fetched along with the
+// deployments requested by concurrent calls.
 func (c *DeploymentScheduleClient) Read(ctx context.Context, deploymentID uuid.UUID) ([]*api.DeploymentSchedule, error) {
+	// Older servers do not include the schedules in the deployment.
+	if deployment, ok := c.deployments().getBatched(ctx, deploymentID); ok && deployment.Schedules != nil {
+		return slices.Clone(deployment.Schedules), nil
+	}
+
 	cfg := requestConfig{
 		method:       http.MethodGet,
 		url:          fmt.Sprintf("%s/%s/schedules", c.routePrefix, deploymentID.String()),
@@ -79,6 +93,9 @@ func (c *DeploymentScheduleClient) Read(ctx context.Context, deploymentID uuid.U
 }
 
 func (c *DeploymentScheduleClient) Update(ctx context.Context, deploymentID uuid.UUID, scheduleID uuid.UUID, payload api.DeploymentSchedulePayload) error {
+	// Cached deployments include their schedules.
+	defer c.cache.invalidate()
+
 	cfg := requestConfig{
 		method:       http.MethodPatch,
 		url:          fmt.Sprintf("%s/%s/%s/%s", c.routePrefix, deploymentID.String(), "schedules", scheduleID.String()),
@@ -98,6 +115,9 @@ func (c *DeploymentScheduleClient) Update(ctx context.Context, deploymentID uuid
 }
 
 func (c *DeploymentScheduleClient) Delete(ctx context.Context, deploymentID uuid.UUID, scheduleID uuid.UUID) error {
+	// Cached deployments include their schedules.
+	defer c.cache.invalidate()
+
 	cfg := requestConfig{
 		method:       http.MethodDelete,
 		url:          fmt.Sprintf("%s/%s/%s/%s", c.routePrefix, deploymentID.String(), "schedules", scheduleID.String()),
@@ -115,3 +135,15 @@ func (c *DeploymentScheduleClient) Delete(ctx context.Context, deploymentID uuid
 
 	return nil
 }
+
+// deployments returns a DeploymentsClient for the same workspace,
+// to fetch deployments along with their schedules.
+func (c *DeploymentScheduleClient) deployments() *DeploymentsClient {
+	return &DeploymentsClient{
+		hc:           c.hc,
+		routePrefix:  c.routePrefix,
+		apiKey:       c.apiKey,
+		basicAuthKey: c.basicAuthKey,
+		cache:        c.cache,
+	}
+}
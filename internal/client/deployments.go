@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -18,6 +19,7 @@ type DeploymentsClient struct {
 	routePrefix  string
 	apiKey       string
 	basicAuthKey string
+	cache        *cache
 }
 
 // Deployments returns a DeploymentsClient.
@@ -32,13 +34,14 @@ func (c *Client) Deployments(accountID uuid.UUID, workspaceID uuid.UUID) (api.De
 		workspaceID = c.defaultWorkspaceID
 	}
 
-	if err := validateCloudEndpoint(c.endpoint, accountID, workspaceID); err != nil {
+	if err := validateCloudEndpoint(c.mode, accountID, workspaceID); err != nil {
 		return nil, err
 	}
 
 	return &DeploymentsClient{
 		hc:           c.hc,
-		routePrefix:  getWorkspaceScopedURL(c.endpoint, accountID, workspaceID, "deployments"),
+		routePrefix:  getWorkspaceScopedURL(c.endpoint, c.mode, accountID, workspaceID, "deployments"),
+		cache:        c.cache,
 		apiKey:       c.apiKey,
 		basicAuthKey: c.basicAuthKey,
 	}, nil
@@ -46,6 +49,8 @@ func (c *Client) Deployments(accountID uuid.UUID, workspaceID uuid.UUID) (api.De
 
 // Create returns details for a new Deployment.
 func (c *DeploymentsClient) Create(ctx context.Context, data api.DeploymentCreate) (*api.Deployment, error) {
+	defer c.cache.invalidate()
+
 	cfg := requestConfig{
 		method:       http.MethodPost,
 		url:          c.routePrefix + "/",
@@ -64,7 +69,14 @@ func (c *DeploymentsClient) Create(ctx context.Context, data api.DeploymentCreat
 }
 
 // Get returns details for a Deployment by ID.
+// With the read cache enabled, concurrent gets are fetched with a single request.
 func (c *DeploymentsClient) Get(ctx context.Context, deploymentID uuid.UUID) (*api.Deployment, error) {
+	if deployment, ok := c.getBatched(ctx, deploymentID); ok {
+		deploymentCopy := *deployment
+
+		return &deploymentCopy, nil
+	}
+
 	cfg := requestConfig{
 		method:       http.MethodGet,
 		url:          fmt.Sprintf("%s/%s", c.routePrefix, deploymentID.String()),
@@ -82,6 +94,41 @@ func (c *DeploymentsClient) Get(ctx context.Context, deploymentID uuid.UUID) (*a
 	return &deployment, nil
 }
 
+// getBatched returns a Deployment from the read cache, fetching it along
+// with the deployments requested by concurrent calls. It reports false if the
+// read cache is disabled, or if the deployment could not be fetched this way,
+// such as when it does not exist. The returned deployment is shared.
+func (c *DeploymentsClient) getBatched(ctx context.Context, deploymentID uuid.UUID) (*api.Deployment, bool) {
+	if c.cache == nil {
+		return nil, false
+	}
+
+	return batchGet(ctx, c.cache, c.routePrefix, deploymentID, c.listByID, func(deployment *api.Deployment) uuid.UUID {
+		return deployment.ID
+	})
+}
+
+// listByID returns the deployments with the given IDs. Every page of results is fetched.
+func (c *DeploymentsClient) listByID(ctx context.Context, deploymentIDs []uuid.UUID) ([]*api.Deployment, error) {
+	filterQuery := api.DeploymentFilter{}
+	filterQuery.Deployments.ID.Any = deploymentIDs
+
+	cfg := requestConfig{
+		method:       http.MethodPost,
+		url:          c.routePrefix + "/filter",
+		apiKey:       c.apiKey,
+		basicAuthKey: c.basicAuthKey,
+		successCodes: successCodesStatusOK,
+	}
+
+	deployments, err := api.Collect(iterate[*api.Deployment](ctx, c.hc, cfg, &filterQuery, api.ListOptions{}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list deployments: %w", err)
+	}
+
+	return deployments, nil
+}
+
 // GetByName returns details for a Deployment by name.
 func (c *DeploymentsClient) GetByName(ctx context.Context, flowName, deploymentName string) (*api.Deployment, error) {
 	url := fmt.Sprintf("%s/name/%s/%s", c.routePrefix, flowName, deploymentName)
@@ -102,8 +149,38 @@ func (c *DeploymentsClient) GetByName(ctx context.Context, flowName, deploymentN
 	return &deployment, nil
 }
 
+// List returns the deployments of the given flows,
+// or all deployments if no flows are given. Every page of results is fetched.
+func (c *DeploymentsClient) List(ctx context.Context, flowIDs []uuid.UUID) ([]*api.Deployment, error) {
+	deployments, err := api.Collect(c.Iterate(ctx, flowIDs, api.ListOptions{}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list deployments: %w", err)
+	}
+
+	return deployments, nil
+}
+
+// Iterate returns an iterator over the deployments of the given flows,
+// or all deployments if no flows are given, fetching one page at a time.
+func (c *DeploymentsClient) Iterate(ctx context.Context, flowIDs []uuid.UUID, opts api.ListOptions) iter.Seq2[*api.Deployment, error] {
+	filterQuery := api.DeploymentFilter{}
+	filterQuery.Flows.ID.Any = flowIDs
+
+	cfg := requestConfig{
+		method:       http.MethodPost,
+		url:          c.routePrefix + "/filter",
+		apiKey:       c.apiKey,
+		basicAuthKey: c.basicAuthKey,
+		successCodes: successCodesStatusOK,
+	}
+
+	return iterate[*api.Deployment](ctx, c.hc, cfg, &filterQuery, opts)
+}
+
 // Update modifies an existing Deployment by ID.
 func (c *DeploymentsClient) Update(ctx context.Context, id uuid.UUID, data api.DeploymentUpdate) error {
+	defer c.cache.invalidate()
+
 	cfg := requestConfig{
 		method:       http.MethodPatch,
 		url:          fmt.Sprintf("%s/%s", c.routePrefix, id.String()),
@@ -124,6 +201,8 @@ func (c *DeploymentsClient) Update(ctx context.Context, id uuid.UUID, data api.D
 
 // Delete removes a Deployment by ID.
 func (c *DeploymentsClient) Delete(ctx context.Context, deploymentID uuid.UUID) error {
+	defer c.cache.invalidate()
+
 	cfg := requestConfig{
 		method:       http.MethodDelete,
 		url:          fmt.Sprintf("%s/%s", c.routePrefix, deploymentID.String()),
@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -18,6 +19,7 @@ type FlowsClient struct {
 	routePrefix  string
 	apiKey       string
 	basicAuthKey string
+	cache        *cache
 }
 
 // Flows returns a FlowsClient.
@@ -32,13 +34,14 @@ func (c *Client) Flows(accountID uuid.UUID, workspaceID uuid.UUID) (api.FlowsCli
 		workspaceID = c.defaultWorkspaceID
 	}
 
-	if err := validateCloudEndpoint(c.endpoint, accountID, workspaceID); err != nil {
+	if err := validateCloudEndpoint(c.mode, accountID, workspaceID); err != nil {
 		return nil, err
 	}
 
 	return &FlowsClient{
 		hc:           c.hc,
-		routePrefix:  getWorkspaceScopedURL(c.endpoint, accountID, workspaceID, "flows"),
+		routePrefix:  getWorkspaceScopedURL(c.endpoint, c.mode, accountID, workspaceID, "flows"),
+		cache:        c.cache,
 		apiKey:       c.apiKey,
 		basicAuthKey: c.basicAuthKey,
 	}, nil
@@ -63,29 +66,35 @@ func (c *FlowsClient) Create(ctx context.Context, data api.FlowCreate) (*api.Flo
 	return &flow, nil
 }
 
-// List returns a list of Flows, based on the provided list of handle names.
+// List returns the flows with the given handles, or all flows
+// if none are given. Every page of results is fetched.
 func (c *FlowsClient) List(ctx context.Context, handleNames []string) ([]*api.Flow, error) {
-	filterQuery := api.WorkspaceFilter{}
+	flows, err := api.Collect(c.Iterate(ctx, handleNames, api.ListOptions{}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list flows: %w", err)
+	}
+
+	return flows, nil
+}
+
+// Iterate returns an iterator over the flows with the given handles,
+// or all flows if none are given, fetching one page at a time.
+func (c *FlowsClient) Iterate(ctx context.Context, handleNames []string, opts api.ListOptions) iter.Seq2[*api.Flow, error] {
+	filterQuery := api.FlowFilter{}
 
 	if len(handleNames) != 0 {
-		filterQuery.Workspaces.Handle.Any = handleNames
+		filterQuery.Flows.Handle.Any = handleNames
 	}
 
 	cfg := requestConfig{
 		method:       http.MethodPost,
-		url:          fmt.Sprintf("%s/filter", c.routePrefix),
-		body:         &filterQuery,
+		url:          c.routePrefix + "/filter",
 		apiKey:       c.apiKey,
 		basicAuthKey: c.basicAuthKey,
 		successCodes: successCodesStatusOK,
 	}
 
-	var flows []*api.Flow
-	if err := requestWithDecodeResponse(ctx, c.hc, cfg, &flows); err != nil {
-		return nil, fmt.Errorf("failed to list flows: %w", err)
-	}
-
-	return flows, nil
+	return iterate[*api.Flow](ctx, c.hc, cfg, &filterQuery, opts)
 }
 
 // Get returns details for a Flow by ID.
@@ -129,6 +138,9 @@ func (c *FlowsClient) Update(ctx context.Context, flowID uuid.UUID, data api.Flo
 
 // Delete removes a Flow by ID.
 func (c *FlowsClient) Delete(ctx context.Context, flowID uuid.UUID) error {
+	// Deleting a flow deletes its deployments.
+	defer c.cache.invalidate()
+
 	cfg := requestConfig{
 		method:       http.MethodDelete,
 		url:          fmt.Sprintf("%s/%s", c.routePrefix, flowID.String()),
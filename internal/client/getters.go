@@ -1,8 +1,26 @@
 package client
 
+import "github.com/google/uuid"
+
 // GetEndpointHost returns the endpoint host,
 // which is the API domain without the trailing subpath.
 // eg. https://api.prefect.cloud
 func (c *Client) GetEndpointHost() string {
 	return c.endpointHost
 }
+
+// AdoptExisting returns whether resources adopt existing objects with the
+// same name on create by default, instead of failing with a conflict.
+func (c *Client) AdoptExisting() bool {
+	return c.adoptExisting
+}
+
+// DefaultAccountID returns the account ID used when a resource does not set one.
+func (c *Client) DefaultAccountID() uuid.UUID {
+	return c.defaultAccountID
+}
+
+// DefaultWorkspaceID returns the workspace ID used when a resource does not set one.
+func (c *Client) DefaultWorkspaceID() uuid.UUID {
+	return c.defaultWorkspaceID
+}
@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -31,13 +32,13 @@ func (c *Client) GlobalConcurrencyLimits(accountID uuid.UUID, workspaceID uuid.U
 		workspaceID = c.defaultWorkspaceID
 	}
 
-	if err := validateCloudEndpoint(c.endpoint, accountID, workspaceID); err != nil {
+	if err := validateCloudEndpoint(c.mode, accountID, workspaceID); err != nil {
 		return nil, err
 	}
 
 	return &GlobalConcurrencyLimitsClient{
 		hc:           c.hc,
-		routePrefix:  getWorkspaceScopedURL(c.endpoint, accountID, workspaceID, "v2/concurrency_limits"),
+		routePrefix:  getWorkspaceScopedURL(c.endpoint, c.mode, accountID, workspaceID, "v2/concurrency_limits"),
 		apiKey:       c.apiKey,
 		basicAuthKey: c.basicAuthKey,
 	}, nil
@@ -80,6 +81,29 @@ func (c *GlobalConcurrencyLimitsClient) Read(ctx context.Context, globalConcurre
 	return &globalConcurrencyLimit, nil
 }
 
+// List returns every global concurrency limit. Every page of results is fetched.
+func (c *GlobalConcurrencyLimitsClient) List(ctx context.Context) ([]*api.GlobalConcurrencyLimit, error) {
+	globalConcurrencyLimits, err := api.Collect(c.Iterate(ctx, api.ListOptions{}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list global concurrency limits: %w", err)
+	}
+
+	return globalConcurrencyLimits, nil
+}
+
+// Iterate returns an iterator over every global concurrency limit, fetching one page at a time.
+func (c *GlobalConcurrencyLimitsClient) Iterate(ctx context.Context, opts api.ListOptions) iter.Seq2[*api.GlobalConcurrencyLimit, error] {
+	cfg := requestConfig{
+		method:       http.MethodPost,
+		url:          c.routePrefix + "/filter",
+		apiKey:       c.apiKey,
+		basicAuthKey: c.basicAuthKey,
+		successCodes: successCodesStatusOK,
+	}
+
+	return iterate[*api.GlobalConcurrencyLimit](ctx, c.hc, cfg, nil, opts)
+}
+
 // Update updates a global concurrency limit.
 func (c *GlobalConcurrencyLimitsClient) Update(ctx context.Context, globalConcurrencyLimitID string, data api.GlobalConcurrencyLimitUpdate) error {
 	cfg := requestConfig{
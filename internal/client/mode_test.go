@@ -0,0 +1,129 @@
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/prefecthq/terraform-provider-prefect/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newPathRecordingServer returns a server that responds with an empty object,
+// and the path of the last request it received.
+func newPathRecordingServer(t *testing.T) (*httptest.Server, *atomic.Value) {
+	t.Helper()
+
+	var requestPath atomic.Value
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestPath.Store(r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &requestPath
+}
+
+func TestModeScopesURLs(t *testing.T) {
+	t.Parallel()
+
+	// Test servers listen on the loopback address.
+	const serverHost = "127.0.0.1"
+
+	accountID, workspaceID := uuid.New(), uuid.New()
+	scopedPath := "/api/accounts/" + accountID.String() + "/workspaces/" + workspaceID.String() + "/work_pools/pool"
+
+	tests := []struct {
+		name     string
+		opts     []client.Option
+		wantPath string
+		wantErr  bool
+	}{
+		{
+			name:     "auto mode without IDs",
+			wantPath: "/api/work_pools/pool",
+		},
+		{
+			name:     "auto mode with IDs",
+			opts:     []client.Option{client.WithDefaults(accountID, workspaceID)},
+			wantPath: scopedPath,
+		},
+		{
+			name:     "server mode ignores IDs",
+			opts:     []client.Option{client.WithMode(client.ModeServer), client.WithDefaults(accountID, workspaceID)},
+			wantPath: "/api/work_pools/pool",
+		},
+		{
+			name:     "cloud mode with IDs",
+			opts:     []client.Option{client.WithMode(client.ModeCloud), client.WithDefaults(accountID, workspaceID)},
+			wantPath: scopedPath,
+		},
+		{
+			name:    "cloud mode without IDs",
+			opts:    []client.Option{client.WithMode(client.ModeCloud)},
+			wantErr: true,
+		},
+		{
+			name:    "custom Cloud host without IDs",
+			opts:    []client.Option{client.WithCloudHosts([]string{serverHost})},
+			wantErr: true,
+		},
+		{
+			name:     "custom Cloud host with IDs",
+			opts:     []client.Option{client.WithCloudHosts([]string{serverHost}), client.WithDefaults(accountID, workspaceID)},
+			wantPath: scopedPath,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server, requestPath := newPathRecordingServer(t)
+			prefectClient := newClient(t, server, tt.opts...)
+
+			workPools, err := prefectClient.WorkPools(uuid.Nil, uuid.Nil)
+			if tt.wantErr {
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			_, err = workPools.Get(context.Background(), "pool")
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantPath, requestPath.Load())
+		})
+	}
+}
+
+func TestModeValidation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		opt  client.Option
+	}{
+		{name: "unknown mode", opt: client.WithMode("hybrid")},
+		{name: "Cloud host with scheme", opt: client.WithCloudHosts([]string{"https://prefect.example.com"})},
+		{name: "Cloud host with port", opt: client.WithCloudHosts([]string{"prefect.example.com:443"})},
+		{name: "empty Cloud host", opt: client.WithCloudHosts([]string{""})},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := client.New(tt.opt)
+			require.Error(t, err)
+		})
+	}
+}
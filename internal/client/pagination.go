@@ -0,0 +1,120 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+)
+
+// defaultPageSize is the number of objects requested per page when listing,
+// which is the largest limit accepted by Prefect's filter endpoints.
+const defaultPageSize = 200
+
+// iterate pages through a filter endpoint using limit and offset, yielding
+// each object as its page is fetched. The filter is sent as the request body,
+// with the limit, offset and sort order added to it.
+//
+// Pages are requested until a page is shorter than the requested size, or
+// until the limit in the options is reached. As a guard against servers that
+// ignore the offset, which would otherwise be paged through forever, an error
+// is returned when a page starts with the same object as the previous one.
+func iterate[T any](ctx context.Context, hc *http.Client, cfg requestConfig, filter any, opts api.ListOptions) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+
+		offset := opts.Offset
+		remaining := opts.Limit
+
+		var previousFirst json.RawMessage
+
+		for {
+			pageSize := int64(defaultPageSize)
+			if opts.Limit > 0 {
+				pageSize = min(pageSize, remaining)
+			}
+
+			body, err := pageBody(filter, pageSize, offset, opts.Sort)
+			if err != nil {
+				yield(zero, err)
+
+				return
+			}
+
+			pageCfg := cfg
+			pageCfg.body = body
+
+			var page []json.RawMessage
+			if err := requestWithDecodeResponse(ctx, hc, pageCfg, &page); err != nil {
+				yield(zero, fmt.Errorf("failed to list page at offset %d: %w", offset, err))
+
+				return
+			}
+
+			if len(page) > 0 && previousFirst != nil && bytes.Equal(page[0], previousFirst) {
+				yield(zero, fmt.Errorf("failed to list page at offset %d: the server returned the previous page again", offset))
+
+				return
+			}
+
+			for _, raw := range page {
+				var item T
+				if err := json.Unmarshal(raw, &item); err != nil {
+					yield(zero, fmt.Errorf("failed to decode page at offset %d: %w", offset, err))
+
+					return
+				}
+
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if int64(len(page)) < pageSize {
+				return
+			}
+
+			previousFirst = page[0]
+			offset += int64(len(page))
+			remaining -= int64(len(page))
+
+			if opts.Limit > 0 && remaining <= 0 {
+				return
+			}
+		}
+	}
+}
+
+// pageBody returns the filter as a JSON object, with the page's limit,
+// offset and sort order added to it.
+func pageBody(filter any, limit, offset int64, sort string) (map[string]any, error) {
+	body := map[string]any{}
+
+	if filter != nil {
+		encoded, err := json.Marshal(filter)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode filter: %w", err)
+		}
+
+		// Numbers are kept as written, rather than converted to floats.
+		decoder := json.NewDecoder(bytes.NewReader(encoded))
+		decoder.UseNumber()
+
+		if err := decoder.Decode(&body); err != nil {
+			return nil, fmt.Errorf("failed to encode filter: %w", err)
+		}
+	}
+
+	body["limit"] = limit
+	body["offset"] = offset
+
+	if sort != "" {
+		body["sort"] = sort
+	}
+
+	return body, nil
+}
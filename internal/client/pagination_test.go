@@ -0,0 +1,212 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type pageRequest struct {
+	Limit  int64  `json:"limit"`
+	Offset int64  `json:"offset"`
+	Sort   string `json:"sort,omitempty"`
+}
+
+// newPagingServer returns a server whose work pool filter endpoint pages
+// through the given number of work pools, and a function returning the
+// pages that were requested.
+func newPagingServer(t *testing.T, total int64) (*httptest.Server, func() []pageRequest) {
+	t.Helper()
+
+	var (
+		mu       sync.Mutex
+		requests []pageRequest
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var page pageRequest
+		if r.URL.Path != "/api/work_pools/filter" || json.NewDecoder(r.Body).Decode(&page) != nil {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		mu.Lock()
+		requests = append(requests, page)
+		mu.Unlock()
+
+		pools := []api.WorkPool{}
+		for i := page.Offset; i < min(page.Offset+page.Limit, total); i++ {
+			pools = append(pools, api.WorkPool{Name: fmt.Sprintf("pool-%03d", i)})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(pools)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, func() []pageRequest {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return append([]pageRequest(nil), requests...)
+	}
+}
+
+func TestListFetchesEveryPage(t *testing.T) {
+	t.Parallel()
+
+	server, requests := newPagingServer(t, 450)
+
+	workPools, err := newClient(t, server).WorkPools(uuid.Nil, uuid.Nil)
+	require.NoError(t, err)
+
+	pools, err := workPools.List(context.Background(), api.WorkPoolFilter{})
+	require.NoError(t, err)
+
+	require.Len(t, pools, 450)
+	assert.Equal(t, "pool-000", pools[0].Name)
+	assert.Equal(t, "pool-449", pools[449].Name)
+	assert.Equal(t, []pageRequest{
+		{Limit: 200, Offset: 0},
+		{Limit: 200, Offset: 200},
+		{Limit: 200, Offset: 400},
+	}, requests())
+}
+
+func TestListFetchesOnePageWhenItIsShort(t *testing.T) {
+	t.Parallel()
+
+	server, requests := newPagingServer(t, 120)
+
+	workPools, err := newClient(t, server).WorkPools(uuid.Nil, uuid.Nil)
+	require.NoError(t, err)
+
+	pools, err := workPools.List(context.Background(), api.WorkPoolFilter{})
+	require.NoError(t, err)
+
+	require.Len(t, pools, 120)
+	assert.Equal(t, []pageRequest{{Limit: 200, Offset: 0}}, requests())
+}
+
+func TestListStopsWhenTheServerIgnoresTheOffset(t *testing.T) {
+	t.Parallel()
+
+	var count atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		count.Add(1)
+
+		pools := make([]api.WorkPool, 200)
+		for i := range pools {
+			pools[i] = api.WorkPool{Name: fmt.Sprintf("pool-%03d", i)}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(pools)
+	}))
+	t.Cleanup(server.Close)
+
+	workPools, err := newClient(t, server).WorkPools(uuid.Nil, uuid.Nil)
+	require.NoError(t, err)
+
+	_, err = workPools.List(context.Background(), api.WorkPoolFilter{})
+	require.ErrorContains(t, err, "the server returned the previous page again")
+	assert.Equal(t, int32(2), count.Load())
+}
+
+func TestIterate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		opts         api.ListOptions
+		stopAfter    int
+		wantCount    int
+		wantFirst    string
+		wantRequests []pageRequest
+	}{
+		{
+			name:      "offset and limit",
+			opts:      api.ListOptions{Offset: 10, Limit: 250},
+			wantCount: 250,
+			wantFirst: "pool-010",
+			wantRequests: []pageRequest{
+				{Limit: 200, Offset: 10},
+				{Limit: 50, Offset: 210},
+			},
+		},
+		{
+			name:         "limit beyond the last object",
+			opts:         api.ListOptions{Offset: 440, Limit: 100},
+			wantCount:    10,
+			wantFirst:    "pool-440",
+			wantRequests: []pageRequest{{Limit: 100, Offset: 440}},
+		},
+		{
+			name:         "sort order is sent to the server",
+			opts:         api.ListOptions{Limit: 5, Sort: "NAME_ASC"},
+			wantCount:    5,
+			wantFirst:    "pool-000",
+			wantRequests: []pageRequest{{Limit: 5, Offset: 0, Sort: "NAME_ASC"}},
+		},
+		{
+			name:         "stopping early fetches no further pages",
+			stopAfter:    3,
+			wantCount:    3,
+			wantFirst:    "pool-000",
+			wantRequests: []pageRequest{{Limit: 200, Offset: 0}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server, requests := newPagingServer(t, 450)
+
+			workPools, err := newClient(t, server).WorkPools(uuid.Nil, uuid.Nil)
+			require.NoError(t, err)
+
+			var names []string
+			for pool, err := range workPools.Iterate(context.Background(), api.WorkPoolFilter{}, tt.opts) {
+				require.NoError(t, err)
+
+				names = append(names, pool.Name)
+				if len(names) == tt.stopAfter {
+					break
+				}
+			}
+
+			require.Len(t, names, tt.wantCount)
+			assert.Equal(t, tt.wantFirst, names[0])
+			assert.Equal(t, tt.wantRequests, requests())
+		})
+	}
+}
+
+func TestIterateReportsPageErrors(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_, _ = w.Write([]byte(`{"detail": "invalid filter"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	workPools, err := newClient(t, server).WorkPools(uuid.Nil, uuid.Nil)
+	require.NoError(t, err)
+
+	_, err = api.Collect(workPools.Iterate(context.Background(), api.WorkPoolFilter{}, api.ListOptions{Offset: 20}))
+	require.ErrorContains(t, err, "failed to list page at offset 20")
+}
@@ -0,0 +1,80 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+)
+
+// ServerInfo returns the server flavor, version and plan detected by
+// DetectServer, or an unknown ServerInfo if detection has not run.
+func (c *Client) ServerInfo() api.ServerInfo {
+	return c.serverInfo
+}
+
+// serverDetectionTimeout bounds the single attempt DetectServer makes, as it
+// runs every time the provider is configured.
+const serverDetectionTimeout = 5 * time.Second
+
+// DetectServer probes the Prefect API to detect whether it is Prefect Cloud
+// or a self-hosted server, and stores the result for ServerInfo.
+//
+// Prefect Cloud is detected from the client's mode or, in auto mode, from a
+// configured default account, whose plan is then read. Otherwise, the
+// server's version endpoint is queried. If detection fails, the flavor is left unknown and the error
+// is returned, so that the caller can decide whether it matters.
+//
+// Requests are made once, without retries, and time out after a few seconds,
+// so that an unreachable endpoint does not delay every plan.
+func (c *Client) DetectServer(ctx context.Context) (api.ServerInfo, error) {
+	probe := &http.Client{Transport: c.throttle, Timeout: serverDetectionTimeout}
+
+	if c.mode == ModeCloud || (c.mode == ModeAuto && c.defaultAccountID != uuid.Nil) {
+		c.serverInfo = api.ServerInfo{Flavor: api.ServerFlavorCloud}
+
+		if c.defaultAccountID == uuid.Nil {
+			return c.serverInfo, nil
+		}
+
+		accounts := &AccountsClient{
+			hc:           probe,
+			apiKey:       c.apiKey,
+			basicAuthKey: c.basicAuthKey,
+			routePrefix:  getAccountScopedURL(c.endpoint, c.defaultAccountID, ""),
+		}
+
+		account, err := accounts.Get(ctx)
+		if err != nil {
+			return c.serverInfo, fmt.Errorf("unable to detect the Prefect Cloud plan: %w", err)
+		}
+
+		c.serverInfo.PlanType = account.PlanType
+
+		return c.serverInfo, nil
+	}
+
+	cfg := requestConfig{
+		method:       http.MethodGet,
+		url:          c.endpoint + "/admin/version",
+		body:         http.NoBody,
+		apiKey:       c.apiKey,
+		basicAuthKey: c.basicAuthKey,
+		successCodes: successCodesStatusOK,
+	}
+
+	var version string
+	if err := requestWithDecodeResponse(ctx, probe, cfg, &version); err != nil {
+		c.serverInfo = api.ServerInfo{}
+
+		return c.serverInfo, fmt.Errorf("unable to detect the Prefect server version: %w", err)
+	}
+
+	c.serverInfo = api.ServerInfo{Flavor: api.ServerFlavorOSS, Version: version}
+
+	return c.serverInfo, nil
+}
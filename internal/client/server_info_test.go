@@ -0,0 +1,109 @@
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetectServer(t *testing.T) {
+	t.Parallel()
+
+	accountID := uuid.New()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/api/admin/version":
+			_, _ = w.Write([]byte(`"3.1.4"`))
+		case "/api/accounts/" + accountID.String() + "/":
+			_, _ = w.Write([]byte(`{"plan_type": "PRO"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"detail": "Not Found"}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	tests := []struct {
+		name    string
+		opts    []client.Option
+		want    api.ServerInfo
+		wantErr bool
+	}{
+		{
+			name: "self-hosted server",
+			want: api.ServerInfo{Flavor: api.ServerFlavorOSS, Version: "3.1.4"},
+		},
+		{
+			name: "Prefect Cloud account",
+			opts: []client.Option{client.WithDefaults(accountID, uuid.New())},
+			want: api.ServerInfo{Flavor: api.ServerFlavorCloud, PlanType: "PRO"},
+		},
+		{
+			name:    "Prefect Cloud account that cannot be read",
+			opts:    []client.Option{client.WithDefaults(uuid.New(), uuid.New())},
+			want:    api.ServerInfo{Flavor: api.ServerFlavorCloud},
+			wantErr: true,
+		},
+		{
+			name:    "unknown server",
+			opts:    []client.Option{client.WithEndpoint(server.URL+"/other/api", server.URL)},
+			want:    api.ServerInfo{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			prefectClient := newClient(t, server, tt.opts...)
+			assert.Equal(t, api.ServerInfo{}, prefectClient.ServerInfo())
+
+			info, err := prefectClient.DetectServer(context.Background())
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.want, info)
+			assert.Equal(t, tt.want, prefectClient.ServerInfo())
+		})
+	}
+}
+
+func TestDetectServerIsNotRetried(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		opts []client.Option
+	}{
+		{name: "self-hosted server"},
+		{name: "Prefect Cloud account", opts: []client.Option{client.WithDefaults(uuid.New(), uuid.New())}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server, count := newCountingServer(t, nil, http.StatusServiceUnavailable)
+			prefectClient := newClient(t, server, tt.opts...)
+
+			_, err := prefectClient.DetectServer(context.Background())
+			require.Error(t, err)
+			assert.Equal(t, int32(1), count.Load())
+			assert.Empty(t, prefectClient.ServerInfo().PlanType)
+		})
+	}
+}
@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -60,25 +61,32 @@ func (sa *ServiceAccountsClient) Create(ctx context.Context, request api.Service
 	return &serviceAccount, nil
 }
 
+// List returns the service accounts with the given names, or all service accounts
+// if none are given. Every page of results is fetched.
 func (sa *ServiceAccountsClient) List(ctx context.Context, names []string) ([]*api.ServiceAccount, error) {
-	filter := api.ServiceAccountFilter{}
-	filter.ServiceAccounts.Name.Any = names
+	serviceAccounts, err := api.Collect(sa.Iterate(ctx, names, api.ListOptions{}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list service accounts: %w", err)
+	}
+
+	return serviceAccounts, nil
+}
+
+// Iterate returns an iterator over the service accounts with the given names,
+// or all service accounts if none are given, fetching one page at a time.
+func (sa *ServiceAccountsClient) Iterate(ctx context.Context, names []string, opts api.ListOptions) iter.Seq2[*api.ServiceAccount, error] {
+	filterQuery := api.ServiceAccountFilter{}
+	filterQuery.ServiceAccounts.Name.Any = names
 
 	cfg := requestConfig{
 		method:       http.MethodPost,
 		url:          sa.routePrefix + "/filter",
-		body:         &filter,
 		apiKey:       sa.apiKey,
 		basicAuthKey: sa.basicAuthKey,
 		successCodes: successCodesStatusOK,
 	}
 
-	var serviceAccounts []*api.ServiceAccount
-	if err := requestWithDecodeResponse(ctx, sa.hc, cfg, &serviceAccounts); err != nil {
-		return nil, fmt.Errorf("failed to list service accounts: %w", err)
-	}
-
-	return serviceAccounts, nil
+	return iterate[*api.ServiceAccount](ctx, sa.hc, cfg, &filterQuery, opts)
 }
 
 func (sa *ServiceAccountsClient) Get(ctx context.Context, botID string) (*api.ServiceAccount, error) {
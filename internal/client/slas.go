@@ -31,14 +31,14 @@ func (c *Client) SLAs(accountID uuid.UUID, workspaceID uuid.UUID) (api.SLAsClien
 		workspaceID = c.defaultWorkspaceID
 	}
 
-	if err := validateCloudEndpoint(c.endpoint, accountID, workspaceID); err != nil {
+	if err := validateCloudEndpoint(c.mode, accountID, workspaceID); err != nil {
 		return nil, err
 	}
 
 	return &SLAsClient{
 		hc:          c.hc,
 		apiKey:      c.apiKey,
-		routePrefix: getWorkspaceScopedURL(c.endpoint, accountID, workspaceID, "slas"),
+		routePrefix: getWorkspaceScopedURL(c.endpoint, c.mode, accountID, workspaceID, "slas"),
 	}, nil
 }
 
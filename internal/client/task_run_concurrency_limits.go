@@ -31,13 +31,13 @@ func (c *Client) TaskRunConcurrencyLimits(accountID uuid.UUID, workspaceID uuid.
 		workspaceID = c.defaultWorkspaceID
 	}
 
-	if err := validateCloudEndpoint(c.endpoint, accountID, workspaceID); err != nil {
+	if err := validateCloudEndpoint(c.mode, accountID, workspaceID); err != nil {
 		return nil, err
 	}
 
 	return &TaskRunConcurrencyLimitsClient{
 		hc:           c.hc,
-		routePrefix:  getWorkspaceScopedURL(c.endpoint, accountID, workspaceID, "concurrency_limits"),
+		routePrefix:  getWorkspaceScopedURL(c.endpoint, c.mode, accountID, workspaceID, "concurrency_limits"),
 		apiKey:       c.apiKey,
 		basicAuthKey: c.basicAuthKey,
 	}, nil
@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -117,24 +118,30 @@ func (c *TeamsClient) Delete(ctx context.Context, teamID string) error {
 	return nil
 }
 
-// List returns a list of teams, based on the provided filter.
+// List returns the teams with the given names, or all teams
+// if none are given. Every page of results is fetched.
 func (c *TeamsClient) List(ctx context.Context, names []string) ([]*api.Team, error) {
+	teams, err := api.Collect(c.Iterate(ctx, names, api.ListOptions{}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list teams: %w", err)
+	}
+
+	return teams, nil
+}
+
+// Iterate returns an iterator over the teams with the given names,
+// or all teams if none are given, fetching one page at a time.
+func (c *TeamsClient) Iterate(ctx context.Context, names []string, opts api.ListOptions) iter.Seq2[*api.Team, error] {
 	filterQuery := api.TeamFilter{}
 	filterQuery.Teams.Name.Any = names
 
 	cfg := requestConfig{
 		method:       http.MethodPost,
-		url:          fmt.Sprintf("%s/filter", c.routePrefix),
-		body:         &filterQuery,
+		url:          c.routePrefix + "/filter",
 		apiKey:       c.apiKey,
 		basicAuthKey: c.basicAuthKey,
 		successCodes: successCodesStatusOK,
 	}
 
-	var teams []*api.Team
-	if err := requestWithDecodeResponse(ctx, c.hc, cfg, &teams); err != nil {
-		return nil, fmt.Errorf("failed to list teams: %w", err)
-	}
-
-	return teams, nil
+	return iterate[*api.Team](ctx, c.hc, cfg, &filterQuery, opts)
 }
@@ -0,0 +1,144 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/time/rate"
+)
+
+// throttledTransport is an http.RoundTripper that limits the rate and
+// concurrency of requests sent through it.
+//
+// It wraps the transport used by the retryable client, so every attempt,
+// including retries, counts towards the limits. Because all sub-clients share
+// the root Client's transport, the limits apply across the whole provider.
+type throttledTransport struct {
+	next http.RoundTripper
+
+	// limiter is a token bucket limiting requests per second.
+	// A nil limiter means no rate limit.
+	limiter *rate.Limiter
+
+	// inFlight is a semaphore limiting concurrent requests.
+	// A nil channel means no concurrency limit.
+	inFlight chan struct{}
+}
+
+// RoundTrip waits for a concurrency slot and a rate limit token
+// before sending the request.
+func (t *throttledTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	release, err := t.acquire(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := t.wait(ctx, req); err != nil {
+		release()
+
+		return nil, err
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		release()
+
+		//nolint:wrapcheck // the error is wrapped by the caller
+		return nil, err
+	}
+
+	// Hold the concurrency slot until the caller is done with the body,
+	// so that slow downloads still count as in flight.
+	resp.Body = &releasingBody{ReadCloser: resp.Body, release: release}
+
+	return resp, nil
+}
+
+// acquire takes a slot from the in-flight semaphore, blocking until one is
+// available. It returns a function that releases the slot exactly once.
+func (t *throttledTransport) acquire(ctx context.Context, req *http.Request) (func(), error) {
+	if t.inFlight == nil {
+		return func() {}, nil
+	}
+
+	release := sync.OnceFunc(func() { <-t.inFlight })
+
+	select {
+	case t.inFlight <- struct{}{}:
+		return release, nil
+	default:
+	}
+
+	start := time.Now()
+
+	select {
+	case t.inFlight <- struct{}{}:
+		tflog.Debug(ctx, "Waited for a free API request slot", map[string]any{
+			"method":                  req.Method,
+			"url":                     req.URL.Redacted(),
+			"max_concurrent_requests": cap(t.inFlight),
+			"wait":                    time.Since(start).String(),
+		})
+
+		return release, nil
+	case <-ctx.Done():
+		return nil, fmt.Errorf("waiting for a free API request slot: %w", ctx.Err())
+	}
+}
+
+// wait blocks until the rate limiter allows the request to be sent.
+func (t *throttledTransport) wait(ctx context.Context, req *http.Request) error {
+	if t.limiter == nil {
+		return nil
+	}
+
+	reservation := t.limiter.Reserve()
+	if !reservation.OK() {
+		return fmt.Errorf("API rate limit of %v requests per second with a burst of %d cannot be satisfied", t.limiter.Limit(), t.limiter.Burst())
+	}
+
+	delay := reservation.Delay()
+	if delay == 0 {
+		return nil
+	}
+
+	tflog.Debug(ctx, "Throttling API request to respect the client rate limit", map[string]any{
+		"method":     req.Method,
+		"url":        req.URL.Redacted(),
+		"rate_limit": float64(t.limiter.Limit()),
+		"burst":      t.limiter.Burst(),
+		"wait":       delay.String(),
+	})
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		reservation.Cancel()
+
+		return fmt.Errorf("waiting for the API rate limit: %w", ctx.Err())
+	}
+}
+
+// releasingBody releases a concurrency slot when the response body is closed.
+type releasingBody struct {
+	io.ReadCloser
+	release func()
+}
+
+func (b *releasingBody) Close() error {
+	defer b.release()
+
+	//nolint:wrapcheck // the error is wrapped by the caller
+	return b.ReadCloser.Close()
+}
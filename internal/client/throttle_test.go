@@ -0,0 +1,134 @@
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/prefecthq/terraform-provider-prefect/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaxConcurrentRequests(t *testing.T) {
+	t.Parallel()
+
+	const maxConcurrentRequests = 2
+
+	var inFlight, peak atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+
+		for {
+			previous := peak.Load()
+			if current <= previous || peak.CompareAndSwap(previous, current) {
+				break
+			}
+		}
+
+		time.Sleep(20 * time.Millisecond)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(server.Close)
+
+	prefectClient := newClient(t, server, client.WithMaxConcurrentRequests(maxConcurrentRequests))
+
+	workPools, err := prefectClient.WorkPools(uuid.Nil, uuid.Nil)
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			_, err := workPools.Get(context.Background(), "pool")
+			assert.NoError(t, err)
+		}()
+	}
+
+	wg.Wait()
+
+	assert.Equal(t, int32(maxConcurrentRequests), peak.Load())
+}
+
+func TestRateLimit(t *testing.T) {
+	t.Parallel()
+
+	server, count := newCountingServer(t, nil, http.StatusOK)
+	prefectClient := newClient(t, server, client.WithRateLimit(20, 1))
+
+	workPools, err := prefectClient.WorkPools(uuid.Nil, uuid.Nil)
+	require.NoError(t, err)
+
+	start := time.Now()
+
+	for range 3 {
+		_, err := workPools.Get(context.Background(), "pool")
+		require.NoError(t, err)
+	}
+
+	// With a burst of 1, the first request is sent immediately and the
+	// next two each wait 50ms for a token.
+	assert.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+	assert.Equal(t, int32(3), count.Load())
+}
+
+func TestRateLimitRespectsContext(t *testing.T) {
+	t.Parallel()
+
+	server, count := newCountingServer(t, nil, http.StatusOK)
+	prefectClient := newClient(t, server, client.WithRateLimit(0.1, 1))
+
+	workPools, err := prefectClient.WorkPools(uuid.Nil, uuid.Nil)
+	require.NoError(t, err)
+
+	_, err = workPools.Get(context.Background(), "pool")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err = workPools.Get(ctx, "pool")
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, int32(1), count.Load())
+}
+
+func TestThrottleOptionsValidation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		opt     client.Option
+		wantErr bool
+	}{
+		{name: "negative rate", opt: client.WithRateLimit(-1, 1), wantErr: true},
+		{name: "zero burst", opt: client.WithRateLimit(1, 0), wantErr: true},
+		{name: "disabled rate ignores burst", opt: client.WithRateLimit(0, 0), wantErr: false},
+		{name: "negative concurrency", opt: client.WithMaxConcurrentRequests(-1), wantErr: true},
+		{name: "unlimited concurrency", opt: client.WithMaxConcurrentRequests(0), wantErr: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := client.New(tt.opt)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
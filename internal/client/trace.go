@@ -0,0 +1,286 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// redactedValue replaces sensitive values in traced requests and responses.
+const redactedValue = "<redacted>"
+
+// sensitiveBodyKeys are JSON object keys whose values are never logged.
+// This covers block document data, which holds secrets such as credentials,
+// and API keys returned by ServiceAccountsClient.RotateKey and
+// UsersClient.CreateAPIKey.
+var sensitiveBodyKeys = map[string]struct{}{
+	"data":     {},
+	"key":      {},
+	"password": {},
+	"secret":   {},
+	"token":    {},
+}
+
+// sensitivePathBodyKeys are JSON object keys whose values are never logged
+// in requests to and responses from API paths containing the given segment.
+// These keys are too common to redact everywhere, but variable values may
+// hold secrets set with the variable resource's value_wo attribute.
+var sensitivePathBodyKeys = map[string][]string{
+	"/variables": {"value"},
+}
+
+// sensitiveHeaders are the HTTP headers whose values are never logged,
+// in addition to any extra headers configured with WithExtraHeaders.
+var sensitiveHeaders = []string{"Authorization", "Cookie", "Set-Cookie"}
+
+// requestTrace tracks a single API request across all of its retries.
+type requestTrace struct {
+	attempts atomic.Int32
+}
+
+// requestTraceKey is the context key used to pass a requestTrace
+// from request() to the transport.
+type requestTraceKey struct{}
+
+// requestTraceFromContext returns the requestTrace for the current request, if any.
+func requestTraceFromContext(ctx context.Context) *requestTrace {
+	trace, _ := ctx.Value(requestTraceKey{}).(*requestTrace)
+
+	return trace
+}
+
+// CallStats counts the API requests made while serving a single provider RPC,
+// such as reading or applying a resource.
+type CallStats struct {
+	mu       sync.Mutex
+	requests int
+	attempts int
+	failures int
+	duration time.Duration
+}
+
+// callStatsKey is the context key used to store a CallStats.
+type callStatsKey struct{}
+
+// ContextWithCallStats returns a context that records every API request made
+// with it, and the CallStats those requests are recorded in.
+func ContextWithCallStats(ctx context.Context) (context.Context, *CallStats) {
+	stats := &CallStats{}
+
+	return context.WithValue(ctx, callStatsKey{}, stats), stats
+}
+
+// Requests returns the number of API requests recorded, not counting retries.
+func (s *CallStats) Requests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.requests
+}
+
+// Fields returns the recorded stats as structured log fields.
+func (s *CallStats) Fields() map[string]any {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return map[string]any{
+		"api_requests":        s.requests,
+		"api_retries":         s.attempts - s.requests,
+		"api_failed_requests": s.failures,
+		"api_duration":        s.duration.String(),
+	}
+}
+
+// recordCallStats adds a finished request to the CallStats in the context, if any.
+func recordCallStats(ctx context.Context, trace *requestTrace, duration time.Duration, success bool) {
+	stats, ok := ctx.Value(callStatsKey{}).(*CallStats)
+	if !ok {
+		return
+	}
+
+	stats.mu.Lock()
+	defer stats.mu.Unlock()
+
+	stats.requests++
+	stats.attempts += max(int(trace.attempts.Load()), 1)
+	stats.duration += duration
+
+	if !success {
+		stats.failures++
+	}
+}
+
+// tracingTransport is an http.RoundTripper that counts the attempts made for
+// each request and, when enabled, logs every attempt at TRACE level with
+// sensitive headers and body fields redacted.
+type tracingTransport struct {
+	next    http.RoundTripper
+	enabled bool
+
+	// sensitiveHeaders are the headers whose values are redacted.
+	sensitiveHeaders []string
+}
+
+// RoundTrip sends the request, logging it and its response if tracing is enabled.
+func (t *tracingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	attempt := 1
+	if trace := requestTraceFromContext(ctx); trace != nil {
+		attempt = int(trace.attempts.Add(1))
+	}
+
+	if !t.enabled {
+		//nolint:wrapcheck // the error is wrapped by the caller
+		return t.next.RoundTrip(req)
+	}
+
+	fields := map[string]any{
+		"method":          req.Method,
+		"url":             req.URL.Redacted(),
+		"attempt":         attempt,
+		"request_headers": redactHeaders(req.Header, t.sensitiveHeaders),
+	}
+
+	pathKeys := pathBodyKeys(req.URL.Path)
+
+	if req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			data, _ := io.ReadAll(body)
+			_ = body.Close()
+
+			fields["request_body"] = redactBody(data, pathKeys)
+		}
+	}
+
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	fields["duration"] = time.Since(start).String()
+
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.Trace(ctx, "Prefect API request failed", fields)
+
+		//nolint:wrapcheck // the error is wrapped by the caller
+		return nil, err
+	}
+
+	// Buffer the response body so it can be logged and still read by the caller.
+	data, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(data))
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	fields["status"] = resp.StatusCode
+	fields["response_headers"] = redactHeaders(resp.Header, t.sensitiveHeaders)
+	fields["response_body"] = redactBody(data, pathKeys)
+	tflog.Trace(ctx, "Prefect API request", fields)
+
+	return resp, nil
+}
+
+// redactHeaders flattens HTTP headers into a map for logging,
+// replacing the values of sensitive headers.
+func redactHeaders(headers http.Header, sensitiveHeaders []string) map[string]string {
+	redacted := make(map[string]string, len(headers))
+	for name, values := range headers {
+		redacted[name] = strings.Join(values, ", ")
+	}
+
+	for _, name := range sensitiveHeaders {
+		name = http.CanonicalHeaderKey(name)
+		if _, ok := redacted[name]; ok {
+			redacted[name] = redactedValue
+		}
+	}
+
+	return redacted
+}
+
+// pathBodyKeys returns the sensitive body keys specific to an API path.
+func pathBodyKeys(path string) map[string]struct{} {
+	keys := map[string]struct{}{}
+
+	for segment, segmentKeys := range sensitivePathBodyKeys {
+		if strings.Contains(path, segment) {
+			for _, key := range segmentKeys {
+				keys[key] = struct{}{}
+			}
+		}
+	}
+
+	return keys
+}
+
+// redactBody returns a JSON body for logging, with the values of sensitive
+// keys, and of the given path-specific keys, replaced at any depth. Bodies
+// that are not valid JSON are omitted, as they cannot be reliably redacted.
+func redactBody(body []byte, pathKeys map[string]struct{}) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	var value any
+	if err := json.Unmarshal(body, &value); err != nil {
+		return fmt.Sprintf("<%d bytes of non-JSON body omitted>", len(body))
+	}
+
+	redactValue(value, pathKeys)
+
+	var redacted bytes.Buffer
+
+	encoder := json.NewEncoder(&redacted)
+	encoder.SetEscapeHTML(false)
+
+	if err := encoder.Encode(value); err != nil {
+		return fmt.Sprintf("<%d bytes of body omitted>", len(body))
+	}
+
+	return strings.TrimSuffix(redacted.String(), "\n")
+}
+
+// redactValue replaces the values of sensitive keys, and of the given
+// path-specific keys, in a decoded JSON value, in place.
+func redactValue(value any, pathKeys map[string]struct{}) {
+	switch typed := value.(type) {
+	case map[string]any:
+		for key, child := range typed {
+			if isSensitiveBodyKey(key, pathKeys) && child != nil {
+				typed[key] = redactedValue
+
+				continue
+			}
+
+			redactValue(child, pathKeys)
+		}
+	case []any:
+		for _, child := range typed {
+			redactValue(child, pathKeys)
+		}
+	}
+}
+
+// isSensitiveBodyKey reports whether the value of a JSON object key must be redacted.
+func isSensitiveBodyKey(key string, pathKeys map[string]struct{}) bool {
+	key = strings.ToLower(key)
+
+	if _, ok := sensitiveBodyKeys[key]; ok {
+		return true
+	}
+
+	_, ok := pathKeys[key]
+
+	return ok
+}
@@ -0,0 +1,166 @@
+package client_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPTracingRedactsSensitiveValues(t *testing.T) {
+	t.Parallel()
+
+	const (
+		apiKey     = "pnu_secretapikey"
+		secret     = "hunter2"
+		createdKey = "pnu_newlycreatedkey"
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		// Echo the block document back, as the API does.
+		_, _ = w.Write(bytes.Replace(body, []byte(`{`), []byte(`{"key": "`+createdKey+`", `), 1))
+	}))
+	t.Cleanup(server.Close)
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	prefectClient := newClient(t, server, client.WithAPIKey(apiKey), client.WithHTTPTracing(true))
+
+	blockDocuments, err := prefectClient.BlockDocuments(uuid.Nil, uuid.Nil)
+	require.NoError(t, err)
+
+	created, err := blockDocuments.Create(ctx, api.BlockDocumentCreate{
+		Name: "credentials",
+		Data: map[string]any{"password": secret},
+	})
+	require.NoError(t, err)
+
+	// The caller still receives the full response.
+	assert.Equal(t, secret, created.Data["password"])
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	entry := entries[0]
+	assert.Equal(t, "trace", entry["@level"])
+	assert.Equal(t, "POST", entry["method"])
+	assert.InDelta(t, 1, entry["attempt"], 0)
+	assert.InDelta(t, http.StatusCreated, entry["status"], 0)
+	assert.Contains(t, entry["request_body"], `"name":"credentials"`)
+	assert.Contains(t, entry["request_body"], `"data":"<redacted>"`)
+	assert.Contains(t, entry["response_body"], `"key":"<redacted>"`)
+
+	assert.NotContains(t, output.String(), secret)
+	assert.NotContains(t, output.String(), apiKey)
+	assert.NotContains(t, output.String(), createdKey)
+}
+
+func TestHTTPTracingRedactsVariableValues(t *testing.T) {
+	t.Parallel()
+
+	const secret = "s3cr3t-variable-value"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		// Echo the variable back, as the API does.
+		_, _ = w.Write(body)
+	}))
+	t.Cleanup(server.Close)
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	prefectClient := newClient(t, server, client.WithHTTPTracing(true))
+
+	variables, err := prefectClient.Variables(uuid.Nil, uuid.Nil)
+	require.NoError(t, err)
+
+	created, err := variables.Create(ctx, api.VariableCreate{
+		Name:  "password",
+		Value: map[string]any{"token": secret, "nested": secret},
+	})
+	require.NoError(t, err)
+
+	// The caller still receives the full response.
+	assert.Equal(t, map[string]any{"token": secret, "nested": secret}, created.Value)
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	entry := entries[0]
+	assert.Contains(t, entry["request_body"], `"name":"password"`)
+	assert.Contains(t, entry["request_body"], `"value":"<redacted>"`)
+	assert.Contains(t, entry["response_body"], `"value":"<redacted>"`)
+
+	assert.NotContains(t, output.String(), secret)
+}
+
+func TestHTTPTracingDisabledByDefault(t *testing.T) {
+	t.Parallel()
+
+	server, _ := newCountingServer(t, nil, http.StatusOK)
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	prefectClient := newClient(t, server)
+
+	workPools, err := prefectClient.WorkPools(uuid.Nil, uuid.Nil)
+	require.NoError(t, err)
+
+	_, err = workPools.Get(ctx, "pool")
+	require.NoError(t, err)
+
+	assert.Empty(t, output.String())
+}
+
+func TestCallStats(t *testing.T) {
+	t.Parallel()
+
+	server, _ := newCountingServer(t, nil, http.StatusInternalServerError, http.StatusOK)
+	prefectClient := newClient(t, server)
+
+	workPools, err := prefectClient.WorkPools(uuid.Nil, uuid.Nil)
+	require.NoError(t, err)
+
+	ctx, stats := client.ContextWithCallStats(context.Background())
+
+	// The first request fails once and is retried, the second succeeds.
+	_, err = workPools.Get(ctx, "pool")
+	require.NoError(t, err)
+
+	_, err = workPools.Get(ctx, "pool")
+	require.NoError(t, err)
+
+	// Requests made without the context are not recorded.
+	_, err = workPools.Get(context.Background(), "pool")
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, stats.Requests())
+
+	fields := stats.Fields()
+	assert.Equal(t, 2, fields["api_requests"])
+	assert.Equal(t, 1, fields["api_retries"])
+	assert.Equal(t, 0, fields["api_failed_requests"])
+}
@@ -0,0 +1,32 @@
+package client
+
+import (
+	"net/http"
+)
+
+// headerTransport is an http.RoundTripper that adds static extra headers
+// to every request.
+type headerTransport struct {
+	next http.RoundTripper
+
+	// extra holds the headers to add. Headers already set on the
+	// request, such as Authorization, are not overridden.
+	extra http.Header
+}
+
+// RoundTrip adds the extra headers to a copy of the request and sends it.
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if len(t.extra) > 0 {
+		// A RoundTripper must not modify the request it is given.
+		req = req.Clone(req.Context())
+
+		for name, values := range t.extra {
+			if req.Header.Get(name) == "" {
+				req.Header[name] = values
+			}
+		}
+	}
+
+	//nolint:wrapcheck // the error is wrapped by the caller
+	return t.next.RoundTrip(req)
+}
@@ -0,0 +1,310 @@
+package client_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/prefecthq/terraform-provider-prefect/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testCertificate is a certificate and key, in both parsed and PEM form.
+type testCertificate struct {
+	cert    *x509.Certificate
+	key     *ecdsa.PrivateKey
+	certPEM []byte
+	keyPEM  []byte
+}
+
+// newTestCertificate creates a certificate signed by the parent,
+// or a self-signed CA if the parent is nil.
+func newTestCertificate(t *testing.T, parent *testCertificate, template *x509.Certificate) *testCertificate {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template.SerialNumber = big.NewInt(time.Now().UnixNano())
+	template.NotBefore = time.Now().Add(-time.Hour)
+	template.NotAfter = time.Now().Add(time.Hour)
+
+	signer, signerKey := template, key
+	if parent != nil {
+		signer, signerKey = parent.cert, parent.key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, signer, &key.PublicKey, signerKey)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	return &testCertificate{
+		cert:    cert,
+		key:     key,
+		certPEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		keyPEM:  pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+	}
+}
+
+// newTestPKI creates a private CA, and a server and client certificate signed by it.
+func newTestPKI(t *testing.T) (ca, server, clientCert *testCertificate) {
+	t.Helper()
+
+	ca = newTestCertificate(t, nil, &x509.Certificate{
+		Subject:               pkix.Name{CommonName: "Test CA"},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	})
+
+	server = newTestCertificate(t, ca, &x509.Certificate{
+		Subject:     pkix.Name{CommonName: "prefect.internal"},
+		IPAddresses: []net.IP{net.ParseIP("127.0.0.1")},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	})
+
+	clientCert = newTestCertificate(t, ca, &x509.Certificate{
+		Subject:     pkix.Name{CommonName: "terraform"},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+
+	return ca, server, clientCert
+}
+
+// newGatewayServer starts a TLS server that, like an internal gateway,
+// requires a client certificate issued by the CA, basic auth and a routing header.
+func newGatewayServer(t *testing.T, ca, serverCert *testCertificate, basicAuthKey string) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Basic "+base64.StdEncoding.EncodeToString([]byte(basicAuthKey)) {
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		if r.Header.Get("X-Gateway-Route") != "prefect" {
+			w.WriteHeader(http.StatusBadGateway)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+
+	certificate, err := tls.X509KeyPair(serverCert.certPEM, serverCert.keyPEM)
+	require.NoError(t, err)
+
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(ca.cert)
+
+	server.TLS = &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    clientCAs,
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestTransportOptionsWithGateway(t *testing.T) {
+	t.Parallel()
+
+	const basicAuthKey = "admin:password"
+
+	ca, serverCert, clientCert := newTestPKI(t)
+	server := newGatewayServer(t, ca, serverCert, basicAuthKey)
+
+	extraHeaders := client.WithExtraHeaders(map[string]string{
+		"X-Gateway-Route": "prefect",
+		// The client's own Authorization header takes priority.
+		"Authorization": "Bearer ignored",
+	})
+
+	tests := []struct {
+		name    string
+		opts    []client.Option
+		wantErr bool
+	}{
+		{
+			name: "private CA, client certificate and extra headers",
+			opts: []client.Option{
+				client.WithCACertificates(ca.certPEM),
+				client.WithClientCertificate(clientCert.certPEM, clientCert.keyPEM),
+				extraHeaders,
+			},
+		},
+		{
+			name: "insecure skip verify instead of private CA",
+			opts: []client.Option{
+				client.WithInsecureSkipVerify(true),
+				client.WithClientCertificate(clientCert.certPEM, clientCert.keyPEM),
+				extraHeaders,
+			},
+		},
+		{
+			name: "missing private CA",
+			opts: []client.Option{
+				client.WithClientCertificate(clientCert.certPEM, clientCert.keyPEM),
+				extraHeaders,
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing client certificate",
+			opts: []client.Option{
+				client.WithCACertificates(ca.certPEM),
+				extraHeaders,
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing extra headers",
+			opts: []client.Option{
+				client.WithCACertificates(ca.certPEM),
+				client.WithClientCertificate(clientCert.certPEM, clientCert.keyPEM),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			prefectClient := newClient(t, server, append([]client.Option{
+				client.WithBasicAuthKey(basicAuthKey),
+				client.WithRetryPolicy(0, 0, 0),
+			}, tt.opts...)...)
+
+			workPools, err := prefectClient.WorkPools(uuid.Nil, uuid.Nil)
+			require.NoError(t, err)
+
+			_, err = workPools.Get(context.Background(), "pool")
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestProxy(t *testing.T) {
+	t.Parallel()
+
+	var proxiedHost atomic.Value
+
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxiedHost.Store(r.URL.Host)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(proxy.Close)
+
+	prefectClient, err := client.New(
+		client.WithEndpoint("http://prefect.internal/api", "http://prefect.internal"),
+		client.WithProxy(proxy.URL),
+	)
+	require.NoError(t, err)
+
+	workPools, err := prefectClient.WorkPools(uuid.Nil, uuid.Nil)
+	require.NoError(t, err)
+
+	_, err = workPools.Get(context.Background(), "pool")
+	require.NoError(t, err)
+
+	assert.Equal(t, "prefect.internal", proxiedHost.Load())
+}
+
+func TestRequestTimeoutIsRetried(t *testing.T) {
+	t.Parallel()
+
+	var count atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Only the first attempt is slow.
+		if count.Add(1) == 1 {
+			select {
+			case <-time.After(time.Second):
+			case <-r.Context().Done():
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(server.Close)
+
+	prefectClient := newClient(t, server, client.WithRequestTimeout(50*time.Millisecond))
+
+	workPools, err := prefectClient.WorkPools(uuid.Nil, uuid.Nil)
+	require.NoError(t, err)
+
+	_, err = workPools.Get(context.Background(), "pool")
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), count.Load())
+}
+
+func TestTransportOptionsValidation(t *testing.T) {
+	t.Parallel()
+
+	_, _, clientCert := newTestPKI(t)
+
+	tests := []struct {
+		name string
+		opt  client.Option
+	}{
+		{name: "invalid CA bundle", opt: client.WithCACertificates([]byte("not a certificate"))},
+		{name: "client certificate without key", opt: client.WithClientCertificate(clientCert.certPEM, nil)},
+		{name: "mismatched client key", opt: client.WithClientCertificate(clientCert.certPEM, newTestPKIKey(t))},
+		{name: "unsupported proxy scheme", opt: client.WithProxy("ftp://proxy.internal")},
+		{name: "negative request timeout", opt: client.WithRequestTimeout(-time.Second)},
+		{name: "invalid header name", opt: client.WithExtraHeaders(map[string]string{"X Route": "prefect"})},
+		{name: "invalid header value", opt: client.WithExtraHeaders(map[string]string{"X-Route": "pre\nfect"})},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := client.New(tt.opt)
+			require.Error(t, err)
+		})
+	}
+}
+
+// newTestPKIKey returns a PEM-encoded key that matches no certificate.
+func newTestPKIKey(t *testing.T) []byte {
+	t.Helper()
+
+	_, _, unrelated := newTestPKI(t)
+
+	return unrelated.keyPEM
+}
@@ -4,16 +4,48 @@ import (
 	"net/http"
 
 	"github.com/google/uuid"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+
+	retryablehttp "github.com/hashicorp/go-retryablehttp"
 )
 
 type Client struct {
 	hc                 *http.Client
+	retryClient        *retryablehttp.Client
+	transport          *http.Transport
+	throttle           *throttledTransport
+	tracing            *tracingTransport
+	headers            *headerTransport
 	endpoint           string
 	endpointHost       string
+	mode               Mode
+	cloudHosts         []string
 	apiKey             string
 	basicAuthKey       string
 	defaultAccountID   uuid.UUID
 	defaultWorkspaceID uuid.UUID
+	serverInfo         api.ServerInfo
+	adoptExisting      bool
+	cache              *cache
 }
 
 type Option func(c *Client) error
+
+// Mode is the kind of Prefect API the client addresses, which determines
+// how account- and workspace-scoped URLs are built.
+type Mode string
+
+const (
+	// ModeAuto detects Prefect Cloud from the endpoint host. For other hosts,
+	// URLs are scoped to an account and workspace only when both are set.
+	ModeAuto Mode = "auto"
+
+	// ModeCloud addresses Prefect Cloud, which requires an account and
+	// workspace for every workspace-scoped URL.
+	ModeCloud Mode = "cloud"
+
+	// ModeServer addresses a self-hosted Prefect server, whose URLs are never
+	// scoped to an account or workspace.
+	ModeServer Mode = "server"
+)
@@ -8,10 +8,12 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
-	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
 )
 
 // getAccountScopedURL constructs a URL for an account-scoped route.
@@ -30,12 +32,14 @@ func getAccountScopedURL(endpoint string, accountID uuid.UUID, route string) str
 }
 
 // getWorkspaceScopedURL constructs a URL for a workspace-scoped route.
-func getWorkspaceScopedURL(endpoint string, accountID uuid.UUID, workspaceID uuid.UUID, route string) string {
+// Self-hosted servers have a single implicit workspace, so the account
+// and workspace are only included when not in server mode.
+func getWorkspaceScopedURL(endpoint string, mode Mode, accountID uuid.UUID, workspaceID uuid.UUID, route string) string {
 	var builder strings.Builder
 
 	builder.WriteString(endpoint)
 
-	if accountID != uuid.Nil && workspaceID != uuid.Nil {
+	if mode != ModeServer && accountID != uuid.Nil && workspaceID != uuid.Nil {
 		builder.WriteString("/accounts/")
 		builder.WriteString(accountID.String())
 
@@ -72,9 +76,9 @@ func setDefaultHeaders(request *http.Request, apiKey, basicAuthKey string) {
 }
 
 // validateCloudEndpoint validates that proper configuration is provided
-// when the endpoint points to Prefect Cloud.
-func validateCloudEndpoint(endpoint string, accountID, workspaceID uuid.UUID) error {
-	if helpers.IsCloudEndpoint(endpoint) && (accountID == uuid.Nil || workspaceID == uuid.Nil) {
+// when the client addresses Prefect Cloud.
+func validateCloudEndpoint(mode Mode, accountID, workspaceID uuid.UUID) error {
+	if mode == ModeCloud && (accountID == uuid.Nil || workspaceID == uuid.Nil) {
 		return fmt.Errorf("prefect Cloud endpoints require an account_id and workspace_id to be set on either the provider or the resource")
 	}
 
@@ -91,6 +95,21 @@ type requestConfig struct {
 
 	apiKey       string
 	basicAuthKey string
+
+	// retryNotFound retries 404 responses, for objects that are
+	// created asynchronously and may not be readable right away.
+	retryNotFound bool
+}
+
+// retryNotFoundKey is the context key used to pass requestConfig.retryNotFound
+// through to checkRetryPolicy.
+type retryNotFoundKey struct{}
+
+// retryNotFoundFromContext reports whether the request opted in to 404 retries.
+func retryNotFoundFromContext(ctx context.Context) bool {
+	retry, _ := ctx.Value(retryNotFoundKey{}).(bool)
+
+	return retry
 }
 
 var (
@@ -113,6 +132,10 @@ var (
 	// successCodesStatusOKOrCreated is a convenience variable to use for a common
 	// success criteria of either Status OK or StatusCreated.
 	successCodesStatusOKOrCreated = []int{http.StatusOK, http.StatusCreated}
+
+	// successCodesStatusAcceptedOrNoContent is a convenience variable to use for a common
+	// success criteria of either StatusAccepted or StatusNoContent.
+	successCodesStatusAcceptedOrNoContent = []int{http.StatusAccepted, http.StatusNoContent}
 )
 
 // request performs an HTTP request with the provided configuration.
@@ -132,6 +155,14 @@ func request(ctx context.Context, client *http.Client, cfg requestConfig) (*http
 		body = http.NoBody
 	}
 
+	if cfg.retryNotFound {
+		ctx = context.WithValue(ctx, retryNotFoundKey{}, true)
+	}
+
+	// The trace counts attempts as the request is retried.
+	trace := &requestTrace{}
+	ctx = context.WithValue(ctx, requestTraceKey{}, trace)
+
 	req, err := http.NewRequestWithContext(ctx, cfg.method, cfg.url, body)
 	if err != nil {
 		return nil, fmt.Errorf("error creating request: %w", err)
@@ -140,24 +171,21 @@ func request(ctx context.Context, client *http.Client, cfg requestConfig) (*http
 	setDefaultHeaders(req, cfg.apiKey, cfg.basicAuthKey)
 
 	// Body will be closed by the caller.
+	start := time.Now()
 	resp, err := client.Do(req)
+	success := err == nil && slices.Contains(cfg.successCodes, resp.StatusCode)
+	recordCallStats(ctx, trace, time.Since(start), success)
+
 	if err != nil {
 		return nil, fmt.Errorf("http error: %w", err)
 	}
 
-	success := false
-	for _, successCode := range cfg.successCodes {
-		if resp.StatusCode == successCode {
-			success = true
-
-			break
-		}
-	}
-
 	if !success {
+		defer resp.Body.Close()
+
 		body, _ := io.ReadAll(resp.Body)
 
-		return nil, fmt.Errorf("status code=%s, error=%s", resp.Status, body)
+		return nil, api.NewAPIError(resp.StatusCode, resp.Status, body)
 	}
 
 	return resp, nil
@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -32,7 +33,7 @@ func (c *Client) Variables(accountID uuid.UUID, workspaceID uuid.UUID) (api.Vari
 		workspaceID = c.defaultWorkspaceID
 	}
 
-	if err := validateCloudEndpoint(c.endpoint, accountID, workspaceID); err != nil {
+	if err := validateCloudEndpoint(c.mode, accountID, workspaceID); err != nil {
 		return nil, err
 	}
 
@@ -40,7 +41,7 @@ func (c *Client) Variables(accountID uuid.UUID, workspaceID uuid.UUID) (api.Vari
 		hc:           c.hc,
 		apiKey:       c.apiKey,
 		basicAuthKey: c.basicAuthKey,
-		routePrefix:  getWorkspaceScopedURL(c.endpoint, accountID, workspaceID, "variables"),
+		routePrefix:  getWorkspaceScopedURL(c.endpoint, c.mode, accountID, workspaceID, "variables"),
 	}, nil
 }
 
@@ -63,12 +64,29 @@ func (c *VariablesClient) Create(ctx context.Context, data api.VariableCreate) (
 	return &variable, nil
 }
 
-// List returns a list of variables matching filter criteria.
+// List returns the variables matching the filter. Every page of results is fetched.
 func (c *VariablesClient) List(ctx context.Context, filter api.VariableFilter) ([]api.Variable, error) {
-	_ = ctx
-	_ = filter
+	variables, err := api.Collect(c.Iterate(ctx, filter, api.ListOptions{}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list variables: %w", err)
+	}
+
+	return variables, nil
+}
+
+// Iterate returns an iterator over the variables matching the filter, fetching one page at a time.
+func (c *VariablesClient) Iterate(ctx context.Context, filter api.VariableFilter, opts api.ListOptions) iter.Seq2[api.Variable, error] {
+	filterQuery := api.VariableFilterSettings{Variables: &filter}
+
+	cfg := requestConfig{
+		method:       http.MethodPost,
+		url:          c.routePrefix + "/filter",
+		apiKey:       c.apiKey,
+		basicAuthKey: c.basicAuthKey,
+		successCodes: successCodesStatusOK,
+	}
 
-	return nil, nil
+	return iterate[api.Variable](ctx, c.hc, cfg, &filterQuery, opts)
 }
 
 // Get returns details for a variable by ID.
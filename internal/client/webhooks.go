@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -31,7 +32,7 @@ func (c *Client) Webhooks(accountID, workspaceID uuid.UUID) (api.WebhooksClient,
 		workspaceID = c.defaultWorkspaceID
 	}
 
-	if err := validateCloudEndpoint(c.endpoint, accountID, workspaceID); err != nil {
+	if err := validateCloudEndpoint(c.mode, accountID, workspaceID); err != nil {
 		return nil, err
 	}
 
@@ -39,7 +40,7 @@ func (c *Client) Webhooks(accountID, workspaceID uuid.UUID) (api.WebhooksClient,
 		hc:           c.hc,
 		apiKey:       c.apiKey,
 		basicAuthKey: c.basicAuthKey,
-		routePrefix:  getWorkspaceScopedURL(c.endpoint, accountID, workspaceID, "webhooks"),
+		routePrefix:  getWorkspaceScopedURL(c.endpoint, c.mode, accountID, workspaceID, "webhooks"),
 	}, nil
 }
 
@@ -121,24 +122,30 @@ func (c *WebhooksClient) Delete(ctx context.Context, webhookID string) error {
 	return nil
 }
 
-// List returns a list of webhooks matching filter criteria.
+// List returns the webhooks with the given names, or all webhooks
+// if none are given. Every page of results is fetched.
 func (c *WebhooksClient) List(ctx context.Context, names []string) ([]*api.Webhook, error) {
-	filter := api.WebhookFilter{}
-	filter.Webhooks.Name.Any = names
+	webhooks, err := api.Collect(c.Iterate(ctx, names, api.ListOptions{}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhooks: %w", err)
+	}
+
+	return webhooks, nil
+}
+
+// Iterate returns an iterator over the webhooks with the given names,
+// or all webhooks if none are given, fetching one page at a time.
+func (c *WebhooksClient) Iterate(ctx context.Context, names []string, opts api.ListOptions) iter.Seq2[*api.Webhook, error] {
+	filterQuery := api.WebhookFilter{}
+	filterQuery.Webhooks.Name.Any = names
 
 	cfg := requestConfig{
-		method:       http.MethodGet,
-		url:          c.routePrefix + "/",
-		body:         http.NoBody,
-		successCodes: successCodesStatusOK,
+		method:       http.MethodPost,
+		url:          c.routePrefix + "/filter",
 		apiKey:       c.apiKey,
 		basicAuthKey: c.basicAuthKey,
+		successCodes: successCodesStatusOK,
 	}
 
-	var webhooks []*api.Webhook
-	if err := requestWithDecodeResponse(ctx, c.hc, cfg, &webhooks); err != nil {
-		return nil, fmt.Errorf("failed to list webhooks: %w", err)
-	}
-
-	return webhooks, nil
+	return iterate[*api.Webhook](ctx, c.hc, cfg, &filterQuery, opts)
 }
@@ -28,13 +28,13 @@ func (c *Client) WorkPoolAccess(accountID uuid.UUID, workspaceID uuid.UUID) (api
 		workspaceID = c.defaultWorkspaceID
 	}
 
-	if err := validateCloudEndpoint(c.endpoint, accountID, workspaceID); err != nil {
+	if err := validateCloudEndpoint(c.mode, accountID, workspaceID); err != nil {
 		return nil, err
 	}
 
 	return &WorkPoolAccessClient{
 		hc:           c.hc,
-		routePrefix:  getWorkspaceScopedURL(c.endpoint, accountID, workspaceID, "work_pools"),
+		routePrefix:  getWorkspaceScopedURL(c.endpoint, c.mode, accountID, workspaceID, "work_pools"),
 		apiKey:       c.apiKey,
 		basicAuthKey: c.basicAuthKey,
 	}, nil
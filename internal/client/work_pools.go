@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -17,6 +18,7 @@ type WorkPoolsClient struct {
 	apiKey       string
 	basicAuthKey string
 	routePrefix  string
+	cache        *cache
 }
 
 // WorkPools returns a WorkPoolsClient.
@@ -31,7 +33,7 @@ func (c *Client) WorkPools(accountID uuid.UUID, workspaceID uuid.UUID) (api.Work
 		workspaceID = c.defaultWorkspaceID
 	}
 
-	if err := validateCloudEndpoint(c.endpoint, accountID, workspaceID); err != nil {
+	if err := validateCloudEndpoint(c.mode, accountID, workspaceID); err != nil {
 		return nil, err
 	}
 
@@ -39,12 +41,15 @@ func (c *Client) WorkPools(accountID uuid.UUID, workspaceID uuid.UUID) (api.Work
 		hc:           c.hc,
 		apiKey:       c.apiKey,
 		basicAuthKey: c.basicAuthKey,
-		routePrefix:  getWorkspaceScopedURL(c.endpoint, accountID, workspaceID, "work_pools"),
+		routePrefix:  getWorkspaceScopedURL(c.endpoint, c.mode, accountID, workspaceID, "work_pools"),
+		cache:        c.cache,
 	}, nil
 }
 
 // Create returns details for a new work pool.
 func (c *WorkPoolsClient) Create(ctx context.Context, data api.WorkPoolCreate) (*api.WorkPool, error) {
+	defer c.cache.invalidate()
+
 	cfg := requestConfig{
 		method:       http.MethodPost,
 		url:          c.routePrefix + "/",
@@ -62,30 +67,50 @@ func (c *WorkPoolsClient) Create(ctx context.Context, data api.WorkPoolCreate) (
 	return &pool, nil
 }
 
-// List returns a list of work pools matching filter criteria.
+// List returns the work pools matching the filter. Every page of results is fetched.
 func (c *WorkPoolsClient) List(ctx context.Context, filter api.WorkPoolFilter) ([]*api.WorkPool, error) {
+	workPools, err := api.Collect(c.Iterate(ctx, filter, api.ListOptions{}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list work pools: %w", err)
+	}
+
+	return workPools, nil
+}
+
+// Iterate returns an iterator over the work pools matching the filter, fetching one page at a time.
+func (c *WorkPoolsClient) Iterate(ctx context.Context, filter api.WorkPoolFilter, opts api.ListOptions) iter.Seq2[*api.WorkPool, error] {
+	filterQuery := filter
+
 	cfg := requestConfig{
 		method:       http.MethodPost,
 		url:          c.routePrefix + "/filter",
-		body:         &filter,
-		successCodes: successCodesStatusOK,
 		apiKey:       c.apiKey,
 		basicAuthKey: c.basicAuthKey,
+		successCodes: successCodesStatusOK,
 	}
 
-	var pools []*api.WorkPool
-	if err := requestWithDecodeResponse(ctx, c.hc, cfg, &pools); err != nil {
-		return nil, fmt.Errorf("failed to list work pools: %w", err)
-	}
-
-	return pools, nil
+	return iterate[*api.WorkPool](ctx, c.hc, cfg, &filterQuery, opts)
 }
 
 // Get returns details for a work pool by name.
+// With the read cache enabled, each work pool is only fetched once until a write.
 func (c *WorkPoolsClient) Get(ctx context.Context, name string) (*api.WorkPool, error) {
+	url := c.routePrefix + "/" + name
+
+	pool, err := cachedRead(ctx, c.cache, url, func(ctx context.Context) (api.WorkPool, error) {
+		return c.get(ctx, url)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pool, nil
+}
+
+func (c *WorkPoolsClient) get(ctx context.Context, url string) (api.WorkPool, error) {
 	cfg := requestConfig{
 		method:       http.MethodGet,
-		url:          c.routePrefix + "/" + name,
+		url:          url,
 		successCodes: successCodesStatusOK,
 		body:         http.NoBody,
 		apiKey:       c.apiKey,
@@ -94,14 +119,16 @@ func (c *WorkPoolsClient) Get(ctx context.Context, name string) (*api.WorkPool,
 
 	var pool api.WorkPool
 	if err := requestWithDecodeResponse(ctx, c.hc, cfg, &pool); err != nil {
-		return nil, fmt.Errorf("failed to get work pool: %w", err)
+		return pool, fmt.Errorf("failed to get work pool: %w", err)
 	}
 
-	return &pool, nil
+	return pool, nil
 }
 
 // Update modifies an existing work pool by name.
 func (c *WorkPoolsClient) Update(ctx context.Context, name string, data api.WorkPoolUpdate) error {
+	defer c.cache.invalidate()
+
 	cfg := requestConfig{
 		method:       http.MethodPatch,
 		url:          c.routePrefix + "/" + name,
@@ -122,6 +149,8 @@ func (c *WorkPoolsClient) Update(ctx context.Context, name string, data api.Work
 
 // Delete removes a work pool by name.
 func (c *WorkPoolsClient) Delete(ctx context.Context, name string) error {
+	defer c.cache.invalidate()
+
 	cfg := requestConfig{
 		method:       http.MethodDelete,
 		url:          c.routePrefix + "/" + name,
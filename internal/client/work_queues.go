@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -31,7 +32,7 @@ func (c *Client) WorkQueues(accountID uuid.UUID, workspaceID uuid.UUID, workPool
 		workspaceID = c.defaultWorkspaceID
 	}
 
-	if err := validateCloudEndpoint(c.endpoint, accountID, workspaceID); err != nil {
+	if err := validateCloudEndpoint(c.mode, accountID, workspaceID); err != nil {
 		return nil, err
 	}
 
@@ -41,7 +42,7 @@ func (c *Client) WorkQueues(accountID uuid.UUID, workspaceID uuid.UUID, workPool
 		hc:           c.hc,
 		apiKey:       c.apiKey,
 		basicAuthKey: c.basicAuthKey,
-		routePrefix:  getWorkspaceScopedURL(c.endpoint, accountID, workspaceID, route),
+		routePrefix:  getWorkspaceScopedURL(c.endpoint, c.mode, accountID, workspaceID, route),
 	}, nil
 }
 
@@ -64,23 +65,29 @@ func (c *WorkQueuesClient) Create(ctx context.Context, data api.WorkQueueCreate)
 	return &queue, nil
 }
 
-// List returns a list of work queues matching filter criteria.
+// List returns the work queues matching the filter. Every page of results is fetched.
 func (c *WorkQueuesClient) List(ctx context.Context, filter api.WorkQueueFilter) ([]*api.WorkQueue, error) {
+	workQueues, err := api.Collect(c.Iterate(ctx, filter, api.ListOptions{}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list work queues: %w", err)
+	}
+
+	return workQueues, nil
+}
+
+// Iterate returns an iterator over the work queues matching the filter, fetching one page at a time.
+func (c *WorkQueuesClient) Iterate(ctx context.Context, filter api.WorkQueueFilter, opts api.ListOptions) iter.Seq2[*api.WorkQueue, error] {
+	filterQuery := filter
+
 	cfg := requestConfig{
 		method:       http.MethodPost,
 		url:          c.routePrefix + "/filter",
-		body:         &filter,
-		successCodes: successCodesStatusOK,
 		apiKey:       c.apiKey,
 		basicAuthKey: c.basicAuthKey,
+		successCodes: successCodesStatusOK,
 	}
 
-	var queues []*api.WorkQueue
-	if err := requestWithDecodeResponse(ctx, c.hc, cfg, &queues); err != nil {
-		return nil, fmt.Errorf("failed to list work queues: %w", err)
-	}
-
-	return queues, nil
+	return iterate[*api.WorkQueue](ctx, c.hc, cfg, &filterQuery, opts)
 }
 
 // Get returns details for a work queue by name.
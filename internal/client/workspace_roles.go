@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -94,26 +95,32 @@ func (c *WorkspaceRolesClient) Delete(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
-// List returns a list of workspace roles, based on the provided filter.
+// List returns the workspace roles with the given names, or all workspace roles
+// if none are given. Every page of results is fetched.
 func (c *WorkspaceRolesClient) List(ctx context.Context, roleNames []string) ([]*api.WorkspaceRole, error) {
+	workspaceRoles, err := api.Collect(c.Iterate(ctx, roleNames, api.ListOptions{}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workspace roles: %w", err)
+	}
+
+	return workspaceRoles, nil
+}
+
+// Iterate returns an iterator over the workspace roles with the given names,
+// or all workspace roles if none are given, fetching one page at a time.
+func (c *WorkspaceRolesClient) Iterate(ctx context.Context, roleNames []string, opts api.ListOptions) iter.Seq2[*api.WorkspaceRole, error] {
 	filterQuery := api.WorkspaceRoleFilter{}
 	filterQuery.WorkspaceRoles.Name.Any = roleNames
 
 	cfg := requestConfig{
 		method:       http.MethodPost,
-		url:          fmt.Sprintf("%s/filter", c.routePrefix),
-		body:         &filterQuery,
+		url:          c.routePrefix + "/filter",
 		apiKey:       c.apiKey,
 		basicAuthKey: c.basicAuthKey,
 		successCodes: successCodesStatusOK,
 	}
 
-	var workspaceRoles []*api.WorkspaceRole
-	if err := requestWithDecodeResponse(ctx, c.hc, cfg, &workspaceRoles); err != nil {
-		return nil, fmt.Errorf("failed to list workspace roles: %w", err)
-	}
-
-	return workspaceRoles, nil
+	return iterate[*api.WorkspaceRole](ctx, c.hc, cfg, &filterQuery, opts)
 }
 
 // Get returns a workspace role by ID.
@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -55,8 +56,20 @@ func (c *WorkspacesClient) Create(ctx context.Context, data api.WorkspaceCreate)
 	return &workspace, nil
 }
 
-// List returns a list of Workspaces, based on the provided list of handle names.
+// List returns the workspaces with the given handles, or all workspaces
+// if none are given. Every page of results is fetched.
 func (c *WorkspacesClient) List(ctx context.Context, handleNames []string) ([]*api.Workspace, error) {
+	workspaces, err := api.Collect(c.Iterate(ctx, handleNames, api.ListOptions{}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workspaces: %w", err)
+	}
+
+	return workspaces, nil
+}
+
+// Iterate returns an iterator over the workspaces with the given handles,
+// or all workspaces if none are given, fetching one page at a time.
+func (c *WorkspacesClient) Iterate(ctx context.Context, handleNames []string, opts api.ListOptions) iter.Seq2[*api.Workspace, error] {
 	filterQuery := api.WorkspaceFilter{}
 
 	if len(handleNames) != 0 {
@@ -65,19 +78,13 @@ func (c *WorkspacesClient) List(ctx context.Context, handleNames []string) ([]*a
 
 	cfg := requestConfig{
 		method:       http.MethodPost,
-		url:          fmt.Sprintf("%s/filter", c.routePrefix),
-		body:         &filterQuery,
+		url:          c.routePrefix + "/filter",
 		apiKey:       c.apiKey,
 		basicAuthKey: c.basicAuthKey,
 		successCodes: successCodesStatusOK,
 	}
 
-	var workspaces []*api.Workspace
-	if err := requestWithDecodeResponse(ctx, c.hc, cfg, &workspaces); err != nil {
-		return nil, fmt.Errorf("failed to list workspaces: %w", err)
-	}
-
-	return workspaces, nil
+	return iterate[*api.Workspace](ctx, c.hc, cfg, &filterQuery, opts)
 }
 
 // Get returns details for a Workspace by ID.
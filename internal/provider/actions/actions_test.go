@@ -0,0 +1,90 @@
+package actions_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/client"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils/fakeserver"
+	"github.com/stretchr/testify/require"
+)
+
+const testAPIKey = "pnu_fake"
+
+// newTestWorkspace starts a fake Prefect API and returns a client for it,
+// along with the ID of a new workspace.
+//
+//nolint:ireturn // returns the client as the actions see it
+func newTestWorkspace(t *testing.T) (api.PrefectClient, uuid.UUID) {
+	t.Helper()
+
+	server := fakeserver.New(fakeserver.WithAPIKey(testAPIKey))
+	server.Start()
+	t.Cleanup(server.Close)
+
+	prefectClient, err := client.New(
+		client.WithEndpoint(server.URL()+"/api", server.URL()),
+		client.WithAPIKey(testAPIKey),
+		client.WithDefaults(server.AccountID(), uuid.Nil),
+	)
+	require.NoError(t, err)
+
+	workspaces, err := prefectClient.Workspaces(uuid.Nil)
+	require.NoError(t, err)
+
+	workspace, err := workspaces.Create(context.Background(), api.WorkspaceCreate{Name: "test", Handle: "test"})
+	require.NoError(t, err)
+
+	return prefectClient, workspace.ID
+}
+
+// invokeAction configures an action with the given client and invokes it
+// with the given configuration, returning its diagnostics and the progress
+// messages it sent. Attributes missing from the configuration are null.
+func invokeAction(t *testing.T, a action.Action, prefectClient api.PrefectClient, config map[string]string) (diag.Diagnostics, []string) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	if withConfigure, ok := a.(action.ActionWithConfigure); ok {
+		var configureResp action.ConfigureResponse
+		withConfigure.Configure(ctx, action.ConfigureRequest{ProviderData: prefectClient}, &configureResp)
+		require.False(t, configureResp.Diagnostics.HasError(), configureResp.Diagnostics)
+	}
+
+	var schemaResp action.SchemaResponse
+	a.Schema(ctx, action.SchemaRequest{}, &schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError(), schemaResp.Diagnostics)
+
+	objectType, ok := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	require.True(t, ok)
+
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		if value, ok := config[name]; ok {
+			values[name] = tftypes.NewValue(attributeType, value)
+		} else {
+			values[name] = tftypes.NewValue(attributeType, nil)
+		}
+	}
+
+	var messages []string
+
+	resp := action.InvokeResponse{
+		SendProgress: func(event action.InvokeProgressEvent) {
+			messages = append(messages, event.Message)
+		},
+	}
+
+	a.Invoke(ctx, action.InvokeRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+	}, &resp)
+
+	return resp.Diagnostics, messages
+}
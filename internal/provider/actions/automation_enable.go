@@ -0,0 +1,151 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var _ = action.ActionWithConfigure(&AutomationEnableAction{})
+
+// AutomationEnableAction contains state for the action that enables
+// or disables an automation.
+type AutomationEnableAction struct {
+	client  api.PrefectClient
+	enabled bool
+}
+
+// AutomationEnableActionModel defines the Terraform action model.
+type AutomationEnableActionModel struct {
+	AccountID   customtypes.UUIDValue `tfsdk:"account_id"`
+	WorkspaceID customtypes.UUIDValue `tfsdk:"workspace_id"`
+	ID          customtypes.UUIDValue `tfsdk:"id"`
+}
+
+// NewAutomationEnableAction returns a new AutomationEnableAction that enables an automation.
+//
+//nolint:ireturn // required by Terraform API
+func NewAutomationEnableAction() action.Action {
+	return &AutomationEnableAction{enabled: true}
+}
+
+// NewAutomationDisableAction returns a new AutomationEnableAction that disables an automation.
+//
+//nolint:ireturn // required by Terraform API
+func NewAutomationDisableAction() action.Action {
+	return &AutomationEnableAction{enabled: false}
+}
+
+// Metadata returns the action type name.
+func (a *AutomationEnableAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_automation_" + a.verb()
+}
+
+// Configure initializes runtime state for the action.
+func (a *AutomationEnableAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.PrefectClient)
+	if !ok {
+		resp.Diagnostics.Append(helpers.ConfigureTypeErrorDiagnostic("action", req.ProviderData))
+
+		return
+	}
+
+	a.client = client
+
+	resp.Diagnostics.Append(helpers.CheckServerCapability(client.ServerInfo(), "The prefect_automation_"+a.verb()+" action", "3.0.0", helpers.AllPlans...)...)
+}
+
+// Schema defines the schema for the action.
+func (a *AutomationEnableAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	description := "The action `automation_enable` enables an Automation, so that its trigger is evaluated and its actions run again. " +
+		"Use the `automation_disable` action to disable it."
+	if !a.enabled {
+		description = "The action `automation_disable` disables an Automation, so that its actions do not run while, " +
+			"for example, the systems it reacts to are under maintenance. Use the `automation_enable` action to enable it again."
+	}
+
+	resp.Schema = schema.Schema{
+		Description: description + "\n" + ignoreChangesNote("prefect_automation", "enabled"),
+		Attributes: map[string]schema.Attribute{
+			"account_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "Account ID (UUID), defaults to the account set in the provider",
+				Optional:    true,
+			},
+			"workspace_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "Workspace ID (UUID), defaults to the workspace set in the provider",
+				Optional:    true,
+			},
+			"id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "Automation ID (UUID)",
+				Required:    true,
+			},
+		},
+	}
+}
+
+// Invoke enables or disables the automation.
+func (a *AutomationEnableAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var model AutomationEnableActionModel
+
+	// Populate the model from action configuration and emit diagnostics on error
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := a.client.Automations(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Automation", err))
+
+		return
+	}
+
+	automationID := model.ID.ValueUUID()
+
+	automation, err := client.Get(ctx, automationID)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Automation", "get", err))
+
+		return
+	}
+
+	if automation.Enabled == a.enabled {
+		sendProgress(resp, fmt.Sprintf("Automation %q is already %sd", automation.Name, a.verb()))
+
+		return
+	}
+
+	// The API replaces the whole automation on update, so the rest of it is sent back unchanged.
+	data := automation.AutomationUpsert
+	data.Enabled = a.enabled
+
+	if err := client.Update(ctx, automationID, data); err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Automation", a.verb(), err))
+
+		return
+	}
+
+	sendProgress(resp, fmt.Sprintf("Automation %q is now %sd", automation.Name, a.verb()))
+}
+
+// verb returns the verb of the action.
+func (a *AutomationEnableAction) verb() string {
+	if a.enabled {
+		return "enable"
+	}
+
+	return "disable"
+}
@@ -0,0 +1,57 @@
+package actions_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/actions"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAutomationEnableAction(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	prefectClient, workspaceID := newTestWorkspace(t)
+
+	automations, err := prefectClient.Automations(uuid.Nil, workspaceID)
+	require.NoError(t, err)
+
+	automation, err := automations.Create(ctx, api.AutomationUpsert{
+		Name:        "test",
+		Description: "my automation",
+		Enabled:     true,
+		Trigger: api.Trigger{
+			Type:   "event",
+			Expect: []string{"prefect.flow-run.Failed"},
+		},
+		Actions:          []api.Action{{Type: "cancel-flow-run"}},
+		ActionsOnTrigger: []api.Action{},
+		ActionsOnResolve: []api.Action{},
+	})
+	require.NoError(t, err)
+
+	config := map[string]string{"workspace_id": workspaceID.String(), "id": automation.ID.String()}
+
+	diags, messages := invokeAction(t, actions.NewAutomationDisableAction(), prefectClient, config)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, []string{`Automation "test" is now disabled`}, messages)
+
+	automation, err = automations.Get(ctx, automation.ID)
+	require.NoError(t, err)
+	assert.False(t, automation.Enabled)
+	assert.Equal(t, "my automation", automation.Description)
+	assert.Equal(t, []string{"prefect.flow-run.Failed"}, automation.Trigger.Expect)
+	assert.Len(t, automation.Actions, 1)
+
+	diags, messages = invokeAction(t, actions.NewAutomationEnableAction(), prefectClient, config)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, []string{`Automation "test" is now enabled`}, messages)
+
+	diags, messages = invokeAction(t, actions.NewAutomationEnableAction(), prefectClient, config)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, []string{`Automation "test" is already enabled`}, messages)
+}
@@ -0,0 +1,148 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var _ = action.ActionWithConfigure(&DeploymentSchedulesPauseAction{})
+
+// DeploymentSchedulesPauseAction contains state for the action that pauses
+// or resumes the schedules of a deployment.
+type DeploymentSchedulesPauseAction struct {
+	client api.PrefectClient
+	paused bool
+}
+
+// DeploymentSchedulesPauseActionModel defines the Terraform action model.
+type DeploymentSchedulesPauseActionModel struct {
+	AccountID    customtypes.UUIDValue `tfsdk:"account_id"`
+	WorkspaceID  customtypes.UUIDValue `tfsdk:"workspace_id"`
+	DeploymentID customtypes.UUIDValue `tfsdk:"deployment_id"`
+}
+
+// NewDeploymentSchedulesPauseAction returns a new DeploymentSchedulesPauseAction
+// that pauses the schedules of a deployment.
+//
+//nolint:ireturn // required by Terraform API
+func NewDeploymentSchedulesPauseAction() action.Action {
+	return &DeploymentSchedulesPauseAction{paused: true}
+}
+
+// NewDeploymentSchedulesResumeAction returns a new DeploymentSchedulesPauseAction
+// that resumes the schedules of a deployment.
+//
+//nolint:ireturn // required by Terraform API
+func NewDeploymentSchedulesResumeAction() action.Action {
+	return &DeploymentSchedulesPauseAction{paused: false}
+}
+
+// Metadata returns the action type name.
+func (a *DeploymentSchedulesPauseAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployment_schedules_" + pauseVerb(a.paused)
+}
+
+// Configure initializes runtime state for the action.
+func (a *DeploymentSchedulesPauseAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.PrefectClient)
+	if !ok {
+		resp.Diagnostics.Append(helpers.ConfigureTypeErrorDiagnostic("action", req.ProviderData))
+
+		return
+	}
+
+	a.client = client
+}
+
+// Schema defines the schema for the action.
+func (a *DeploymentSchedulesPauseAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	description := "The action `deployment_schedules_pause` deactivates all the schedules of a Deployment, " +
+		"so that no new flow runs are scheduled for it. Use the `deployment_schedules_resume` action to activate them again."
+	if !a.paused {
+		description = "The action `deployment_schedules_resume` activates all the schedules of a Deployment, " +
+			"so that flow runs are scheduled for it again. Use the `deployment_schedules_pause` action to deactivate them."
+	}
+
+	resp.Schema = schema.Schema{
+		Description: description + "\n" + ignoreChangesNote("prefect_deployment_schedule", "active"),
+		Attributes: map[string]schema.Attribute{
+			"account_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "Account ID (UUID), defaults to the account set in the provider",
+				Optional:    true,
+			},
+			"workspace_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "Workspace ID (UUID), defaults to the workspace set in the provider",
+				Optional:    true,
+			},
+			"deployment_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "Deployment ID (UUID)",
+				Required:    true,
+			},
+		},
+	}
+}
+
+// Invoke deactivates or activates the schedules of the deployment.
+func (a *DeploymentSchedulesPauseAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var model DeploymentSchedulesPauseActionModel
+
+	// Populate the model from action configuration and emit diagnostics on error
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := a.client.DeploymentSchedule(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Deployment Schedule", err))
+
+		return
+	}
+
+	deploymentID := model.DeploymentID.ValueUUID()
+
+	schedules, err := client.Read(ctx, deploymentID)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Deployment Schedule", "get", err))
+
+		return
+	}
+
+	active := !a.paused
+	updated := 0
+
+	for _, schedule := range schedules {
+		// Schedules are active unless the API says otherwise.
+		if (schedule.Active == nil && active) || (schedule.Active != nil && *schedule.Active == active) {
+			continue
+		}
+
+		// The API replaces the schedule on update, so the rest of it is sent back unchanged.
+		payload := schedule.DeploymentSchedulePayload
+		payload.Active = &active
+
+		if err := client.Update(ctx, deploymentID, schedule.ID, payload); err != nil {
+			resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Deployment Schedule", pauseVerb(a.paused), err))
+
+			return
+		}
+
+		updated++
+	}
+
+	sendProgress(resp, fmt.Sprintf("Deployment %s: %sd %d of its %d schedules", deploymentID, pauseVerb(a.paused), updated, len(schedules)))
+}
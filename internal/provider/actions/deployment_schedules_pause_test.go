@@ -0,0 +1,71 @@
+package actions_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/actions"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeploymentSchedulesPauseAction(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	prefectClient, workspaceID := newTestWorkspace(t)
+
+	flows, err := prefectClient.Flows(uuid.Nil, workspaceID)
+	require.NoError(t, err)
+
+	flow, err := flows.Create(ctx, api.FlowCreate{Name: "flow", Tags: []string{}})
+	require.NoError(t, err)
+
+	deployments, err := prefectClient.Deployments(uuid.Nil, workspaceID)
+	require.NoError(t, err)
+
+	deployment, err := deployments.Create(ctx, api.DeploymentCreate{FlowID: flow.ID, Name: "deployment"})
+	require.NoError(t, err)
+
+	schedulesClient, err := prefectClient.DeploymentSchedule(uuid.Nil, workspaceID)
+	require.NoError(t, err)
+
+	active := true
+	inactive := false
+
+	_, err = schedulesClient.Create(ctx, deployment.ID, []api.DeploymentSchedulePayload{
+		{Active: &active, Schedule: api.Schedule{Cron: "0 * * * *", Timezone: "UTC"}},
+		{Active: &inactive, Schedule: api.Schedule{Interval: 60, Timezone: "UTC"}},
+	})
+	require.NoError(t, err)
+
+	config := map[string]string{"workspace_id": workspaceID.String(), "deployment_id": deployment.ID.String()}
+
+	diags, messages := invokeAction(t, actions.NewDeploymentSchedulesPauseAction(), prefectClient, config)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, []string{"Deployment " + deployment.ID.String() + ": paused 1 of its 2 schedules"}, messages)
+
+	schedules, err := schedulesClient.Read(ctx, deployment.ID)
+	require.NoError(t, err)
+	require.Len(t, schedules, 2)
+
+	for _, schedule := range schedules {
+		require.NotNil(t, schedule.Active)
+		assert.False(t, *schedule.Active)
+		assert.Equal(t, "UTC", schedule.Schedule.Timezone)
+	}
+
+	diags, messages = invokeAction(t, actions.NewDeploymentSchedulesResumeAction(), prefectClient, config)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, []string{"Deployment " + deployment.ID.String() + ": resumed 2 of its 2 schedules"}, messages)
+
+	schedules, err = schedulesClient.Read(ctx, deployment.ID)
+	require.NoError(t, err)
+
+	for _, schedule := range schedules {
+		require.NotNil(t, schedule.Active)
+		assert.True(t, *schedule.Active)
+	}
+}
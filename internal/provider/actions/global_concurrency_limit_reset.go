@@ -0,0 +1,129 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var _ = action.ActionWithConfigure(&GlobalConcurrencyLimitResetAction{})
+
+// GlobalConcurrencyLimitResetAction contains state for the action.
+type GlobalConcurrencyLimitResetAction struct {
+	client api.PrefectClient
+}
+
+// GlobalConcurrencyLimitResetActionModel defines the Terraform action model.
+type GlobalConcurrencyLimitResetActionModel struct {
+	AccountID   customtypes.UUIDValue `tfsdk:"account_id"`
+	WorkspaceID customtypes.UUIDValue `tfsdk:"workspace_id"`
+	ID          customtypes.UUIDValue `tfsdk:"id"`
+}
+
+// NewGlobalConcurrencyLimitResetAction returns a new GlobalConcurrencyLimitResetAction.
+//
+//nolint:ireturn // required by Terraform API
+func NewGlobalConcurrencyLimitResetAction() action.Action {
+	return &GlobalConcurrencyLimitResetAction{}
+}
+
+// Metadata returns the action type name.
+func (a *GlobalConcurrencyLimitResetAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_global_concurrency_limit_reset"
+}
+
+// Configure initializes runtime state for the action.
+func (a *GlobalConcurrencyLimitResetAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.PrefectClient)
+	if !ok {
+		resp.Diagnostics.Append(helpers.ConfigureTypeErrorDiagnostic("action", req.ProviderData))
+
+		return
+	}
+
+	a.client = client
+}
+
+// Schema defines the schema for the action.
+func (a *GlobalConcurrencyLimitResetAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The action `global_concurrency_limit_reset` releases all the active slots of a Global Concurrency Limit, " +
+			"for example when slots were left occupied by runs that crashed without releasing them." +
+			"\n" +
+			ignoreChangesNote("prefect_global_concurrency_limit", "active_slots"),
+		Attributes: map[string]schema.Attribute{
+			"account_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "Account ID (UUID), defaults to the account set in the provider",
+				Optional:    true,
+			},
+			"workspace_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "Workspace ID (UUID), defaults to the workspace set in the provider",
+				Optional:    true,
+			},
+			"id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "Global Concurrency Limit ID (UUID)",
+				Required:    true,
+			},
+		},
+	}
+}
+
+// Invoke releases the active slots of the global concurrency limit.
+func (a *GlobalConcurrencyLimitResetAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var model GlobalConcurrencyLimitResetActionModel
+
+	// Populate the model from action configuration and emit diagnostics on error
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := a.client.GlobalConcurrencyLimits(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Global Concurrency Limit", err))
+
+		return
+	}
+
+	limit, err := client.Read(ctx, model.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Global Concurrency Limit", "get", err))
+
+		return
+	}
+
+	if limit.ActiveSlots == 0 {
+		sendProgress(resp, fmt.Sprintf("Global concurrency limit %q has no active slots", limit.Name))
+
+		return
+	}
+
+	// The API replaces every field on update, so the others are sent back unchanged.
+	err = client.Update(ctx, model.ID.ValueString(), api.GlobalConcurrencyLimitUpdate{
+		Active:             limit.Active,
+		Name:               limit.Name,
+		Limit:              limit.Limit,
+		ActiveSlots:        0,
+		SlotDecayPerSecond: limit.SlotDecayPerSecond,
+	})
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Global Concurrency Limit", "reset", err))
+
+		return
+	}
+
+	sendProgress(resp, fmt.Sprintf("Released %d active slots of global concurrency limit %q", limit.ActiveSlots, limit.Name))
+}
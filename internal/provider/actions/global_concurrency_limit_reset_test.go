@@ -0,0 +1,48 @@
+package actions_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/actions"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGlobalConcurrencyLimitResetAction(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	prefectClient, workspaceID := newTestWorkspace(t)
+
+	limits, err := prefectClient.GlobalConcurrencyLimits(uuid.Nil, workspaceID)
+	require.NoError(t, err)
+
+	limit, err := limits.Create(ctx, api.GlobalConcurrencyLimitCreate{
+		Active:             true,
+		Name:               "test",
+		Limit:              5,
+		ActiveSlots:        3,
+		SlotDecayPerSecond: 1.5,
+	})
+	require.NoError(t, err)
+
+	config := map[string]string{"workspace_id": workspaceID.String(), "id": limit.ID.String()}
+
+	diags, messages := invokeAction(t, actions.NewGlobalConcurrencyLimitResetAction(), prefectClient, config)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, []string{`Released 3 active slots of global concurrency limit "test"`}, messages)
+
+	limit, err = limits.Read(ctx, limit.ID.String())
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), limit.ActiveSlots)
+	assert.Equal(t, int64(5), limit.Limit)
+	assert.InDelta(t, 1.5, limit.SlotDecayPerSecond, 0)
+	assert.True(t, limit.Active)
+
+	diags, messages = invokeAction(t, actions.NewGlobalConcurrencyLimitResetAction(), prefectClient, config)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, []string{`Global concurrency limit "test" has no active slots`}, messages)
+}
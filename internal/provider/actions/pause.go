@@ -0,0 +1,43 @@
+package actions
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+)
+
+// pauseVerb returns the verb of an action that pauses or resumes an object.
+func pauseVerb(paused bool) string {
+	if paused {
+		return "pause"
+	}
+
+	return "resume"
+}
+
+// pausedState returns the state an object is left in by an action that
+// pauses or resumes it.
+func pausedState(paused bool) string {
+	if paused {
+		return "paused"
+	}
+
+	return "active"
+}
+
+// ignoreChangesNote returns the note, shared by the actions that change an
+// object's status, on objects that are also managed by a resource.
+func ignoreChangesNote(resourceType, attribute string) string {
+	return fmt.Sprintf("The action does not change Terraform configuration or state. "+
+		"If the object is also managed by a `%s` resource, add its `%s` attribute to `lifecycle.ignore_changes` "+
+		"so that the next plan does not revert the action.", resourceType, attribute)
+}
+
+// sendProgress reports a progress message to Terraform while an action runs.
+func sendProgress(resp *action.InvokeResponse, message string) {
+	if resp.SendProgress == nil {
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: message})
+}
@@ -0,0 +1,143 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var _ = action.ActionWithConfigure(&WorkPoolPauseAction{})
+
+// WorkPoolPauseAction contains state for the action that pauses
+// or resumes a work pool.
+type WorkPoolPauseAction struct {
+	client api.PrefectClient
+	paused bool
+}
+
+// WorkPoolPauseActionModel defines the Terraform action model.
+type WorkPoolPauseActionModel struct {
+	AccountID   customtypes.UUIDValue `tfsdk:"account_id"`
+	WorkspaceID customtypes.UUIDValue `tfsdk:"workspace_id"`
+	Name        types.String          `tfsdk:"name"`
+}
+
+// NewWorkPoolPauseAction returns a new WorkPoolPauseAction that pauses a work pool.
+//
+//nolint:ireturn // required by Terraform API
+func NewWorkPoolPauseAction() action.Action {
+	return &WorkPoolPauseAction{paused: true}
+}
+
+// NewWorkPoolResumeAction returns a new WorkPoolPauseAction that resumes a work pool.
+//
+//nolint:ireturn // required by Terraform API
+func NewWorkPoolResumeAction() action.Action {
+	return &WorkPoolPauseAction{paused: false}
+}
+
+// Metadata returns the action type name.
+func (a *WorkPoolPauseAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_work_pool_" + pauseVerb(a.paused)
+}
+
+// Configure initializes runtime state for the action.
+func (a *WorkPoolPauseAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.PrefectClient)
+	if !ok {
+		resp.Diagnostics.Append(helpers.ConfigureTypeErrorDiagnostic("action", req.ProviderData))
+
+		return
+	}
+
+	a.client = client
+}
+
+// Schema defines the schema for the action.
+func (a *WorkPoolPauseAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	description := "The action `work_pool_pause` pauses a Work Pool, so that workers stop picking up its flow runs, " +
+		"for example before maintenance on the infrastructure it runs on. Use the `work_pool_resume` action to resume it."
+	if !a.paused {
+		description = "The action `work_pool_resume` resumes a paused Work Pool, so that workers pick up its flow runs again. " +
+			"Use the `work_pool_pause` action to pause it."
+	}
+
+	resp.Schema = schema.Schema{
+		Description: description + "\n" + ignoreChangesNote("prefect_work_pool", "paused"),
+		Attributes: map[string]schema.Attribute{
+			"account_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "Account ID (UUID), defaults to the account set in the provider",
+				Optional:    true,
+			},
+			"workspace_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "Workspace ID (UUID), defaults to the workspace set in the provider",
+				Optional:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the work pool",
+				Required:    true,
+			},
+		},
+	}
+}
+
+// Invoke pauses or resumes the work pool.
+func (a *WorkPoolPauseAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var model WorkPoolPauseActionModel
+
+	// Populate the model from action configuration and emit diagnostics on error
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := a.client.WorkPools(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Work Pool", err))
+
+		return
+	}
+
+	name := model.Name.ValueString()
+
+	pool, err := client.Get(ctx, name)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Work Pool", "get", err))
+
+		return
+	}
+
+	if pool.IsPaused == a.paused {
+		sendProgress(resp, fmt.Sprintf("Work pool %q is already %s", name, pausedState(a.paused)))
+
+		return
+	}
+
+	// The API replaces the description and concurrency limit on update,
+	// so they are sent back unchanged.
+	err = client.Update(ctx, name, api.WorkPoolUpdate{
+		Description:      pool.Description,
+		IsPaused:         &a.paused,
+		ConcurrencyLimit: pool.ConcurrencyLimit,
+	})
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Work Pool", pauseVerb(a.paused), err))
+
+		return
+	}
+
+	sendProgress(resp, fmt.Sprintf("Work pool %q is now %s", name, pausedState(a.paused)))
+}
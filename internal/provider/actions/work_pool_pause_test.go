@@ -0,0 +1,59 @@
+package actions_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/actions"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkPoolPauseAction(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	prefectClient, workspaceID := newTestWorkspace(t)
+
+	workPools, err := prefectClient.WorkPools(uuid.Nil, workspaceID)
+	require.NoError(t, err)
+
+	description := "my pool"
+	concurrencyLimit := int64(5)
+
+	_, err = workPools.Create(ctx, api.WorkPoolCreate{
+		Name:             "test",
+		Description:      &description,
+		Type:             "process",
+		ConcurrencyLimit: &concurrencyLimit,
+	})
+	require.NoError(t, err)
+
+	config := map[string]string{"workspace_id": workspaceID.String(), "name": "test"}
+
+	diags, messages := invokeAction(t, actions.NewWorkPoolPauseAction(), prefectClient, config)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, []string{`Work pool "test" is now paused`}, messages)
+
+	pool, err := workPools.Get(ctx, "test")
+	require.NoError(t, err)
+	assert.True(t, pool.IsPaused)
+	assert.Equal(t, &description, pool.Description)
+	assert.Equal(t, &concurrencyLimit, pool.ConcurrencyLimit)
+
+	diags, messages = invokeAction(t, actions.NewWorkPoolPauseAction(), prefectClient, config)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, []string{`Work pool "test" is already paused`}, messages)
+
+	diags, _ = invokeAction(t, actions.NewWorkPoolResumeAction(), prefectClient, config)
+	require.False(t, diags.HasError(), diags)
+
+	pool, err = workPools.Get(ctx, "test")
+	require.NoError(t, err)
+	assert.False(t, pool.IsPaused)
+
+	diags, _ = invokeAction(t, actions.NewWorkPoolPauseAction(), prefectClient, map[string]string{"workspace_id": workspaceID.String(), "name": "missing"})
+	assert.True(t, diags.HasError())
+}
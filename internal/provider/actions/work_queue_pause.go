@@ -0,0 +1,149 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var _ = action.ActionWithConfigure(&WorkQueuePauseAction{})
+
+// WorkQueuePauseAction contains state for the action that pauses
+// or resumes a work queue.
+type WorkQueuePauseAction struct {
+	client api.PrefectClient
+	paused bool
+}
+
+// WorkQueuePauseActionModel defines the Terraform action model.
+type WorkQueuePauseActionModel struct {
+	AccountID    customtypes.UUIDValue `tfsdk:"account_id"`
+	WorkspaceID  customtypes.UUIDValue `tfsdk:"workspace_id"`
+	WorkPoolName types.String          `tfsdk:"work_pool_name"`
+	Name         types.String          `tfsdk:"name"`
+}
+
+// NewWorkQueuePauseAction returns a new WorkQueuePauseAction that pauses a work queue.
+//
+//nolint:ireturn // required by Terraform API
+func NewWorkQueuePauseAction() action.Action {
+	return &WorkQueuePauseAction{paused: true}
+}
+
+// NewWorkQueueResumeAction returns a new WorkQueuePauseAction that resumes a work queue.
+//
+//nolint:ireturn // required by Terraform API
+func NewWorkQueueResumeAction() action.Action {
+	return &WorkQueuePauseAction{paused: false}
+}
+
+// Metadata returns the action type name.
+func (a *WorkQueuePauseAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_work_queue_" + pauseVerb(a.paused)
+}
+
+// Configure initializes runtime state for the action.
+func (a *WorkQueuePauseAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.PrefectClient)
+	if !ok {
+		resp.Diagnostics.Append(helpers.ConfigureTypeErrorDiagnostic("action", req.ProviderData))
+
+		return
+	}
+
+	a.client = client
+}
+
+// Schema defines the schema for the action.
+func (a *WorkQueuePauseAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	description := "The action `work_queue_pause` pauses a Work Queue, so that workers stop picking up the flow runs it holds, " +
+		"for example while the systems its flows depend on are under maintenance. Use the `work_queue_resume` action to resume it."
+	if !a.paused {
+		description = "The action `work_queue_resume` resumes a paused Work Queue, so that workers pick up the flow runs it holds again. " +
+			"Use the `work_queue_pause` action to pause it."
+	}
+
+	resp.Schema = schema.Schema{
+		Description: description + "\n" + ignoreChangesNote("prefect_work_queue", "is_paused"),
+		Attributes: map[string]schema.Attribute{
+			"account_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "Account ID (UUID), defaults to the account set in the provider",
+				Optional:    true,
+			},
+			"workspace_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "Workspace ID (UUID), defaults to the workspace set in the provider",
+				Optional:    true,
+			},
+			"work_pool_name": schema.StringAttribute{
+				Description: "Name of the work pool the work queue belongs to",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the work queue",
+				Required:    true,
+			},
+		},
+	}
+}
+
+// Invoke pauses or resumes the work queue.
+func (a *WorkQueuePauseAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var model WorkQueuePauseActionModel
+
+	// Populate the model from action configuration and emit diagnostics on error
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := a.client.WorkQueues(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID(), model.WorkPoolName.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Work Queue", err))
+
+		return
+	}
+
+	name := model.Name.ValueString()
+
+	queue, err := client.Get(ctx, name)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Work Queue", "get", err))
+
+		return
+	}
+
+	if queue.IsPaused == a.paused {
+		sendProgress(resp, fmt.Sprintf("Work queue %q is already %s", name, pausedState(a.paused)))
+
+		return
+	}
+
+	// The API replaces the description and concurrency limit on update,
+	// so they are sent back unchanged.
+	err = client.Update(ctx, name, api.WorkQueueUpdate{
+		Description:      queue.Description,
+		IsPaused:         &a.paused,
+		ConcurrencyLimit: queue.ConcurrencyLimit,
+		Priority:         queue.Priority,
+	})
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Work Queue", pauseVerb(a.paused), err))
+
+		return
+	}
+
+	sendProgress(resp, fmt.Sprintf("Work queue %q is now %s", name, pausedState(a.paused)))
+}
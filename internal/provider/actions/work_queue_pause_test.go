@@ -0,0 +1,54 @@
+package actions_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/actions"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkQueuePauseAction(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	prefectClient, workspaceID := newTestWorkspace(t)
+
+	workPools, err := prefectClient.WorkPools(uuid.Nil, workspaceID)
+	require.NoError(t, err)
+
+	_, err = workPools.Create(ctx, api.WorkPoolCreate{Name: "pool", Type: "process"})
+	require.NoError(t, err)
+
+	workQueues, err := prefectClient.WorkQueues(uuid.Nil, workspaceID, "pool")
+	require.NoError(t, err)
+
+	description := "my queue"
+	priority := int64(3)
+
+	_, err = workQueues.Create(ctx, api.WorkQueueCreate{Name: "queue", Description: &description, Priority: &priority})
+	require.NoError(t, err)
+
+	config := map[string]string{"workspace_id": workspaceID.String(), "work_pool_name": "pool", "name": "queue"}
+
+	diags, messages := invokeAction(t, actions.NewWorkQueuePauseAction(), prefectClient, config)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, []string{`Work queue "queue" is now paused`}, messages)
+
+	queue, err := workQueues.Get(ctx, "queue")
+	require.NoError(t, err)
+	assert.True(t, queue.IsPaused)
+	assert.Equal(t, &description, queue.Description)
+	assert.Equal(t, &priority, queue.Priority)
+
+	diags, messages = invokeAction(t, actions.NewWorkQueueResumeAction(), prefectClient, config)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, []string{`Work queue "queue" is now active`}, messages)
+
+	queue, err = workQueues.Get(ctx, "queue")
+	require.NoError(t, err)
+	assert.False(t, queue.IsPaused)
+}
@@ -0,0 +1,86 @@
+package provider_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+)
+
+// TestActionThroughProviderServer pauses a work pool through the provider
+// server as served by main.go, rather than invoking the action directly.
+func TestActionThroughProviderServer(t *testing.T) {
+	t.Parallel()
+
+	server := newConfiguredProviderServer(t)
+
+	workPools, err := server.client.WorkPools(uuid.Nil, server.workspaceID)
+	require.NoError(t, err)
+
+	_, err = workPools.Create(context.Background(), api.WorkPoolCreate{Name: "pool", Type: "process"})
+	require.NoError(t, err)
+
+	actionServer, ok := server.ProviderServer.(tfprotov6.ActionServer)
+	require.True(t, ok)
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	config := dynamicValue(t, server.schemas.ActionSchemas["prefect_work_pool_pause"].Schema, map[string]string{
+		"workspace_id": server.workspaceID.String(),
+		"name":         "pool",
+	})
+
+	validateResp, err := actionServer.ValidateActionConfig(ctx, &tfprotov6.ValidateActionConfigRequest{
+		ActionType: "prefect_work_pool_pause",
+		Config:     config,
+	})
+	require.NoError(t, err)
+	requireNoErrorDiagnostics(t, validateResp.Diagnostics)
+
+	planResp, err := actionServer.PlanAction(ctx, &tfprotov6.PlanActionRequest{
+		ActionType: "prefect_work_pool_pause",
+		Config:     config,
+	})
+	require.NoError(t, err)
+	requireNoErrorDiagnostics(t, planResp.Diagnostics)
+
+	stream, err := actionServer.InvokeAction(ctx, &tfprotov6.InvokeActionRequest{
+		ActionType: "prefect_work_pool_pause",
+		Config:     config,
+	})
+	require.NoError(t, err)
+
+	var messages []string
+
+	completed := false
+
+	for event := range stream.Events {
+		switch eventType := event.Type.(type) {
+		case tfprotov6.ProgressInvokeActionEventType:
+			messages = append(messages, eventType.Message)
+		case tfprotov6.CompletedInvokeActionEventType:
+			requireNoErrorDiagnostics(t, eventType.Diagnostics)
+
+			completed = true
+		}
+	}
+
+	assert.True(t, completed)
+	assert.Equal(t, []string{`Work pool "pool" is now paused`}, messages)
+
+	pool, err := workPools.Get(context.Background(), "pool")
+	require.NoError(t, err)
+	assert.True(t, pool.IsPaused)
+
+	summaries := requestSummaries(t, &output)
+	require.Len(t, summaries, 1)
+	assert.Greater(t, summaries[0]["api_requests"], float64(0))
+}
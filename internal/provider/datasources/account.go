@@ -59,6 +59,8 @@ func (d *AccountDataSource) Configure(_ context.Context, req datasource.Configur
 	}
 
 	d.client = client
+
+	resp.Diagnostics.Append(helpers.CheckServerCapability(client.ServerInfo(), "The prefect_account data source", "", helpers.AllCloudPlans...)...)
 }
 
 // Schema defines the schema for the data source.
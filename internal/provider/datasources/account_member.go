@@ -133,6 +133,8 @@ func (d *AccountMemberDataSource) Configure(_ context.Context, req datasource.Co
 	}
 
 	d.client = client
+
+	resp.Diagnostics.Append(helpers.CheckServerCapability(client.ServerInfo(), "The prefect_account_member data source", "", helpers.AllCloudPlans...)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
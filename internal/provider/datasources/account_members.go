@@ -2,6 +2,8 @@ package datasources
 
 import (
 	"context"
+	"iter"
+	"maps"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -24,8 +26,23 @@ type AccountMembersDataSourceModel struct {
 	Members types.List `tfsdk:"members"`
 
 	AccountID customtypes.UUIDValue `tfsdk:"account_id"`
+
+	Limit  types.Int64  `tfsdk:"limit"`
+	Offset types.Int64  `tfsdk:"offset"`
+	Sort   types.String `tfsdk:"sort"`
+}
+
+// accountMemberSortOrders are the sort orders accepted by the account members data source.
+var accountMemberSortOrders = sortOrders[*api.AccountMembership]{
+	"EMAIL_ASC":   cmpString(accountMemberEmail, false),
+	"EMAIL_DESC":  cmpString(accountMemberEmail, true),
+	"HANDLE_ASC":  cmpString(accountMemberHandle, false),
+	"HANDLE_DESC": cmpString(accountMemberHandle, true),
 }
 
+func accountMemberEmail(member *api.AccountMembership) string  { return member.Email }
+func accountMemberHandle(member *api.AccountMembership) string { return member.Handle }
+
 // NewAccountMemberDataSource returns a new AccountMemberDataSource.
 //
 //nolint:ireturn // required by Terraform API
@@ -65,6 +82,8 @@ For more information, see [manage account roles](https://docs.prefect.io/v3/mana
 			},
 		},
 	}
+
+	maps.Copy(resp.Schema.Attributes, paginationAttributes(accountMemberSortOrders.values()))
 }
 
 // Configure adds the provider-configured client to the data source.
@@ -81,6 +100,8 @@ func (d *AccountMembersDataSource) Configure(_ context.Context, req datasource.C
 	}
 
 	d.client = client
+
+	resp.Diagnostics.Append(helpers.CheckServerCapability(client.ServerInfo(), "The prefect_account_members data source", "", helpers.AllCloudPlans...)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -102,7 +123,12 @@ func (d *AccountMembersDataSource) Read(ctx context.Context, req datasource.Read
 
 	// Fetch all existing account members
 	var filter []string
-	accountMembers, err := client.List(ctx, filter)
+	accountMembers, err := listWindow(
+		func(opts api.ListOptions) iter.Seq2[*api.AccountMembership, error] {
+			return client.Iterate(ctx, filter, opts)
+		},
+		model.Limit, model.Offset, model.Sort, accountMemberSortOrders,
+	)
 	if err != nil {
 		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Account Members", "list", err))
 
@@ -112,6 +112,8 @@ func (d *AccountRoleDataSource) Configure(_ context.Context, req datasource.Conf
 	}
 
 	d.client = client
+
+	resp.Diagnostics.Append(helpers.CheckServerCapability(client.ServerInfo(), "The prefect_account_role data source", "", helpers.AllCloudPlans...)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
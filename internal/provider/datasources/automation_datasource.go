@@ -67,6 +67,8 @@ func (d *AutomationDataSource) Configure(_ context.Context, req datasource.Confi
 	}
 
 	d.client = client
+
+	resp.Diagnostics.Append(helpers.CheckServerCapability(client.ServerInfo(), "The prefect_automation data source", "3.0.0", helpers.AllPlans...)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
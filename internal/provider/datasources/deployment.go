@@ -31,8 +31,8 @@ type deploymentDataSource struct {
 
 // DeploymentDataSourceModel defines the Terraform data source model.
 type DeploymentDataSourceModel struct {
-	// The model requires the same fields, so reuse the fields defined in the resource model.
-	resources.DeploymentResourceModel
+	// The model requires the same fields, so reuse the fields defined for the resource model.
+	resources.DeploymentModel
 
 	// The following fields are specific to the Deployment datasource.
 	FlowName types.String `tfsdk:"flow_name"`
@@ -195,6 +195,10 @@ For more information, see [deploy overview](https://docs.prefect.io/v3/deploy/in
 							Computed:    true,
 							Description: "The type of pull step",
 						},
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "An identifier for the pull step, so that later steps can refer to its outputs.",
+						},
 						"credentials": schema.StringAttribute{
 							Computed:    true,
 							Description: "Credentials to use for the pull step. Refer to a {GitHub,GitLab,BitBucket} credentials block.",
@@ -205,7 +209,11 @@ For more information, see [deploy overview](https://docs.prefect.io/v3/deploy/in
 						},
 						"directory": schema.StringAttribute{
 							Computed:    true,
-							Description: "(For type 'set_working_directory') The directory to set as the working directory.",
+							Description: "(For type 'set_working_directory') The directory to set as the working directory. (For types 'run_shell_script' and 'pip_install_requirements') The directory to run the step in.",
+						},
+						"stream_output": schema.BoolAttribute{
+							Computed:    true,
+							Description: "(For types 'run_shell_script' and 'pip_install_requirements') Whether to stream the output of the step.",
 						},
 						"repository": schema.StringAttribute{
 							Computed:    true,
@@ -223,6 +231,15 @@ For more information, see [deploy overview](https://docs.prefect.io/v3/deploy/in
 							Computed:    true,
 							Description: "(For type 'git_clone') Whether to include submodules when cloning the repository.",
 						},
+						"commit_sha": schema.StringAttribute{
+							Computed:    true,
+							Description: "(For type 'git_clone') The commit to check out.",
+						},
+						"directories": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "(For type 'git_clone') The directories of the repository to clone, with a sparse checkout.",
+						},
 						"bucket": schema.StringAttribute{
 							Computed:    true,
 							Description: "(For type 'pull_from_*') The name of the bucket where files are stored.",
@@ -231,6 +248,32 @@ For more information, see [deploy overview](https://docs.prefect.io/v3/deploy/in
 							Computed:    true,
 							Description: "(For type 'pull_from_*') The folder in the bucket where files are stored.",
 						},
+						"script": schema.StringAttribute{
+							Computed:    true,
+							Description: "(For type 'run_shell_script') The script to run.",
+						},
+						"env": schema.MapAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "(For type 'run_shell_script') Environment variables to set for the script.",
+						},
+						"expand_env_vars": schema.BoolAttribute{
+							Computed:    true,
+							Description: "(For type 'run_shell_script') Whether to expand environment variables in the script.",
+						},
+						"requirements_file": schema.StringAttribute{
+							Computed:    true,
+							Description: "(For type 'pip_install_requirements') The requirements file to install.",
+						},
+						"function": schema.StringAttribute{
+							Computed:    true,
+							Description: "(For type 'custom') The fully-qualified name of the function of the step. Built-in steps with arguments that their own type has no attributes for are read as type 'custom' as well.",
+						},
+						"args": schema.StringAttribute{
+							Computed:    true,
+							CustomType:  jsontypes.NormalizedType{},
+							Description: "(For type 'custom') The arguments of the function, as a JSON object.",
+						},
 					},
 				},
 			},
@@ -339,14 +382,14 @@ func (d *deploymentDataSource) Configure(_ context.Context, req datasource.Confi
 }
 
 // copyDeploymentToModel leverages the function by the same name from the resources package to avoid repeating
-// the logic. Because DeploymentResourceModel embeds the resource.DeploymentResourceModel type, we can cast
+// the logic. Because DeploymentDataSourceModel embeds the resources.DeploymentModel type, we can cast
 // it to the compatible type before calling the referenced function.
 func copyDeploymentToModel(ctx context.Context, deployment *api.Deployment, model *DeploymentDataSourceModel) diag.Diagnostics {
 	// We need to copy the DeploymentResourceModel fields to the
 	// DeploymentDataSourceModel.  Unfortunately, we can't directly convert the
 	// type because struct embedding does not automatically make the embedding
 	// struct convertible to the embedded type.
-	compatibleModel := &resources.DeploymentResourceModel{
+	compatibleModel := &resources.DeploymentResourceModel{DeploymentModel: resources.DeploymentModel{
 		BaseModel: resources.BaseModel{
 			ID:      model.ID,
 			Created: model.Created,
@@ -374,7 +417,7 @@ func copyDeploymentToModel(ctx context.Context, deployment *api.Deployment, mode
 		WorkPoolName:           model.WorkPoolName,
 		WorkQueueName:          model.WorkQueueName,
 		WorkspaceID:            model.WorkspaceID,
-	}
+	}}
 
 	diags := resources.CopyDeploymentToModel(ctx, deployment, compatibleModel)
 	diags.Append(diags...)
@@ -19,9 +19,21 @@ type globalConcurrencyLimitDataSource struct {
 	client api.PrefectClient
 }
 
+// globalConcurrencyLimitDataSourceModel defines the Terraform data source model.
+// It does not reuse the resource model, which holds resource-only attributes
+// such as `adopt_existing`.
 type globalConcurrencyLimitDataSourceModel struct {
-	// The model requires the same fields, so reuse the fields defined in the resource model.
-	resources.GlobalConcurrencyLimitResourceModel
+	resources.BaseModel
+
+	AccountID   customtypes.UUIDValue `tfsdk:"account_id"`
+	WorkspaceID customtypes.UUIDValue `tfsdk:"workspace_id"`
+
+	Name   types.String `tfsdk:"name"`
+	Limit  types.Int64  `tfsdk:"limit"`
+	Active types.Bool   `tfsdk:"active"`
+
+	ActiveSlots        types.Int64   `tfsdk:"active_slots"`
+	SlotDecayPerSecond types.Float64 `tfsdk:"slot_decay_per_second"`
 }
 
 // NewGlobalConcurrencyLimitDataSource returns a new GlobalConcurrencyLimitDataSource.
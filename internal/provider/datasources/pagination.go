@@ -0,0 +1,131 @@
+package datasources
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+)
+
+// sortOrders maps the sort values accepted by a plural data source to
+// comparison functions for its objects.
+type sortOrders[T any] map[string]func(a, b T) int
+
+// values returns the accepted sort values, in a stable order.
+func (s sortOrders[T]) values() []string {
+	values := make([]string, 0, len(s))
+	for value := range s {
+		values = append(values, value)
+	}
+
+	slices.Sort(values)
+
+	return values
+}
+
+// baseModelSortOrders returns the sort orders for objects with a name and
+// created and updated timestamps.
+func baseModelSortOrders[T any](name func(T) string, base func(T) api.BaseModel) sortOrders[T] {
+	return sortOrders[T]{
+		"NAME_ASC":  cmpString(name, false),
+		"NAME_DESC": cmpString(name, true),
+		"CREATED_DESC": func(a, b T) int {
+			return compareTimes(base(b).Created, base(a).Created)
+		},
+		"UPDATED_DESC": func(a, b T) int {
+			return compareTimes(base(b).Updated, base(a).Updated)
+		},
+	}
+}
+
+// compareTimes orders timestamps chronologically, with missing ones first.
+func compareTimes(a, b *time.Time) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return -1
+	case b == nil:
+		return 1
+	default:
+		return a.Compare(*b)
+	}
+}
+
+// paginationAttributes returns the optional limit, offset and sort
+// attributes shared by the plural data sources.
+func paginationAttributes(sortValues []string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"limit": schema.Int64Attribute{
+			Optional:    true,
+			Description: "Maximum number of objects to return. Defaults to returning every matching object.",
+			Validators:  []validator.Int64{int64validator.AtLeast(1)},
+		},
+		"offset": schema.Int64Attribute{
+			Optional:    true,
+			Description: "Number of matching objects to skip before returning results. Defaults to 0.",
+			Validators:  []validator.Int64{int64validator.AtLeast(0)},
+		},
+		"sort": schema.StringAttribute{
+			Optional:    true,
+			Description: fmt.Sprintf("Order in which objects are returned, applied before `limit` and `offset`. One of: %s", strings.Join(sortValues, ", ")),
+			Validators:  []validator.String{stringvalidator.OneOf(sortValues...)},
+		},
+	}
+}
+
+// listWindow fetches the objects selected by a plural data source's limit,
+// offset and sort arguments.
+//
+// The filter endpoints behind these data sources do not support sorting,
+// so when a sort order is given every object is fetched and sorted before
+// the offset and limit are applied. Otherwise, only the requested window is
+// fetched from the server.
+func listWindow[T any](list func(api.ListOptions) iter.Seq2[T, error], limit, offset types.Int64, sortBy types.String, orders sortOrders[T]) ([]T, error) {
+	opts := api.ListOptions{
+		Offset: offset.ValueInt64(),
+		Limit:  limit.ValueInt64(),
+	}
+
+	compare, ok := orders[sortBy.ValueString()]
+	if !ok {
+		return api.Collect(list(opts))
+	}
+
+	items, err := api.Collect(list(api.ListOptions{}))
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortStableFunc(items, compare)
+
+	start := min(opts.Offset, int64(len(items)))
+	end := int64(len(items))
+
+	if opts.Limit > 0 {
+		end = min(start+opts.Limit, end)
+	}
+
+	return items[start:end], nil
+}
+
+// cmpString is a convenience for sort orders on string fields.
+func cmpString[T any](field func(T) string, descending bool) func(a, b T) int {
+	return func(a, b T) int {
+		if descending {
+			return cmp.Compare(field(b), field(a))
+		}
+
+		return cmp.Compare(field(a), field(b))
+	}
+}
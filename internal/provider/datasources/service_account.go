@@ -63,6 +63,8 @@ func (d *ServiceAccountDataSource) Configure(_ context.Context, req datasource.C
 	}
 
 	d.client = client
+
+	resp.Diagnostics.Append(helpers.CheckServerCapability(client.ServerInfo(), "The prefect_service_account data source", "", helpers.PlanPrefectCloudPro, helpers.PlanPrefectCloudEnterprise)...)
 }
 
 var serviceAccountAttributes = map[string]schema.Attribute{
@@ -114,6 +114,8 @@ func (d *TeamDataSource) Configure(_ context.Context, req datasource.ConfigureRe
 	}
 
 	d.client = client
+
+	resp.Diagnostics.Append(helpers.CheckServerCapability(client.ServerInfo(), "The prefect_team data source", "", helpers.PlanPrefectCloudEnterprise)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -2,6 +2,8 @@ package datasources
 
 import (
 	"context"
+	"iter"
+	"maps"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -25,8 +27,18 @@ type TeamsDataSourceModel struct {
 	AccountID customtypes.UUIDValue `tfsdk:"account_id"`
 
 	Teams types.List `tfsdk:"teams"`
+
+	Limit  types.Int64  `tfsdk:"limit"`
+	Offset types.Int64  `tfsdk:"offset"`
+	Sort   types.String `tfsdk:"sort"`
 }
 
+// teamSortOrders are the sort orders accepted by the teams data source.
+var teamSortOrders = baseModelSortOrders(
+	func(team *api.Team) string { return team.Name },
+	func(team *api.Team) api.BaseModel { return team.BaseModel },
+)
+
 // NewTeamsDataSource returns a new TeamsDataSource.
 //
 //nolint:ireturn // required by Terraform API
@@ -53,6 +65,8 @@ func (d *TeamsDataSource) Configure(_ context.Context, req datasource.ConfigureR
 	}
 
 	d.client = client
+
+	resp.Diagnostics.Append(helpers.CheckServerCapability(client.ServerInfo(), "The prefect_teams data source", "", helpers.PlanPrefectCloudEnterprise)...)
 }
 
 // Schema defines the schema for the data source.
@@ -80,6 +94,8 @@ Use this data source to search for multiple Teams. Defaults to fetching all Team
 			},
 		},
 	}
+
+	maps.Copy(resp.Schema.Attributes, paginationAttributes(teamSortOrders.values()))
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -101,7 +117,10 @@ func (d *TeamsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 
 	// Fetch all existing teams
 	var filter []string
-	teams, err := client.List(ctx, filter)
+	teams, err := listWindow(
+		func(opts api.ListOptions) iter.Seq2[*api.Team, error] { return client.Iterate(ctx, filter, opts) },
+		model.Limit, model.Offset, model.Sort, teamSortOrders,
+	)
 	if err != nil {
 		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Teams", "list", err))
 
@@ -61,6 +61,8 @@ func (d *WebhookDataSource) Configure(_ context.Context, req datasource.Configur
 	}
 
 	d.client = client
+
+	resp.Diagnostics.Append(helpers.CheckServerCapability(client.ServerInfo(), "The prefect_webhook data source", "", helpers.AllCloudPlans...)...)
 }
 
 var webhookAttributes = map[string]schema.Attribute{
@@ -44,6 +44,33 @@ data "prefect_work_pools" "test" {
 `, workspace, name)
 }
 
+func fixtureAccPaginatedWorkPools(workspace string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "prefect_work_pool" "first" {
+	name = "test-pool-a"
+	type = "kubernetes"
+	workspace_id = prefect_workspace.test.id
+	depends_on = [prefect_workspace.test]
+}
+
+resource "prefect_work_pool" "second" {
+	name = "test-pool-b"
+	type = "kubernetes"
+	workspace_id = prefect_workspace.test.id
+	depends_on = [prefect_workspace.test]
+}
+
+data "prefect_work_pools" "test" {
+	workspace_id = prefect_workspace.test.id
+	sort = "NAME_DESC"
+	limit = 1
+	depends_on = [prefect_work_pool.first, prefect_work_pool.second]
+}
+`, workspace)
+}
+
 //nolint:paralleltest // we use the resource.ParallelTest helper instead
 func TestAccDatasource_work_pool(t *testing.T) {
 	singleWorkPoolDatasourceName := "data.prefect_work_pool.test"
@@ -82,6 +109,14 @@ func TestAccDatasource_work_pool(t *testing.T) {
 					testutils.ExpectKnownValueNotNull(multipleWorkPoolDatasourceName, "work_pools.0.base_job_template"),
 				},
 			},
+			{
+				// Check that the limit is applied after sorting
+				Config: fixtureAccPaginatedWorkPools(workspace.Resource),
+				ConfigStateChecks: []statecheck.StateCheck{
+					testutils.ExpectKnownValueListSize(multipleWorkPoolDatasourceName, "work_pools", 1),
+					testutils.ExpectKnownValue(multipleWorkPoolDatasourceName, "work_pools.0.name", "test-pool-b"),
+				},
+			},
 		},
 	})
 }
@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
+	"maps"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -31,8 +33,18 @@ type WorkPoolsSourceModel struct {
 
 	FilterAny types.List `tfsdk:"filter_any"`
 	WorkPools types.List `tfsdk:"work_pools"`
+
+	Limit  types.Int64  `tfsdk:"limit"`
+	Offset types.Int64  `tfsdk:"offset"`
+	Sort   types.String `tfsdk:"sort"`
 }
 
+// workPoolSortOrders are the sort orders accepted by the work pools data source.
+var workPoolSortOrders = baseModelSortOrders(
+	func(pool *api.WorkPool) string { return pool.Name },
+	func(pool *api.WorkPool) api.BaseModel { return pool.BaseModel },
+)
+
 // NewWorkPoolsDataSource returns a new WorkPoolsDataSource.
 //
 //nolint:ireturn // required by Terraform API
@@ -98,6 +110,8 @@ For more information, see [configure dynamic infrastructure with work pools](htt
 			},
 		},
 	}
+
+	maps.Copy(resp.Schema.Attributes, paginationAttributes(workPoolSortOrders.values()))
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -119,7 +133,10 @@ func (d *WorkPoolsDataSource) Read(ctx context.Context, req datasource.ReadReque
 
 	filter := api.WorkPoolFilter{}
 
-	pools, err := client.List(ctx, filter)
+	pools, err := listWindow(
+		func(opts api.ListOptions) iter.Seq2[*api.WorkPool, error] { return client.Iterate(ctx, filter, opts) },
+		model.Limit, model.Offset, model.Sort, workPoolSortOrders,
+	)
 	if err != nil {
 		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Work Pools", "list", err))
 
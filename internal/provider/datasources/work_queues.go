@@ -2,6 +2,8 @@ package datasources
 
 import (
 	"context"
+	"iter"
+	"maps"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -28,8 +30,18 @@ type WorkQueuesSourceModel struct {
 
 	FilterAny  types.List `tfsdk:"filter_any"`
 	WorkQueues types.Set  `tfsdk:"work_queues"`
+
+	Limit  types.Int64  `tfsdk:"limit"`
+	Offset types.Int64  `tfsdk:"offset"`
+	Sort   types.String `tfsdk:"sort"`
 }
 
+// workQueueSortOrders are the sort orders accepted by the work queues data source.
+var workQueueSortOrders = baseModelSortOrders(
+	func(queue *api.WorkQueue) string { return queue.Name },
+	func(queue *api.WorkQueue) api.BaseModel { return queue.BaseModel },
+)
+
 // NewWorkQueuesDataSource returns a new WorkQueuesDataSource.
 //
 //nolint:ireturn // required by Terraform API
@@ -99,6 +111,8 @@ For more information, see [work queues](https://docs.prefect.io/v3/deploy/infras
 			},
 		},
 	}
+
+	maps.Copy(resp.Schema.Attributes, paginationAttributes(workQueueSortOrders.values()))
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -121,7 +135,10 @@ func (d *WorkQueuesDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	filter := api.WorkQueueFilter{}
 
 	// List work queues with the filter
-	queues, err := client.List(ctx, filter)
+	queues, err := listWindow(
+		func(opts api.ListOptions) iter.Seq2[*api.WorkQueue, error] { return client.Iterate(ctx, filter, opts) },
+		model.Limit, model.Offset, model.Sort, workQueueSortOrders,
+	)
 	if err != nil {
 		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Work Queues", "list", err))
 
@@ -58,6 +58,8 @@ func (d *WorkspaceDataSource) Configure(_ context.Context, req datasource.Config
 	}
 
 	d.client = client
+
+	resp.Diagnostics.Append(helpers.CheckServerCapability(client.ServerInfo(), "The prefect_workspace data source", "", helpers.AllCloudPlans...)...)
 }
 
 var workspaceAttributes = map[string]schema.Attribute{
@@ -116,6 +116,8 @@ func (d *WorkspaceRoleDataSource) Configure(_ context.Context, req datasource.Co
 	}
 
 	d.client = client
+
+	resp.Diagnostics.Append(helpers.CheckServerCapability(client.ServerInfo(), "The prefect_workspace_role data source", "", helpers.PlanPrefectCloudPro, helpers.PlanPrefectCloudEnterprise)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -0,0 +1,128 @@
+package provider_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDeletionProtectionFailsThePlan checks that destroying or replacing a
+// resource with deletion protection fails at plan time, before anything is
+// deleted.
+func TestDeletionProtectionFailsThePlan(t *testing.T) {
+	t.Parallel()
+
+	server := newConfiguredProviderServer(t)
+	schema := server.schemas.ResourceSchemas["prefect_work_pool"]
+
+	workPool := func(poolType string, deletionProtection bool, onDestroy string) tftypes.Value {
+		return objectValue(t, schema, map[string]tftypes.Value{
+			"id":                  tftypes.NewValue(tftypes.String, uuid.NewString()),
+			"workspace_id":        tftypes.NewValue(tftypes.String, server.workspaceID.String()),
+			"name":                tftypes.NewValue(tftypes.String, "pool"),
+			"type":                tftypes.NewValue(tftypes.String, poolType),
+			"deletion_protection": tftypes.NewValue(tftypes.Bool, deletionProtection),
+			"on_destroy":          tftypes.NewValue(tftypes.String, onDestroy),
+		})
+	}
+
+	destroyed := tftypes.NewValue(schema.ValueType(), nil)
+
+	tests := []struct {
+		name      string
+		prior     tftypes.Value
+		planned   tftypes.Value
+		wantError bool
+	}{
+		{
+			name:      "destroying a protected resource fails",
+			prior:     workPool("process", true, "delete"),
+			planned:   destroyed,
+			wantError: true,
+		},
+		{
+			name:      "replacing a protected resource fails",
+			prior:     workPool("process", true, "delete"),
+			planned:   workPool("kubernetes", true, "delete"),
+			wantError: true,
+		},
+		{
+			name:    "updating a protected resource succeeds",
+			prior:   workPool("process", true, "delete"),
+			planned: workPool("process", false, "delete"),
+		},
+		{
+			name:    "abandoning a protected resource succeeds",
+			prior:   workPool("process", true, "abandon"),
+			planned: destroyed,
+		},
+		{
+			name:    "destroying an unprotected resource succeeds",
+			prior:   workPool("process", false, "delete"),
+			planned: destroyed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp, err := server.PlanResourceChange(context.Background(), &tfprotov6.PlanResourceChangeRequest{
+				TypeName:         "prefect_work_pool",
+				PriorState:       encodeValue(t, schema, tt.prior),
+				ProposedNewState: encodeValue(t, schema, tt.planned),
+				Config:           encodeValue(t, schema, tt.planned),
+			})
+			require.NoError(t, err)
+
+			var summaries []string
+			for _, diagnostic := range resp.Diagnostics {
+				if diagnostic.Severity == tfprotov6.DiagnosticSeverityError {
+					summaries = append(summaries, diagnostic.Summary)
+				}
+			}
+
+			if tt.wantError {
+				assert.Equal(t, []string{"Deletion protection is enabled"}, summaries)
+			} else {
+				assert.Empty(t, summaries)
+			}
+		})
+	}
+}
+
+// objectValue returns a value of the given schema, with the given attributes
+// set and all other attributes null.
+func objectValue(t *testing.T, schema *tfprotov6.Schema, attributes map[string]tftypes.Value) tftypes.Value {
+	t.Helper()
+
+	objectType, ok := schema.ValueType().(tftypes.Object)
+	require.True(t, ok)
+
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+
+	for name, value := range attributes {
+		require.Contains(t, objectType.AttributeTypes, name)
+		values[name] = value
+	}
+
+	return tftypes.NewValue(objectType, values)
+}
+
+// encodeValue encodes a value of the given schema.
+func encodeValue(t *testing.T, schema *tfprotov6.Schema, value tftypes.Value) *tfprotov6.DynamicValue {
+	t.Helper()
+
+	encoded, err := tfprotov6.NewDynamicValue(schema.ValueType(), value)
+	require.NoError(t, err)
+
+	return &encoded
+}
@@ -0,0 +1,276 @@
+package ephemeralresources
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var (
+	_ = ephemeral.EphemeralResourceWithConfigure(&ServiceAccountAPIKeyEphemeralResource{})
+	_ = ephemeral.EphemeralResourceWithClose(&ServiceAccountAPIKeyEphemeralResource{})
+)
+
+const (
+	// serviceAccountAPIKeyPrivateKey is the private data key holding the
+	// service account whose key was rotated.
+	serviceAccountAPIKeyPrivateKey = "service_account_api_key"
+
+	// oldKeyExpiresInSecondsMax is the longest grace period the API accepts
+	// for the previously active key.
+	oldKeyExpiresInSecondsMax = 172800
+
+	// oldKeyExpiresInSecondsDefault is the grace period of the previously
+	// active key when none is configured, so that clients using it keep
+	// working after a plan or apply rotates it.
+	oldKeyExpiresInSecondsDefault = 3600
+
+	// revokedKeyTTL is the lifetime of the unused key left on the service
+	// account when Close revokes the minted key.
+	revokedKeyTTL = time.Minute
+)
+
+// ServiceAccountAPIKeyEphemeralResource contains state for the ephemeral resource.
+type ServiceAccountAPIKeyEphemeralResource struct {
+	client api.PrefectClient
+}
+
+// ServiceAccountAPIKeyEphemeralResourceModel defines the Terraform ephemeral resource model.
+type ServiceAccountAPIKeyEphemeralResourceModel struct {
+	AccountID              customtypes.UUIDValue `tfsdk:"account_id"`
+	ServiceAccountID       customtypes.UUIDValue `tfsdk:"service_account_id"`
+	TTL                    types.String          `tfsdk:"ttl"`
+	OldKeyExpiresInSeconds types.Int32           `tfsdk:"old_key_expires_in_seconds"`
+
+	ID         types.String               `tfsdk:"id"`
+	Name       types.String               `tfsdk:"name"`
+	Created    customtypes.TimestampValue `tfsdk:"created"`
+	Expiration customtypes.TimestampValue `tfsdk:"expiration"`
+	Key        types.String               `tfsdk:"key"`
+}
+
+// serviceAccountAPIKeyPrivateData identifies the service account whose key
+// was rotated, so that Close can revoke it.
+type serviceAccountAPIKeyPrivateData struct {
+	AccountID        uuid.UUID `json:"account_id"`
+	ServiceAccountID string    `json:"service_account_id"`
+	KeyID            string    `json:"key_id"`
+}
+
+// NewServiceAccountAPIKeyEphemeralResource returns a new ServiceAccountAPIKeyEphemeralResource.
+//
+//nolint:ireturn // required by Terraform API
+func NewServiceAccountAPIKeyEphemeralResource() ephemeral.EphemeralResource {
+	return &ServiceAccountAPIKeyEphemeralResource{}
+}
+
+// Metadata returns the ephemeral resource type name.
+func (r *ServiceAccountAPIKeyEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_account_api_key"
+}
+
+// Configure initializes runtime state for the ephemeral resource.
+func (r *ServiceAccountAPIKeyEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.PrefectClient)
+	if !ok {
+		resp.Diagnostics.Append(helpers.ConfigureTypeErrorDiagnostic("ephemeral resource", req.ProviderData))
+
+		return
+	}
+
+	r.client = client
+
+	resp.Diagnostics.Append(helpers.CheckServerCapability(client.ServerInfo(), "The prefect_service_account_api_key ephemeral resource", "", helpers.PlanPrefectCloudPro, helpers.PlanPrefectCloudEnterprise)...)
+}
+
+// Schema defines the schema for the ephemeral resource.
+func (r *ServiceAccountAPIKeyEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: helpers.DescriptionWithPlans(
+			"The ephemeral resource `service_account_api_key` rotates a Service Account's API key to mint a short-lived key for the duration of a Terraform run, "+
+				"so that it can be passed to other providers (for example, to populate a Kubernetes secret or a Vault entry) without being stored in Terraform state. "+
+				"\n"+
+				"A Service Account has a single API key, so opening this ephemeral resource replaces the current key. "+
+				"Terraform opens ephemeral resources during every plan as well as every apply, so **every `terraform plan` rotates the key**. "+
+				"The previous key keeps working for the grace period set in `old_key_expires_in_seconds`, one hour by default. "+
+				"When Terraform closes the ephemeral resource, the key is revoked by rotating it again, leaving the Service Account with an unused key that expires shortly after. "+
+				"Use this with Service Accounts whose key is not managed by the `api_key` attribute of a `prefect_service_account` resource, as that attribute would no longer hold a valid key.",
+			helpers.PlanPrefectCloudPro,
+			helpers.PlanPrefectCloudEnterprise,
+		),
+		Attributes: map[string]schema.Attribute{
+			"account_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "Account ID (UUID), defaults to the account set in the provider",
+				Optional:    true,
+			},
+			"service_account_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "Service Account ID (UUID)",
+				Required:    true,
+			},
+			"ttl": schema.StringAttribute{
+				Description: ttlDescription,
+				Optional:    true,
+			},
+			"old_key_expires_in_seconds": schema.Int32Attribute{
+				Description: "Number of seconds the previously active key remains valid after the rotation, so that clients using it keep working until they pick up a new key. " +
+					"Defaults to `3600` (1 hour). Set it to `0` to revoke the previous key immediately. It cannot be more than 48 hours (172800 seconds).",
+				Optional: true,
+				Validators: []validator.Int32{
+					int32validator.Between(0, oldKeyExpiresInSecondsMax),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "API Key ID",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "API Key name",
+			},
+			"created": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.TimestampType{},
+				Description: "Timestamp of when the API key was created (RFC3339)",
+			},
+			"expiration": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.TimestampType{},
+				Description: "Expiration of the API key (RFC3339)",
+			},
+			"key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Value of the API key",
+			},
+		},
+	}
+}
+
+// Open rotates the Service Account's API key to mint a short-lived key.
+func (r *ServiceAccountAPIKeyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var model ServiceAccountAPIKeyEphemeralResourceModel
+
+	// Populate the model from ephemeral resource configuration and emit diagnostics on error
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	expiration := expirationFromTTL(model.TTL, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.ServiceAccounts(model.AccountID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Service Account", err))
+
+		return
+	}
+
+	oldKeyExpiresInSeconds := int32(oldKeyExpiresInSecondsDefault)
+	if !model.OldKeyExpiresInSeconds.IsNull() {
+		oldKeyExpiresInSeconds = model.OldKeyExpiresInSeconds.ValueInt32()
+	}
+
+	serviceAccount, err := client.RotateKey(ctx, model.ServiceAccountID.ValueString(), api.ServiceAccountRotateKeyRequest{
+		APIKeyExpiration:       &expiration,
+		OldKeyExpiresInSeconds: oldKeyExpiresInSeconds,
+	})
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Service Account", "rotate key", err))
+
+		return
+	}
+
+	model.ID = types.StringValue(serviceAccount.APIKey.ID)
+	model.Name = types.StringValue(serviceAccount.APIKey.Name)
+	model.Created = customtypes.NewTimestampPointerValue(serviceAccount.APIKey.Created)
+	model.Expiration = customtypes.NewTimestampPointerValue(serviceAccount.APIKey.Expiration)
+	model.Key = types.StringValue(serviceAccount.APIKey.Key)
+
+	private, err := json.Marshal(serviceAccountAPIKeyPrivateData{
+		AccountID:        model.AccountID.ValueUUID(),
+		ServiceAccountID: model.ServiceAccountID.ValueString(),
+		KeyID:            serviceAccount.APIKey.ID,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to serialize private data", err.Error())
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, serviceAccountAPIKeyPrivateKey, private)...)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &model)...)
+}
+
+// Close revokes the API key minted by Open.
+//
+// The API has no way to delete a Service Account's key, so the key is
+// rotated again with no grace period, which revokes the minted key
+// immediately. The replacement key is discarded and expires shortly after.
+func (r *ServiceAccountAPIKeyEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	raw, diags := req.Private.GetKey(ctx, serviceAccountAPIKeyPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || raw == nil {
+		return
+	}
+
+	var private serviceAccountAPIKeyPrivateData
+	if err := json.Unmarshal(raw, &private); err != nil {
+		resp.Diagnostics.AddError("Failed to deserialize private data", err.Error())
+
+		return
+	}
+
+	client, err := r.client.ServiceAccounts(private.AccountID)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Service Account", err))
+
+		return
+	}
+
+	serviceAccount, err := client.Get(ctx, private.ServiceAccountID)
+	if errors.Is(err, api.ErrNotFound) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Service Account", "get", err))
+
+		return
+	}
+
+	// Leave the key alone if it was already rotated by someone else.
+	if serviceAccount.APIKey.ID != private.KeyID {
+		return
+	}
+
+	expiration := time.Now().UTC().Add(revokedKeyTTL).Truncate(time.Second)
+
+	_, err = client.RotateKey(ctx, private.ServiceAccountID, api.ServiceAccountRotateKeyRequest{
+		APIKeyExpiration:       &expiration,
+		OldKeyExpiresInSeconds: 0,
+	})
+	if err != nil && !errors.Is(err, api.ErrNotFound) {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Service Account", "rotate key", err))
+	}
+}
@@ -0,0 +1,55 @@
+package ephemeralresources_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
+)
+
+func fixtureAccServiceAccountAPIKey(name string) string {
+	return fmt.Sprintf(`
+resource "prefect_service_account" "test" {
+	name = "%s"
+}
+
+ephemeral "prefect_service_account_api_key" "test" {
+	service_account_id = prefect_service_account.test.id
+	ttl = "10m"
+}
+
+provider "echo" {
+	data = ephemeral.prefect_service_account_api_key.test
+}
+
+resource "echo" "test" {}
+`, name)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccEphemeralResource_service_account_api_key(t *testing.T) {
+	echoResourceName := "echo.test"
+	name := testutils.NewRandomPrefixedString()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactoriesWithEcho,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fixtureAccServiceAccountAPIKey(name),
+				ConfigStateChecks: []statecheck.StateCheck{
+					testutils.ExpectKnownValueNotNull(echoResourceName, "data.service_account_id"),
+					testutils.ExpectKnownValueNotNull(echoResourceName, "data.id"),
+					testutils.ExpectKnownValueNotNull(echoResourceName, "data.expiration"),
+					testutils.ExpectKnownValueNotNull(echoResourceName, "data.key"),
+				},
+			},
+		},
+	})
+}
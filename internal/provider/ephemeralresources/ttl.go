@@ -0,0 +1,42 @@
+package ephemeralresources
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultTTL is how long API keys minted by ephemeral resources remain
+// valid when no ttl is configured.
+const defaultTTL = time.Hour
+
+// ttlDescription describes the ttl attribute shared by the ephemeral resources.
+const ttlDescription = "How long the API key remains valid, as a Go duration string such as `15m` or `2h`. " +
+	"The key is revoked when Terraform closes the ephemeral resource, so this only bounds its lifetime " +
+	"if Terraform exits without closing it. Defaults to `1h`."
+
+// expirationFromTTL returns the expiration time for a key minted now with the
+// configured ttl, adding an attribute error if the ttl is not a valid duration.
+func expirationFromTTL(ttl types.String, diags *diag.Diagnostics) time.Time {
+	duration := defaultTTL
+
+	if !ttl.IsNull() && !ttl.IsUnknown() {
+		parsed, err := time.ParseDuration(ttl.ValueString())
+		if err != nil || parsed <= 0 {
+			diags.AddAttributeError(
+				path.Root("ttl"),
+				"Invalid duration",
+				fmt.Sprintf("The ttl value %q is not a valid positive duration, such as \"15m\" or \"2h\".", ttl.ValueString()),
+			)
+
+			return time.Time{}
+		}
+
+		duration = parsed
+	}
+
+	return time.Now().UTC().Add(duration).Truncate(time.Second)
+}
@@ -0,0 +1,202 @@
+package ephemeralresources
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var (
+	_ = ephemeral.EphemeralResourceWithConfigure(&UserAPIKeyEphemeralResource{})
+	_ = ephemeral.EphemeralResourceWithClose(&UserAPIKeyEphemeralResource{})
+)
+
+// userAPIKeyPrivateKey is the private data key holding the minted key's IDs.
+const userAPIKeyPrivateKey = "user_api_key"
+
+// UserAPIKeyEphemeralResource contains state for the ephemeral resource.
+type UserAPIKeyEphemeralResource struct {
+	client api.PrefectClient
+}
+
+// UserAPIKeyEphemeralResourceModel defines the Terraform ephemeral resource model.
+type UserAPIKeyEphemeralResourceModel struct {
+	UserID types.String `tfsdk:"user_id"`
+	Name   types.String `tfsdk:"name"`
+	TTL    types.String `tfsdk:"ttl"`
+
+	ID         types.String               `tfsdk:"id"`
+	Created    customtypes.TimestampValue `tfsdk:"created"`
+	Expiration customtypes.TimestampValue `tfsdk:"expiration"`
+	Key        types.String               `tfsdk:"key"`
+}
+
+// userAPIKeyPrivateData identifies the minted key, so that Close can revoke it.
+type userAPIKeyPrivateData struct {
+	UserID string `json:"user_id"`
+	KeyID  string `json:"key_id"`
+}
+
+// NewUserAPIKeyEphemeralResource returns a new UserAPIKeyEphemeralResource.
+//
+//nolint:ireturn // required by Terraform API
+func NewUserAPIKeyEphemeralResource() ephemeral.EphemeralResource {
+	return &UserAPIKeyEphemeralResource{}
+}
+
+// Metadata returns the ephemeral resource type name.
+func (r *UserAPIKeyEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_api_key"
+}
+
+// Configure initializes runtime state for the ephemeral resource.
+func (r *UserAPIKeyEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.PrefectClient)
+	if !ok {
+		resp.Diagnostics.Append(helpers.ConfigureTypeErrorDiagnostic("ephemeral resource", req.ProviderData))
+
+		return
+	}
+
+	r.client = client
+
+	resp.Diagnostics.Append(helpers.CheckServerCapability(client.ServerInfo(), "The prefect_user_api_key ephemeral resource", "", helpers.AllCloudPlans...)...)
+}
+
+// Schema defines the schema for the ephemeral resource.
+func (r *UserAPIKeyEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: helpers.DescriptionWithPlans(
+			"The ephemeral resource `user_api_key` mints a short-lived Prefect User API Key for the duration of a Terraform run, "+
+				"so that it can be passed to other providers (for example, to populate a Kubernetes secret or a Vault entry) without being stored in Terraform state. "+
+				"\n"+
+				"The key is revoked when Terraform closes the ephemeral resource at the end of the run. "+
+				"Use the `prefect_user_api_key` resource instead for keys that must outlive the run.",
+			helpers.AllCloudPlans...,
+		),
+		Attributes: map[string]schema.Attribute{
+			"user_id": schema.StringAttribute{
+				Description: "User ID (UUID)",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the API key",
+				Required:    true,
+			},
+			"ttl": schema.StringAttribute{
+				Description: ttlDescription,
+				Optional:    true,
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "User API Key ID (UUID)",
+			},
+			"created": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.TimestampType{},
+				Description: "Timestamp of when the API key was created (RFC3339)",
+			},
+			"expiration": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.TimestampType{},
+				Description: "Expiration of the API key (RFC3339)",
+			},
+			"key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Value of the API key",
+			},
+		},
+	}
+}
+
+// Open mints a new User API Key.
+func (r *UserAPIKeyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var model UserAPIKeyEphemeralResourceModel
+
+	// Populate the model from ephemeral resource configuration and emit diagnostics on error
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	expiration := expirationFromTTL(model.TTL, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userClient, err := r.client.Users()
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("User", err))
+
+		return
+	}
+
+	apiKey, err := userClient.CreateAPIKey(ctx, model.UserID.ValueString(), api.UserAPIKeyCreate{
+		Name:       model.Name.ValueString(),
+		Expiration: &expiration,
+	})
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("User API Key", "create", err))
+
+		return
+	}
+
+	model.ID = types.StringValue(apiKey.ID.String())
+	model.Created = customtypes.NewTimestampValue(apiKey.Created)
+	model.Expiration = customtypes.NewTimestampPointerValue(apiKey.Expiration)
+	model.Key = types.StringValue(apiKey.Key)
+
+	private, err := json.Marshal(userAPIKeyPrivateData{
+		UserID: model.UserID.ValueString(),
+		KeyID:  apiKey.ID.String(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to serialize private data", err.Error())
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, userAPIKeyPrivateKey, private)...)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &model)...)
+}
+
+// Close revokes the User API Key minted by Open.
+func (r *UserAPIKeyEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	raw, diags := req.Private.GetKey(ctx, userAPIKeyPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || raw == nil {
+		return
+	}
+
+	var private userAPIKeyPrivateData
+	if err := json.Unmarshal(raw, &private); err != nil {
+		resp.Diagnostics.AddError("Failed to deserialize private data", err.Error())
+
+		return
+	}
+
+	userClient, err := r.client.Users()
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("User", err))
+
+		return
+	}
+
+	err = userClient.DeleteAPIKey(ctx, private.UserID, private.KeyID)
+	if err != nil && !errors.Is(err, api.ErrNotFound) {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("User API Key", "delete", err))
+	}
+}
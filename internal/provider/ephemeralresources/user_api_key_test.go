@@ -0,0 +1,64 @@
+package ephemeralresources_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
+)
+
+// envUserResourceID is the environment variable holding the ID of the user
+// whose API keys are minted by the tests.
+const envUserResourceID = "ACC_TEST_USER_RESOURCE_ID"
+
+func fixtureAccUserAPIKey(userID, name string) string {
+	return fmt.Sprintf(`
+ephemeral "prefect_user_api_key" "test" {
+	user_id = "%s"
+	name = "%s"
+	ttl = "10m"
+}
+
+provider "echo" {
+	data = ephemeral.prefect_user_api_key.test
+}
+
+resource "echo" "test" {}
+`, userID, name)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccEphemeralResource_user_api_key(t *testing.T) {
+	echoResourceName := "echo.test"
+	userID := os.Getenv(envUserResourceID)
+	name := testutils.NewRandomPrefixedString()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactoriesWithEcho,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				SkipFunc: func() (bool, error) {
+					_, set := os.LookupEnv(envUserResourceID)
+
+					return !set, nil
+				},
+				Config: fixtureAccUserAPIKey(userID, name),
+				ConfigStateChecks: []statecheck.StateCheck{
+					testutils.ExpectKnownValue(echoResourceName, "data.user_id", userID),
+					testutils.ExpectKnownValue(echoResourceName, "data.name", name),
+					testutils.ExpectKnownValueNotNull(echoResourceName, "data.id"),
+					testutils.ExpectKnownValueNotNull(echoResourceName, "data.expiration"),
+					testutils.ExpectKnownValueNotNull(echoResourceName, "data.key"),
+				},
+			},
+		},
+	})
+}
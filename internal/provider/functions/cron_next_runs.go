@@ -0,0 +1,54 @@
+package functions
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var _ = function.Function(&CronNextRunsFunction{})
+
+// CronNextRunsFunction computes the next fire times of a cron schedule.
+type CronNextRunsFunction struct{}
+
+// NewCronNextRunsFunction returns a new CronNextRunsFunction.
+//
+//nolint:ireturn // required by Terraform API
+func NewCronNextRunsFunction() function.Function {
+	return &CronNextRunsFunction{}
+}
+
+// Metadata returns the function name.
+func (f *CronNextRunsFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cron_next_runs"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *CronNextRunsFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Compute the next runs of a cron schedule",
+		MarkdownDescription: "Computes the next `count` times after `start` at which a cron schedule fires in a timezone, as RFC3339 timestamps in that timezone. " +
+			"This previews the runs of a `prefect_deployment_schedule` with the same `cron` and `timezone`. " +
+			"As with Prefect's default `day_or` setting, when both the day of month and the day of week are restricted, a day matching either of them fires.",
+		Parameters: append([]function.Parameter{
+			function.StringParameter{
+				Name:        "cron",
+				Description: "Cron expression, such as 0 9 * * 1-5",
+			},
+		}, nextRunsParameters()...),
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+// Run computes the next runs.
+func (f *CronNextRunsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	runNextRuns(ctx, req, resp, func(cron string, location *time.Location, after time.Time, count int) ([]time.Time, error) {
+		return helpers.CronNextRuns(cron, true, location, after, count)
+	})
+}
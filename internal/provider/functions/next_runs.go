@@ -0,0 +1,83 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+// maxNextRuns is the largest number of runs the next runs functions compute.
+const maxNextRuns = 1000
+
+// nextRunsParameters returns the parameters shared by the next runs
+// functions, after the schedule itself.
+func nextRunsParameters() []function.Parameter {
+	return []function.Parameter{
+		function.StringParameter{
+			Name:           "timezone",
+			Description:    "IANA timezone in which the schedule is evaluated and the runs are returned, such as America/New_York, or null for UTC",
+			AllowNullValue: true,
+		},
+		function.StringParameter{
+			Name:        "start",
+			Description: "Timestamp (RFC3339) after which to compute runs, such as the result of timestamp()",
+		},
+		function.Int64Parameter{
+			Name:        "count",
+			Description: fmt.Sprintf("Number of runs to compute, between 1 and %d", maxNextRuns),
+			Validators:  []function.Int64ParameterValidator{int64validator.Between(1, maxNextRuns)},
+		},
+	}
+}
+
+// nextRunsFunc computes up to count runs of a schedule after the given time.
+type nextRunsFunc func(schedule string, location *time.Location, after time.Time, count int) ([]time.Time, error)
+
+// runNextRuns implements Run for the next runs functions, which take the
+// schedule followed by the nextRunsParameters and return RFC3339 timestamps.
+func runNextRuns(ctx context.Context, req function.RunRequest, resp *function.RunResponse, nextRuns nextRunsFunc) {
+	var (
+		schedule, start string
+		timezone        types.String
+		count           int64
+	)
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &schedule, &timezone, &start, &count))
+	if resp.Error != nil {
+		return
+	}
+
+	location, err := helpers.LoadTimezone(timezone.ValueString())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+
+		return
+	}
+
+	after, err := time.Parse(time.RFC3339, start)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(2, fmt.Sprintf("%q is not a valid RFC3339 timestamp: %s", start, err))
+
+		return
+	}
+
+	runs, err := nextRuns(schedule, location, after, int(count))
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+
+		return
+	}
+
+	timestamps := make([]string, 0, len(runs))
+	for _, run := range runs {
+		timestamps = append(timestamps, run.Format(time.RFC3339))
+	}
+
+	resp.Error = resp.Result.Set(ctx, timestamps)
+}
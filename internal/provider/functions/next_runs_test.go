@@ -0,0 +1,126 @@
+package functions_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/functions"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNextRuns(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		function function.Function
+		schedule string
+		timezone types.String
+		start    string
+		want     []string
+		wantErr  bool
+	}{
+		{
+			name:     "cron in a timezone",
+			function: functions.NewCronNextRunsFunction(),
+			schedule: "30 8 * * *",
+			timezone: types.StringValue("Europe/Paris"),
+			start:    "2025-03-29T12:00:00Z",
+			want:     []string{"2025-03-30T08:30:00+02:00", "2025-03-31T08:30:00+02:00"},
+		},
+		{
+			name:     "cron in UTC",
+			function: functions.NewCronNextRunsFunction(),
+			schedule: "@hourly",
+			timezone: types.StringNull(),
+			start:    "2025-01-01T00:00:00Z",
+			want:     []string{"2025-01-01T01:00:00Z", "2025-01-01T02:00:00Z"},
+		},
+		{
+			name:     "rrule",
+			function: functions.NewRRuleNextRunsFunction(),
+			schedule: "FREQ=MONTHLY;BYMONTHDAY=1;BYHOUR=0;BYMINUTE=0;BYSECOND=0",
+			timezone: types.StringNull(),
+			start:    "2025-01-15T00:00:00Z",
+			want:     []string{"2025-02-01T00:00:00Z", "2025-03-01T00:00:00Z"},
+		},
+		{
+			name:     "invalid cron",
+			function: functions.NewCronNextRunsFunction(),
+			schedule: "* * *",
+			timezone: types.StringNull(),
+			start:    "2025-01-01T00:00:00Z",
+			wantErr:  true,
+		},
+		{
+			name:     "invalid timezone",
+			function: functions.NewCronNextRunsFunction(),
+			schedule: "@daily",
+			timezone: types.StringValue("Mars/Olympus_Mons"),
+			start:    "2025-01-01T00:00:00Z",
+			wantErr:  true,
+		},
+		{
+			name:     "invalid start",
+			function: functions.NewRRuleNextRunsFunction(),
+			schedule: "FREQ=DAILY",
+			timezone: types.StringNull(),
+			start:    "yesterday",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := runFunction(t, tt.function, types.ListUnknown(types.StringType),
+				types.StringValue(tt.schedule), tt.timezone, types.StringValue(tt.start), types.Int64Value(int64(len(tt.want))))
+
+			if tt.wantErr {
+				require.NotNil(t, err)
+
+				return
+			}
+
+			require.Nil(t, err)
+
+			want := make([]attr.Value, 0, len(tt.want))
+			for _, run := range tt.want {
+				want = append(want, types.StringValue(run))
+			}
+
+			assert.Equal(t, types.ListValueMust(types.StringType, want), result)
+		})
+	}
+}
+
+func TestValidateCron(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		cron string
+		want bool
+	}{
+		{cron: "0 9 * * MON-FRI", want: true},
+		{cron: "@weekly", want: true},
+		{cron: "0 9 * * 1#1", want: true},
+		{cron: "0 0 L * *", want: true},
+		{cron: "0 9 * * MON-FRI 30", want: true},
+		{cron: "0 9 * * MON-FRI 0 * *", want: false},
+		{cron: "61 * * * *", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.cron, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := runFunction(t, functions.NewValidateCronFunction(), types.BoolUnknown(), types.StringValue(tt.cron))
+			require.Nil(t, err)
+			assert.Equal(t, types.BoolValue(tt.want), result)
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var _ = function.Function(&ParseAPIURLFunction{})
+
+// parsedAPIURLAttributeTypes are the attributes of the object returned by parse_api_url.
+var parsedAPIURLAttributeTypes = map[string]attr.Type{
+	"endpoint":     types.StringType,
+	"account_id":   types.StringType,
+	"workspace_id": types.StringType,
+}
+
+// ParseAPIURLFunction splits a Prefect API URL into its endpoint and IDs.
+type ParseAPIURLFunction struct{}
+
+// NewParseAPIURLFunction returns a new ParseAPIURLFunction.
+//
+//nolint:ireturn // required by Terraform API
+func NewParseAPIURLFunction() function.Function {
+	return &ParseAPIURLFunction{}
+}
+
+// Metadata returns the function name.
+func (f *ParseAPIURLFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_api_url"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *ParseAPIURLFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Split a Prefect API URL into its endpoint, account ID and workspace ID",
+		MarkdownDescription: "Splits a Prefect API URL, such as the value of `PREFECT_API_URL`, into the API endpoint and the account and workspace IDs it contains. " +
+			"For Prefect Cloud, the URL has the form `https://api.prefect.cloud/api/accounts/<account_id>/workspaces/<workspace_id>`. " +
+			"The returned `endpoint` is the base URL of the API (ending in `/api`), suitable for the provider's `endpoint` attribute. " +
+			"The `account_id` and `workspace_id` are null when the URL does not contain them, as is the case for Prefect server.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "url",
+				Description: "Prefect API URL to parse",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: parsedAPIURLAttributeTypes,
+		},
+	}
+}
+
+// Run parses the API URL.
+func (f *ParseAPIURLFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var apiURL string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &apiURL))
+	if resp.Error != nil {
+		return
+	}
+
+	endpointURL, err := url.Parse(apiURL)
+	if err != nil || endpointURL.Scheme == "" || endpointURL.Host == "" {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("%q is not a valid URL, such as https://api.prefect.cloud/api/accounts/<account_id>/workspaces/<workspace_id>", apiURL))
+
+		return
+	}
+
+	endpoint := fmt.Sprintf("%s://%s%s", endpointURL.Scheme, endpointURL.Host, endpointURL.Path)
+	accountID := types.StringNull()
+	workspaceID := types.StringNull()
+
+	if helpers.URLContainsIDs(apiURL) {
+		aID, err := helpers.GetAccountIDFromPath(endpointURL.Path)
+		if err != nil {
+			resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("The URL contains an account value that is not a valid UUID: %s", err))
+
+			return
+		}
+
+		wID, err := helpers.GetWorkspaceIDFromPath(endpointURL.Path)
+		if err != nil {
+			resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("The URL contains a workspace value that is not a valid UUID: %s", err))
+
+			return
+		}
+
+		endpoint = fmt.Sprintf("%s://%s/api", endpointURL.Scheme, endpointURL.Host)
+		accountID = types.StringValue(aID.String())
+		workspaceID = types.StringValue(wID.String())
+	} else if !strings.HasSuffix(endpoint, "/api") {
+		endpoint = strings.TrimSuffix(endpoint, "/") + "/api"
+	}
+
+	result, diags := types.ObjectValue(parsedAPIURLAttributeTypes, map[string]attr.Value{
+		"endpoint":     types.StringValue(endpoint),
+		"account_id":   accountID,
+		"workspace_id": workspaceID,
+	})
+
+	resp.Error = function.ConcatFuncErrors(function.FuncErrorFromDiags(ctx, diags), resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,89 @@
+package functions_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/functions"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAPIURL(t *testing.T) {
+	t.Parallel()
+
+	const (
+		accountID   = "9b2b6b7e-30d1-4a4c-8c8f-f2b0d5b4b1a1"
+		workspaceID = "4e0a1c3d-9f6e-4f43-93b8-2d9b1f0c5e7a"
+	)
+
+	tests := []struct {
+		name            string
+		url             string
+		wantEndpoint    string
+		wantAccountID   types.String
+		wantWorkspaceID types.String
+		wantErr         bool
+	}{
+		{
+			name:            "Prefect Cloud URL with IDs",
+			url:             "https://api.prefect.cloud/api/accounts/" + accountID + "/workspaces/" + workspaceID,
+			wantEndpoint:    "https://api.prefect.cloud/api",
+			wantAccountID:   types.StringValue(accountID),
+			wantWorkspaceID: types.StringValue(workspaceID),
+		},
+		{
+			name:            "Prefect server URL",
+			url:             "http://localhost:4200/api",
+			wantEndpoint:    "http://localhost:4200/api",
+			wantAccountID:   types.StringNull(),
+			wantWorkspaceID: types.StringNull(),
+		},
+		{
+			name:            "URL without the API path",
+			url:             "https://prefect.example.com/",
+			wantEndpoint:    "https://prefect.example.com/api",
+			wantAccountID:   types.StringNull(),
+			wantWorkspaceID: types.StringNull(),
+		},
+		{
+			name:    "URL with invalid IDs",
+			url:     "https://api.prefect.cloud/api/accounts/abc/workspaces/def",
+			wantErr: true,
+		},
+		{
+			name:    "not a URL",
+			url:     "api.prefect.cloud",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := runFunction(t, functions.NewParseAPIURLFunction(), types.ObjectUnknown(map[string]attr.Type{
+				"endpoint":     types.StringType,
+				"account_id":   types.StringType,
+				"workspace_id": types.StringType,
+			}), types.StringValue(tt.url))
+
+			if tt.wantErr {
+				require.NotNil(t, err)
+
+				return
+			}
+
+			require.Nil(t, err)
+
+			object, ok := result.(types.Object)
+			require.True(t, ok)
+
+			attributes := object.Attributes()
+			assert.Equal(t, types.StringValue(tt.wantEndpoint), attributes["endpoint"])
+			assert.Equal(t, tt.wantAccountID, attributes["account_id"])
+			assert.Equal(t, tt.wantWorkspaceID, attributes["workspace_id"])
+		})
+	}
+}
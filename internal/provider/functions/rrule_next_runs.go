@@ -0,0 +1,51 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var _ = function.Function(&RRuleNextRunsFunction{})
+
+// RRuleNextRunsFunction computes the next fire times of an RRule schedule.
+type RRuleNextRunsFunction struct{}
+
+// NewRRuleNextRunsFunction returns a new RRuleNextRunsFunction.
+//
+//nolint:ireturn // required by Terraform API
+func NewRRuleNextRunsFunction() function.Function {
+	return &RRuleNextRunsFunction{}
+}
+
+// Metadata returns the function name.
+func (f *RRuleNextRunsFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "rrule_next_runs"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *RRuleNextRunsFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Compute the next runs of an RRule schedule",
+		MarkdownDescription: "Computes the next `count` times after `start` at which an RRule schedule fires in a timezone, as RFC3339 timestamps in that timezone. " +
+			"This previews the runs of a `prefect_deployment_schedule` with the same `rrule` and `timezone`. " +
+			"The rule may start with a `DTSTART` line; otherwise, it starts at `start`.",
+		Parameters: append([]function.Parameter{
+			function.StringParameter{
+				Name:        "rrule",
+				Description: "RRule string, such as FREQ=WEEKLY;BYDAY=MO,WE,FR;BYHOUR=9;BYMINUTE=0",
+			},
+		}, nextRunsParameters()...),
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+// Run computes the next runs.
+func (f *RRuleNextRunsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	runNextRuns(ctx, req, resp, helpers.RRuleNextRuns)
+}
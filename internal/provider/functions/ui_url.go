@@ -0,0 +1,132 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ = function.Function(&UIURLFunction{})
+
+// uiPaths maps the object types accepted by ui_url to their path in the Prefect UI.
+var uiPaths = map[string]string{
+	"deployment": "deployments/deployment",
+	"flow":       "flows/flow",
+	"work_pool":  "work-pools/work-pool",
+}
+
+// UIURLFunction builds a link to an object in the Prefect UI.
+type UIURLFunction struct{}
+
+// NewUIURLFunction returns a new UIURLFunction.
+//
+//nolint:ireturn // required by Terraform API
+func NewUIURLFunction() function.Function {
+	return &UIURLFunction{}
+}
+
+// Metadata returns the function name.
+func (f *UIURLFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ui_url"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *UIURLFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	objectTypes := uiObjectTypes()
+
+	resp.Definition = function.Definition{
+		Summary: "Build a link to a deployment, flow or work pool in the Prefect UI",
+		MarkdownDescription: "Builds a link to a deployment, flow or work pool in the Prefect UI from the API endpoint and the object's IDs. " +
+			"For Prefect Cloud, the UI host is derived from the API host (for example, `https://api.prefect.cloud` links to `https://app.prefect.cloud`), " +
+			"and both `account_id` and `workspace_id` must be set. " +
+			"For Prefect server, pass null for both, and the link points to the UI served by the same host as the API.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "endpoint",
+				Description: "Prefect API endpoint or host, such as https://api.prefect.cloud",
+			},
+			function.StringParameter{
+				Name:           "account_id",
+				Description:    "Prefect Cloud account ID (UUID), or null for Prefect server",
+				AllowNullValue: true,
+			},
+			function.StringParameter{
+				Name:           "workspace_id",
+				Description:    "Prefect Cloud workspace ID (UUID), or null for Prefect server",
+				AllowNullValue: true,
+			},
+			function.StringParameter{
+				Name:        "object_type",
+				Description: fmt.Sprintf("Type of the object to link to. One of: %s", strings.Join(objectTypes, ", ")),
+				Validators:  []function.StringParameterValidator{stringvalidator.OneOf(objectTypes...)},
+			},
+			function.StringParameter{
+				Name:        "id",
+				Description: "ID of the deployment or flow, or name of the work pool",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run builds the UI link.
+func (f *UIURLFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		endpoint, objectType, id string
+		accountID, workspaceID   types.String
+	)
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &endpoint, &accountID, &workspaceID, &objectType, &id))
+	if resp.Error != nil {
+		return
+	}
+
+	endpointURL, err := url.Parse(endpoint)
+	if err != nil || endpointURL.Scheme == "" || endpointURL.Host == "" {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("%q is not a valid URL, such as https://api.prefect.cloud", endpoint))
+
+		return
+	}
+
+	objectPath := fmt.Sprintf("%s/%s", uiPaths[objectType], url.PathEscape(id))
+
+	switch {
+	case accountID.IsNull() && workspaceID.IsNull():
+		resp.Error = resp.Result.Set(ctx, fmt.Sprintf("%s://%s/%s", endpointURL.Scheme, endpointURL.Host, objectPath))
+	case accountID.IsNull():
+		resp.Error = function.NewArgumentFuncError(1, "account_id must be set when workspace_id is set")
+	case workspaceID.IsNull():
+		resp.Error = function.NewArgumentFuncError(2, "workspace_id must be set when account_id is set")
+	default:
+		resp.Error = resp.Result.Set(ctx, fmt.Sprintf("%s://%s/account/%s/workspace/%s/%s",
+			endpointURL.Scheme, uiHost(endpointURL.Host), accountID.ValueString(), workspaceID.ValueString(), objectPath))
+	}
+}
+
+// uiHost returns the Prefect Cloud UI host for an API host,
+// such as app.prefect.cloud for api.prefect.cloud.
+func uiHost(apiHost string) string {
+	if host, ok := strings.CutPrefix(apiHost, "api."); ok {
+		return "app." + host
+	}
+
+	return apiHost
+}
+
+// uiObjectTypes returns the object types accepted by ui_url, in a stable order.
+func uiObjectTypes() []string {
+	objectTypes := make([]string, 0, len(uiPaths))
+	for objectType := range uiPaths {
+		objectTypes = append(objectTypes, objectType)
+	}
+
+	slices.Sort(objectTypes)
+
+	return objectTypes
+}
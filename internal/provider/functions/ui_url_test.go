@@ -0,0 +1,86 @@
+package functions_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/functions"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUIURL(t *testing.T) {
+	t.Parallel()
+
+	const (
+		accountID   = "9b2b6b7e-30d1-4a4c-8c8f-f2b0d5b4b1a1"
+		workspaceID = "4e0a1c3d-9f6e-4f43-93b8-2d9b1f0c5e7a"
+		objectID    = "0f3c8a52-6a4e-4a8b-9d0c-3b7f5e1a2c4d"
+	)
+
+	tests := []struct {
+		name        string
+		endpoint    string
+		accountID   types.String
+		workspaceID types.String
+		objectType  string
+		id          string
+		want        string
+		wantErr     bool
+	}{
+		{
+			name:        "Prefect Cloud deployment",
+			endpoint:    "https://api.prefect.cloud",
+			accountID:   types.StringValue(accountID),
+			workspaceID: types.StringValue(workspaceID),
+			objectType:  "deployment",
+			id:          objectID,
+			want:        "https://app.prefect.cloud/account/" + accountID + "/workspace/" + workspaceID + "/deployments/deployment/" + objectID,
+		},
+		{
+			name:        "Prefect Cloud work pool from a workspace-scoped API URL",
+			endpoint:    "https://api.prefect.cloud/api/accounts/" + accountID + "/workspaces/" + workspaceID,
+			accountID:   types.StringValue(accountID),
+			workspaceID: types.StringValue(workspaceID),
+			objectType:  "work_pool",
+			id:          "my pool",
+			want:        "https://app.prefect.cloud/account/" + accountID + "/workspace/" + workspaceID + "/work-pools/work-pool/my%20pool",
+		},
+		{
+			name:        "Prefect server flow",
+			endpoint:    "http://localhost:4200/api",
+			accountID:   types.StringNull(),
+			workspaceID: types.StringNull(),
+			objectType:  "flow",
+			id:          objectID,
+			want:        "http://localhost:4200/flows/flow/" + objectID,
+		},
+		{
+			name:        "workspace without account",
+			endpoint:    "https://api.prefect.cloud",
+			accountID:   types.StringNull(),
+			workspaceID: types.StringValue(workspaceID),
+			objectType:  "flow",
+			id:          objectID,
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := runFunction(t, functions.NewUIURLFunction(), types.StringUnknown(),
+				types.StringValue(tt.endpoint), tt.accountID, tt.workspaceID, types.StringValue(tt.objectType), types.StringValue(tt.id))
+
+			if tt.wantErr {
+				require.NotNil(t, err)
+
+				return
+			}
+
+			require.Nil(t, err)
+			assert.Equal(t, types.StringValue(tt.want), result)
+		})
+	}
+}
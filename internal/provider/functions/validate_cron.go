@@ -0,0 +1,61 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var _ = function.Function(&ValidateCronFunction{})
+
+// ValidateCronFunction checks whether a cron expression is valid.
+type ValidateCronFunction struct{}
+
+// NewValidateCronFunction returns a new ValidateCronFunction.
+//
+//nolint:ireturn // required by Terraform API
+func NewValidateCronFunction() function.Function {
+	return &ValidateCronFunction{}
+}
+
+// Metadata returns the function name.
+func (f *ValidateCronFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "validate_cron"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *ValidateCronFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Check whether a cron expression is valid",
+		MarkdownDescription: "Returns `true` if a cron expression is valid for a Prefect cron schedule, and `false` otherwise. " +
+			"Valid expressions have five fields (minute, hour, day of month, month and day of week), " +
+			"optionally followed by a sixth field for seconds and a seventh field for years, " +
+			"or are one of the `@yearly`, `@annually`, `@monthly`, `@weekly`, `@daily`, `@midnight` and `@hourly` macros. " +
+			"Like Prefect, the day of month accepts `L` for the last day of the month, " +
+			"and the day of week accepts `1#1` for the first Monday and `L5` for the last Friday of the month. " +
+			"This is intended for `validation` blocks on variables holding schedules.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "cron",
+				Description: "Cron expression to validate",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+// Run validates the cron expression.
+func (f *ValidateCronFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cron string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cron))
+	if resp.Error != nil {
+		return
+	}
+
+	err := helpers.ParseCron(cron)
+
+	resp.Error = resp.Result.Set(ctx, err == nil)
+}
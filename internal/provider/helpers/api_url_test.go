@@ -1,11 +1,11 @@
-package provider_test
+package helpers_test
 
 import (
 	"fmt"
 	"testing"
 
 	"github.com/google/uuid"
-	"github.com/prefecthq/terraform-provider-prefect/internal/provider"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -43,7 +43,7 @@ func TestURLContainsIDs(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			got := provider.URLContainsIDs(tt.apiURL)
+			got := helpers.URLContainsIDs(tt.apiURL)
 
 			assert.Equal(t, tt.want, got, "Expected %+v but got %+v", tt.want, got)
 		})
@@ -92,13 +92,13 @@ func TestGetUUIDFromPath(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			gotAccountID, accountErr := provider.GetAccountIDFromPath(tt.path)
+			gotAccountID, accountErr := helpers.GetAccountIDFromPath(tt.path)
 			if !tt.wantErr {
 				assert.NoError(t, accountErr)
 			}
 			assert.Equal(t, tt.wantAccountID, gotAccountID, "Expected account ID %+v but got %+v", tt.wantAccountID, gotAccountID)
 
-			gotWorkspaceID, accountErr := provider.GetWorkspaceIDFromPath(tt.path)
+			gotWorkspaceID, accountErr := helpers.GetWorkspaceIDFromPath(tt.path)
 			if !tt.wantErr {
 				assert.NoError(t, accountErr)
 			}
@@ -0,0 +1,98 @@
+package helpers
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+)
+
+// PlanFromPlanType maps a Prefect Cloud account plan type, as returned by
+// the API, to one of the plan names used in DescriptionWithPlans.
+// It returns an empty string for plan types that are not recognized.
+func PlanFromPlanType(planType string) string {
+	planType = strings.ToUpper(strings.TrimSpace(planType))
+
+	switch {
+	case strings.Contains(planType, "ENTERPRISE"):
+		return PlanPrefectCloudEnterprise
+	case planType == "PRO" || strings.HasPrefix(planType, "PRO_"):
+		return PlanPrefectCloudPro
+	case planType == "FREE" || planType == "PERSONAL" || planType == "HOBBY":
+		return PlanPrefectCloudFree
+	default:
+		return ""
+	}
+}
+
+// CheckServerCapability returns an error diagnostic if the detected server
+// cannot support a resource or datasource, so that plans fail early with a
+// clear message instead of an API error during apply.
+//
+// The plans are the same as those passed to DescriptionWithPlans, and
+// minServerVersion is the minimum self-hosted server version, if any.
+// Anything that could not be detected, such as an unrecognized plan type,
+// is assumed to be supported.
+//
+// Data sources, ephemeral resources and actions call it in their `Configure`
+// method. Resources call it in `ModifyPlan`, only when they are created, so
+// that existing resources can still be refreshed, updated and destroyed after
+// a server or plan downgrade.
+func CheckServerCapability(info api.ServerInfo, typeName, minServerVersion string, plans ...string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch {
+	case info.IsOSS() && !slices.Contains(plans, PlanPrefectOSS):
+		diags.AddError(
+			"Unsupported Prefect server",
+			fmt.Sprintf("%s requires %s, but the provider is connected to a self-hosted Prefect server. "+
+				"It is available in the following product plan(s): %s.", typeName, requiredPlan(plans), strings.Join(plans, ", ")),
+		)
+
+	case info.IsOSS() && minServerVersion != "":
+		if atLeast, known := info.VersionAtLeast(minServerVersion); known && !atLeast {
+			diags.AddError(
+				"Unsupported Prefect server version",
+				fmt.Sprintf("%s requires Prefect server >= %s, but the provider is connected to Prefect server %s. "+
+					"Upgrade the server to manage this object.", typeName, minServerVersion, info.Version),
+			)
+		}
+
+	case info.IsCloud():
+		plan := PlanFromPlanType(info.PlanType)
+		if plan != "" && !slices.Contains(plans, plan) {
+			diags.AddError(
+				"Unsupported Prefect Cloud plan",
+				fmt.Sprintf("%s requires %s, but the provider's account is on the %s plan. "+
+					"It is available in the following product plan(s): %s.", typeName, requiredPlan(plans), plan, strings.Join(plans, ", ")),
+			)
+		}
+	}
+
+	return diags
+}
+
+// IsCreate reports whether a plan creates a new resource.
+func IsCreate(req resource.ModifyPlanRequest) bool {
+	return req.State.Raw.IsNull() && !req.Plan.Raw.IsNull()
+}
+
+// requiredPlan returns the lowest Prefect Cloud plan among the plans, such as
+// "Prefect Cloud (Pro)", or "Prefect Cloud" if any Cloud plan is supported.
+func requiredPlan(plans []string) string {
+	if slices.Contains(plans, PlanPrefectCloudFree) {
+		return "Prefect Cloud"
+	}
+
+	for _, plan := range AllCloudPlans {
+		if slices.Contains(plans, plan) {
+			return plan
+		}
+	}
+
+	return "Prefect Cloud"
+}
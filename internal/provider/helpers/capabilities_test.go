@@ -0,0 +1,156 @@
+package helpers_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckServerCapability(t *testing.T) {
+	t.Parallel()
+
+	oss := func(version string) api.ServerInfo {
+		return api.ServerInfo{Flavor: api.ServerFlavorOSS, Version: version}
+	}
+	cloud := func(planType string) api.ServerInfo {
+		return api.ServerInfo{Flavor: api.ServerFlavorCloud, PlanType: planType}
+	}
+	proAndEnterprise := []string{helpers.PlanPrefectCloudPro, helpers.PlanPrefectCloudEnterprise}
+
+	tests := []struct {
+		name       string
+		info       api.ServerInfo
+		minVersion string
+		plans      []string
+		wantError  string
+	}{
+		{
+			name:  "unknown server",
+			info:  api.ServerInfo{},
+			plans: []string{helpers.PlanPrefectCloudEnterprise},
+		},
+		{
+			name:  "self-hosted server for all plans",
+			info:  oss("3.1.0"),
+			plans: helpers.AllPlans,
+		},
+		{
+			name:      "self-hosted server for a Cloud feature",
+			info:      oss("3.1.0"),
+			plans:     helpers.AllCloudPlans,
+			wantError: "The prefect_test resource requires Prefect Cloud, but the provider is connected to a self-hosted Prefect server.",
+		},
+		{
+			name:      "self-hosted server for a Pro feature",
+			info:      oss("3.1.0"),
+			plans:     proAndEnterprise,
+			wantError: "The prefect_test resource requires Prefect Cloud (Pro), but the provider is connected to a self-hosted Prefect server.",
+		},
+		{
+			name:       "self-hosted server at the minimum version",
+			info:       oss("3.0.0"),
+			minVersion: "3.0.0",
+			plans:      helpers.AllPlans,
+		},
+		{
+			name:       "self-hosted server below the minimum version",
+			info:       oss("2.20.16"),
+			minVersion: "3.0.0",
+			plans:      helpers.AllPlans,
+			wantError:  "The prefect_test resource requires Prefect server >= 3.0.0, but the provider is connected to Prefect server 2.20.16.",
+		},
+		{
+			name:       "self-hosted server with an unknown version",
+			info:       oss(""),
+			minVersion: "3.0.0",
+			plans:      helpers.AllPlans,
+		},
+		{
+			name:  "Cloud plan supported",
+			info:  cloud("PRO"),
+			plans: proAndEnterprise,
+		},
+		{
+			name:      "Cloud plan not supported",
+			info:      cloud("PRO"),
+			plans:     []string{helpers.PlanPrefectCloudEnterprise},
+			wantError: "The prefect_test resource requires Prefect Cloud (Enterprise), but the provider's account is on the Prefect Cloud (Pro) plan.",
+		},
+		{
+			name:      "Cloud free plan",
+			info:      cloud("free"),
+			plans:     proAndEnterprise,
+			wantError: "The prefect_test resource requires Prefect Cloud (Pro), but the provider's account is on the Prefect Cloud (Free) plan.",
+		},
+		{
+			name:  "Cloud unrecognized plan",
+			info:  cloud("STARTER"),
+			plans: []string{helpers.PlanPrefectCloudEnterprise},
+		},
+		{
+			name:  "Cloud unknown plan",
+			info:  cloud(""),
+			plans: []string{helpers.PlanPrefectCloudEnterprise},
+		},
+		{
+			name:       "Cloud ignores the minimum server version",
+			info:       cloud("ENTERPRISE"),
+			minVersion: "3.0.0",
+			plans:      helpers.AllPlans,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := helpers.CheckServerCapability(tt.info, "The prefect_test resource", tt.minVersion, tt.plans...)
+			if tt.wantError == "" {
+				assert.False(t, diags.HasError(), "unexpected errors: %v", diags.Errors())
+
+				return
+			}
+
+			require.Len(t, diags.Errors(), 1)
+			assert.Contains(t, diags.Errors()[0].Detail(), tt.wantError)
+		})
+	}
+}
+
+func TestIsCreate(t *testing.T) {
+	t.Parallel()
+
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"id": tftypes.String}}
+	null := tftypes.NewValue(objectType, nil)
+	known := tftypes.NewValue(objectType, map[string]tftypes.Value{"id": tftypes.NewValue(tftypes.String, "id")})
+
+	tests := []struct {
+		name  string
+		state tftypes.Value
+		plan  tftypes.Value
+		want  bool
+	}{
+		{name: "create", state: null, plan: known, want: true},
+		{name: "update", state: known, plan: known, want: false},
+		{name: "delete", state: known, plan: null, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := resource.ModifyPlanRequest{
+				State: tfsdk.State{Raw: tt.state},
+				Plan:  tfsdk.Plan{Raw: tt.plan},
+			}
+
+			assert.Equal(t, tt.want, helpers.IsCreate(req))
+		})
+	}
+}
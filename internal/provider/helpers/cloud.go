@@ -1,7 +1,48 @@
 package helpers
 
-import "strings"
+import (
+	"net/url"
+	"strings"
+)
 
-func IsCloudEndpoint(endpoint string) bool {
-	return strings.Contains(endpoint, "api.prefect.cloud") || strings.Contains(endpoint, "api.prefect.dev") || strings.Contains(endpoint, "api.stg.prefect.dev")
+// cloudHosts are the API hosts of Prefect Cloud and its internal environments.
+var cloudHosts = []string{"api.prefect.cloud", "api.prefect.dev", "api.stg.prefect.dev"}
+
+// IsCloudEndpoint reports whether an endpoint URL or host belongs to Prefect Cloud.
+// In addition to the public Prefect Cloud hosts, extraCloudHosts lists
+// hostnames that also serve Prefect Cloud, such as private-link hostnames.
+// These must match the endpoint's hostname exactly, ignoring case and port.
+func IsCloudEndpoint(endpoint string, extraCloudHosts ...string) bool {
+	for _, host := range cloudHosts {
+		if strings.Contains(endpoint, host) {
+			return true
+		}
+	}
+
+	if len(extraCloudHosts) == 0 {
+		return false
+	}
+
+	hostname := endpointHostname(endpoint)
+	for _, host := range extraCloudHosts {
+		if host != "" && strings.EqualFold(hostname, strings.TrimSpace(host)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// endpointHostname returns the hostname of an endpoint URL or host, without the port.
+func endpointHostname(endpoint string) string {
+	if !strings.Contains(endpoint, "://") {
+		endpoint = "//" + endpoint
+	}
+
+	endpointURL, err := url.Parse(endpoint)
+	if err != nil {
+		return ""
+	}
+
+	return endpointURL.Hostname()
 }
@@ -0,0 +1,38 @@
+package helpers_test
+
+import (
+	"testing"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsCloudEndpoint(t *testing.T) {
+	t.Parallel()
+
+	privateLink := []string{"prefect.privatelink.example.com"}
+
+	tests := []struct {
+		name       string
+		endpoint   string
+		cloudHosts []string
+		want       bool
+	}{
+		{name: "Prefect Cloud URL", endpoint: "https://api.prefect.cloud/api", want: true},
+		{name: "Prefect Cloud host", endpoint: "api.prefect.cloud", want: true},
+		{name: "staging host", endpoint: "https://api.stg.prefect.dev/api", want: true},
+		{name: "self-hosted server", endpoint: "http://localhost:4200/api", want: false},
+		{name: "private-link URL", endpoint: "https://prefect.privatelink.example.com/api", cloudHosts: privateLink, want: true},
+		{name: "private-link host with port", endpoint: "PREFECT.privatelink.example.com:443", cloudHosts: privateLink, want: true},
+		{name: "private-link host not listed", endpoint: "https://prefect.privatelink.example.com/api", want: false},
+		{name: "private-link subdomain", endpoint: "https://eu.prefect.privatelink.example.com/api", cloudHosts: privateLink, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, helpers.IsCloudEndpoint(tt.endpoint, tt.cloudHosts...))
+		})
+	}
+}
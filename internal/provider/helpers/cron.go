@@ -0,0 +1,355 @@
+package helpers
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cronYears bounds the search for the next runs of a cron schedule. The
+// Gregorian calendar repeats every 400 years, so a schedule that does not
+// fire within that many years never fires, such as on February 30th.
+const cronYears = 400
+
+// cronMacros are the macros accepted in place of a cron expression.
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// cronField describes a field of a cron expression.
+type cronField struct {
+	name     string
+	min, max int
+	names    []string
+}
+
+var (
+	cronMinute = cronField{name: "minute", min: 0, max: 59}
+	cronHour   = cronField{name: "hour", min: 0, max: 23}
+	cronDom    = cronField{name: "day of month", min: 1, max: 31}
+	cronMonth  = cronField{name: "month", min: 1, max: 12, names: []string{"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}}
+	cronDow    = cronField{name: "day of week", min: 0, max: 7, names: []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}}
+	cronSecond = cronField{name: "second", min: 0, max: 59}
+	cronYear   = cronField{name: "year", min: 1970, max: 2099}
+)
+
+// cronSchedule is a parsed cron expression. Each field holds the values it
+// matches, and whether it matches all of its values, like `*`.
+type cronSchedule struct {
+	seconds, minutes, hours, months, years cronValues
+
+	// dom holds the days of the month, and lastDom whether it matches the
+	// last day of the month, `L`.
+	dom     cronValues
+	lastDom bool
+
+	// dow holds the days of the week, with Sunday as 0. nthDow holds the
+	// days matching the nth such day of the month, `5#3`, by weekday, and
+	// lastDow those matching the last such day of the month, `L5`.
+	dow     cronValues
+	nthDow  map[time.Weekday][]int
+	lastDow []time.Weekday
+}
+
+// cronValues are the values matched by a field of a cron expression.
+type cronValues struct {
+	values []int
+	all    bool
+}
+
+func (v cronValues) matches(value int) bool {
+	return v.all || slices.Contains(v.values, value)
+}
+
+// parseCron parses a cron expression with the syntax of croniter, which
+// Prefect's cron schedules use: five fields (minute, hour, day of month, month
+// and day of week), an optional sixth field for seconds and an optional
+// seventh field for years, or one of the cronMacros. On top of lists, ranges,
+// steps and month and weekday names, the day of month accepts `L` for the last
+// day of the month, and the day of week accepts `5#3` for the third Friday and
+// `L5` for the last Friday of the month.
+func parseCron(expression string) (*cronSchedule, error) {
+	normalized := strings.ToLower(strings.TrimSpace(expression))
+	if macro, ok := cronMacros[normalized]; ok {
+		normalized = macro
+	}
+
+	fields := strings.Fields(normalized)
+	if len(fields) < 5 || len(fields) > 7 {
+		return nil, fmt.Errorf("invalid cron expression %q: expected 5, 6 or 7 fields, got %d", expression, len(fields))
+	}
+
+	// The seconds and years are optional, and match 0 and every year.
+	fields = append(fields, []string{"0", "*"}[len(fields)-5:]...)
+
+	schedule := &cronSchedule{nthDow: map[time.Weekday][]int{}}
+
+	var err error
+	parse := func(field cronField, value string) cronValues {
+		if err != nil {
+			return cronValues{}
+		}
+
+		var values cronValues
+		values, err = field.parse(value, nil)
+
+		return values
+	}
+
+	schedule.minutes = parse(cronMinute, fields[0])
+	schedule.hours = parse(cronHour, fields[1])
+	schedule.months = parse(cronMonth, fields[3])
+	schedule.seconds = parse(cronSecond, fields[5])
+	schedule.years = parse(cronYear, fields[6])
+
+	if err == nil {
+		schedule.dom, err = cronDom.parse(fields[2], func(item string) (bool, error) {
+			if item != "l" {
+				return false, nil
+			}
+
+			schedule.lastDom = true
+
+			return true, nil
+		})
+	}
+
+	if err == nil {
+		schedule.dow, err = cronDow.parse(fields[4], schedule.parseSpecialDow)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid cron expression %q: %w", expression, err)
+	}
+
+	// Sunday is either 0 or 7.
+	for i, day := range schedule.dow.values {
+		if day == 7 {
+			schedule.dow.values[i] = 0
+		}
+	}
+
+	// Fields with special values do not match all days.
+	schedule.dom.all = schedule.dom.all && !schedule.lastDom
+	schedule.dow.all = schedule.dow.all && len(schedule.nthDow) == 0 && len(schedule.lastDow) == 0
+
+	return schedule, nil
+}
+
+// parseSpecialDow parses the nth weekday of the month, `5#3` or `fri#3`, and
+// the last weekday of the month, `L5`, reporting whether the item is one.
+func (s *cronSchedule) parseSpecialDow(item string) (bool, error) {
+	if day, ok := strings.CutPrefix(item, "l"); ok && day != "" {
+		value, err := cronDow.value(day)
+		if err != nil {
+			return true, err
+		}
+
+		s.lastDow = append(s.lastDow, time.Weekday(value%7))
+
+		return true, nil
+	}
+
+	days, nth, ok := strings.Cut(item, "#")
+	if !ok {
+		return false, nil
+	}
+
+	n, err := strconv.Atoi(nth)
+	if err != nil || n < 1 || n > 5 {
+		return true, fmt.Errorf("invalid day of week %q: the nth day must be between 1 and 5", item)
+	}
+
+	values, err := cronDow.parse(days, nil)
+	if err != nil {
+		return true, err
+	}
+
+	for _, value := range values.values {
+		weekday := time.Weekday(value % 7)
+		s.nthDow[weekday] = append(s.nthDow[weekday], n)
+	}
+
+	return true, nil
+}
+
+// parse parses a field of a cron expression, a comma-separated list of `*`,
+// values, ranges and steps. Special items that the field accepts, such as
+// `L`, are handled by special, which reports whether it handled an item.
+func (f cronField) parse(value string, special func(item string) (bool, error)) (cronValues, error) {
+	var values cronValues
+
+	for item := range strings.SplitSeq(value, ",") {
+		if special != nil {
+			handled, err := special(item)
+			if err != nil {
+				return values, err
+			}
+
+			if handled {
+				continue
+			}
+		}
+
+		low, high, step, err := f.parseRange(item)
+		if err != nil {
+			return values, err
+		}
+
+		for value := low; value <= high; value += step {
+			if !slices.Contains(values.values, value) {
+				values.values = append(values.values, value)
+			}
+		}
+	}
+
+	slices.Sort(values.values)
+
+	// Like croniter, a field matching all of its values is treated as `*`,
+	// such as when both the day of month and the day of week are set.
+	values.all = len(values.values) == f.max-f.min+1
+	if f.name == cronDow.name {
+		values.all = slices.Contains(values.values, 0) || slices.Contains(values.values, 7)
+		for day := 1; day <= 6; day++ {
+			values.all = values.all && slices.Contains(values.values, day)
+		}
+	}
+
+	return values, nil
+}
+
+// parseRange parses `*`, a value, or a range of values, with an optional step.
+func (f cronField) parseRange(item string) (int, int, int, error) {
+	valueRange, stepValue, hasStep := strings.Cut(item, "/")
+
+	step := 1
+	if hasStep {
+		var err error
+
+		step, err = strconv.Atoi(stepValue)
+		if err != nil || step < 1 {
+			return 0, 0, 0, fmt.Errorf("invalid %s %q: the step must be a positive number", f.name, item)
+		}
+	}
+
+	if valueRange == "*" || valueRange == "?" {
+		return f.min, f.max, step, nil
+	}
+
+	lowValue, highValue, isRange := strings.Cut(valueRange, "-")
+
+	low, err := f.value(lowValue)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	high := low
+	switch {
+	case isRange:
+		// Like croniter, the day of month accepts `L` as the end of a range.
+		if f.name == cronDom.name && highValue == "l" {
+			highValue = strconv.Itoa(f.max)
+		}
+
+		high, err = f.value(highValue)
+		if err != nil {
+			return 0, 0, 0, err
+		}
+
+	case hasStep:
+		// A value with a step, such as `5/15`, runs to the end of the field.
+		high = f.max
+	}
+
+	if low > high {
+		return 0, 0, 0, fmt.Errorf("invalid %s %q: the range must be ascending", f.name, item)
+	}
+
+	return low, high, step, nil
+}
+
+// value parses a value of the field, as a number or a name.
+func (f cronField) value(value string) (int, error) {
+	if i := slices.Index(f.names, value); i >= 0 {
+		return i + f.min, nil
+	}
+
+	number, err := strconv.Atoi(value)
+	if err != nil || number < f.min || number > f.max {
+		return 0, fmt.Errorf("invalid %s %q: expected a value between %d and %d", f.name, value, f.min, f.max)
+	}
+
+	return number, nil
+}
+
+// matchesDay reports whether the schedule fires on a day. When dayOr is true
+// and both the day of month and the day of week are restricted, a day matching
+// either of them matches, and otherwise a day must match both of them, like
+// the day_or setting of Prefect's cron schedules.
+func (s *cronSchedule) matchesDay(day time.Time, dayOr bool) bool {
+	if !s.months.matches(int(day.Month())) || !s.years.matches(day.Year()) {
+		return false
+	}
+
+	lastDay := time.Date(day.Year(), day.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+
+	domMatches := s.dom.matches(day.Day()) || (s.lastDom && day.Day() == lastDay)
+	dowMatches := s.dow.matches(int(day.Weekday())) ||
+		slices.Contains(s.nthDow[day.Weekday()], (day.Day()-1)/7+1) ||
+		(slices.Contains(s.lastDow, day.Weekday()) && day.Day()+7 > lastDay)
+
+	switch {
+	case s.dom.all:
+		return dowMatches
+	case s.dow.all:
+		return domMatches
+	case dayOr:
+		return domMatches || dowMatches
+	default:
+		return domMatches && dowMatches
+	}
+}
+
+// nextRuns returns up to count times after the given time at which the
+// schedule fires in the given location. Times that do not exist in the
+// location, such as when clocks move forward for daylight saving time, are
+// skipped.
+func (s *cronSchedule) nextRuns(dayOr bool, location *time.Location, after time.Time, count int) []time.Time {
+	runs := make([]time.Time, 0, count)
+
+	start := after.In(location)
+	day := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+	end := day.AddDate(cronYears, 0, 0)
+
+	for ; len(runs) < count && day.Before(end); day = day.AddDate(0, 0, 1) {
+		if !s.matchesDay(day, dayOr) {
+			continue
+		}
+
+		for _, hour := range s.hours.values {
+			for _, minute := range s.minutes.values {
+				for _, second := range s.seconds.values {
+					run := time.Date(day.Year(), day.Month(), day.Day(), hour, minute, second, 0, location)
+					if !run.After(after) || run.Hour() != hour || run.Minute() != minute {
+						continue
+					}
+
+					runs = append(runs, run)
+					if len(runs) == count {
+						return runs
+					}
+				}
+			}
+		}
+	}
+
+	return runs
+}
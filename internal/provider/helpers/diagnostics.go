@@ -1,10 +1,13 @@
 package helpers
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
 )
 
 const (
@@ -25,14 +28,53 @@ func CreateClientErrorDiagnostic(clientName string, err error) diag.Diagnostic {
 // ResourceClientErrorDiagnostic returns an error diagnostic for when a
 // client call fails during any resource operations (CRUD).
 //
+// If the API rejected the request with a validation error that points at
+// a specific field, the diagnostic is attached to the matching attribute.
+//
 //nolint:ireturn // required by Terraform API
 func ResourceClientErrorDiagnostic(resourceName string, operation string, err error) diag.Diagnostic {
+	summary := fmt.Sprintf("Error during %s %s", operation, resourceName)
+
+	var apiErr *api.APIError
+	if errors.As(err, &apiErr) {
+		if attributePath, ok := AttributePathFromAPIError(apiErr); ok {
+			return diag.NewAttributeErrorDiagnostic(
+				attributePath,
+				summary,
+				fmt.Sprintf("Could not %s %s, invalid value: %s", operation, resourceName, apiErr.Summary()),
+			)
+		}
+	}
+
 	return diag.NewErrorDiagnostic(
-		fmt.Sprintf("Error during %s %s", operation, resourceName),
+		summary,
 		fmt.Sprintf("Could not %s %s, unexpected error: %s", operation, resourceName, err.Error()),
 	)
 }
 
+// AttributePathFromAPIError maps the location of the first field-level
+// validation error in an API error onto a Terraform attribute path.
+//
+// FastAPI reports locations like ["body", "job_variables", "image"]. Nested
+// API values are often stored in a single JSON-encoded attribute, so only
+// the top-level field is used - the full location is kept in the message.
+func AttributePathFromAPIError(apiErr *api.APIError) (path.Path, bool) {
+	if !errors.Is(apiErr, api.ErrValidation) {
+		return path.Empty(), false
+	}
+
+	for _, detail := range apiErr.Details {
+		field := detail.Field()
+		if len(field) == 0 || strings.TrimSpace(field[0]) == "" {
+			continue
+		}
+
+		return path.Root(field[0]), true
+	}
+
+	return path.Empty(), false
+}
+
 // ConfigureTypeErrorDiagnostic returns an error diagnostic for when a
 // given type does not implement PrefectClient.
 //
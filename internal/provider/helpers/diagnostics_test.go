@@ -0,0 +1,72 @@
+package helpers_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceClientErrorDiagnostic(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		err      error
+		wantPath path.Path
+	}{
+		{
+			name: "validation error on a field",
+			err: api.NewAPIError(http.StatusUnprocessableEntity, "", []byte(
+				`{"detail": [{"loc": ["body", "job_variables", "image"], "msg": "Field required", "type": "missing"}]}`,
+			)),
+			wantPath: path.Root("job_variables"),
+		},
+		{
+			name: "wrapped validation error on a field",
+			err: fmt.Errorf("failed to create deployment: %w", api.NewAPIError(http.StatusUnprocessableEntity, "", []byte(
+				`{"detail": [{"loc": ["body", "tags", 1], "msg": "Input should be a valid string", "type": "string_type"}]}`,
+			))),
+			wantPath: path.Root("tags"),
+		},
+		{
+			name: "validation error on the whole body",
+			err: api.NewAPIError(http.StatusUnprocessableEntity, "", []byte(
+				`{"detail": [{"loc": ["body"], "msg": "Invalid JSON", "type": "json_invalid"}]}`,
+			)),
+			wantPath: path.Empty(),
+		},
+		{
+			name:     "non-validation error",
+			err:      api.NewAPIError(http.StatusNotFound, "", []byte(`{"detail": "Not found."}`)),
+			wantPath: path.Empty(),
+		},
+		{
+			name:     "non-API error",
+			err:      fmt.Errorf("http error: connection refused"),
+			wantPath: path.Empty(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := helpers.ResourceClientErrorDiagnostic("Deployment", "create", tt.err)
+
+			assert.Equal(t, diag.SeverityError, got.Severity())
+
+			gotPath := path.Empty()
+			if withPath, ok := got.(diag.DiagnosticWithPath); ok {
+				gotPath = withPath.Path()
+			}
+
+			assert.Equal(t, tt.wantPath, gotPath)
+		})
+	}
+}
@@ -8,9 +8,16 @@ import (
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 func importState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, identifier string) {
+	if IsImportByIdentity(req) {
+		ImportStateFromIdentity(ctx, req, resp)
+
+		return
+	}
+
 	maxInputCount := 2
 	inputParts := strings.Split(req.ID, ",")
 
@@ -56,6 +63,8 @@ func importState(ctx context.Context, req resource.ImportStateRequest, resp *res
 // - "id,workspace_id"
 // - "id"
 //
+// or the identity set in an import block.
+//
 // To import by name instead of ID, see ImportStateByName.
 func ImportStateByID(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	importState(ctx, req, resp, "id")
@@ -67,7 +76,62 @@ func ImportStateByID(ctx context.Context, req resource.ImportStateRequest, resp
 // - "name,workspace_id"
 // - "name"
 //
+// or the identity set in an import block.
+//
 // To import by ID instead of name, see ImportStateByID.
 func ImportStateByName(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	importState(ctx, req, resp, "name")
 }
+
+// IsImportByIdentity reports whether the resource is imported from the
+// `identity` of an import block, rather than from an import identifier.
+func IsImportByIdentity(req resource.ImportStateRequest) bool {
+	return req.ID == "" && req.Identity != nil && !req.Identity.Raw.IsNull()
+}
+
+// ImportStateFromIdentity imports the resource into Terraform state from the
+// `identity` of an import block, setting every identity attribute that is
+// not null on the state attribute of the same name.
+func ImportStateFromIdentity(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var attributes map[string]tftypes.Value
+	if err := req.Identity.Raw.As(&attributes); err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identity",
+			fmt.Sprintf("Could not read the import identity: %s. This is a bug in the Terraform provider. Please report it to the maintainers.", err),
+		)
+
+		return
+	}
+
+	for name, value := range attributes {
+		var attribute *string
+		if err := value.As(&attribute); err != nil {
+			resp.Diagnostics.AddError(
+				"Unexpected Import Identity",
+				fmt.Sprintf("Could not read the %q identity attribute: %s. This is a bug in the Terraform provider. Please report it to the maintainers.", name, err),
+			)
+
+			return
+		}
+
+		if attribute != nil {
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(name), *attribute)...)
+		}
+	}
+}
+
+// ImportStateByIdentity imports a resource that can only be imported from
+// the `identity` of an import block, as its import identifier would be made
+// of several keys.
+func ImportStateByIdentity(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if !IsImportByIdentity(req) {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("This resource can only be imported with an import block setting its identity, got the import identifier %q.", req.ID),
+		)
+
+		return
+	}
+
+	ImportStateFromIdentity(ctx, req, resp)
+}
@@ -0,0 +1,154 @@
+package helpers_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var importTestSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"account_id":   schema.StringAttribute{Optional: true},
+		"id":           schema.StringAttribute{Computed: true},
+		"name":         schema.StringAttribute{Required: true},
+		"workspace_id": schema.StringAttribute{Optional: true},
+	},
+}
+
+var importTestIdentitySchema = identityschema.Schema{
+	Attributes: map[string]identityschema.Attribute{
+		"id":           identityschema.StringAttribute{RequiredForImport: true},
+		"workspace_id": identityschema.StringAttribute{OptionalForImport: true},
+	},
+}
+
+// newImportStateTest returns an import request, from either an import
+// identifier or an identity, and an empty response like the framework's.
+func newImportStateTest(ctx context.Context, id string, identity map[string]*string) (resource.ImportStateRequest, *resource.ImportStateResponse) {
+	identityType := importTestIdentitySchema.Type().TerraformType(ctx)
+	identityValue := tftypes.NewValue(identityType, nil)
+
+	if identity != nil {
+		attributes := map[string]tftypes.Value{}
+		for name, value := range identity {
+			attributes[name] = tftypes.NewValue(tftypes.String, value)
+		}
+
+		identityValue = tftypes.NewValue(identityType, attributes)
+	}
+
+	req := resource.ImportStateRequest{
+		ID:       id,
+		Identity: &tfsdk.ResourceIdentity{Schema: importTestIdentitySchema, Raw: identityValue},
+	}
+	resp := &resource.ImportStateResponse{
+		State: tfsdk.State{
+			Schema: importTestSchema,
+			Raw:    tftypes.NewValue(importTestSchema.Type().TerraformType(ctx), nil),
+		},
+	}
+
+	return req, resp
+}
+
+func stringPtr(value string) *string {
+	return &value
+}
+
+func TestImportStateByID(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		id              string
+		identity        map[string]*string
+		wantID          types.String
+		wantWorkspaceID types.String
+		wantError       bool
+	}{
+		{
+			name:            "import identifier",
+			id:              "my-id",
+			wantID:          types.StringValue("my-id"),
+			wantWorkspaceID: types.StringNull(),
+		},
+		{
+			name:            "import identifier with workspace",
+			id:              "my-id,00000000-0000-0000-0000-000000000001",
+			wantID:          types.StringValue("my-id"),
+			wantWorkspaceID: types.StringValue("00000000-0000-0000-0000-000000000001"),
+		},
+		{
+			name:            "identity",
+			identity:        map[string]*string{"id": stringPtr("my-id"), "workspace_id": stringPtr("00000000-0000-0000-0000-000000000001")},
+			wantID:          types.StringValue("my-id"),
+			wantWorkspaceID: types.StringValue("00000000-0000-0000-0000-000000000001"),
+		},
+		{
+			name:            "identity without workspace",
+			identity:        map[string]*string{"id": stringPtr("my-id"), "workspace_id": nil},
+			wantID:          types.StringValue("my-id"),
+			wantWorkspaceID: types.StringNull(),
+		},
+		{
+			name:      "too many import identifiers",
+			id:        "a,b,c",
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			req, resp := newImportStateTest(ctx, tt.id, tt.identity)
+
+			helpers.ImportStateByID(ctx, req, resp)
+
+			if tt.wantError {
+				assert.True(t, resp.Diagnostics.HasError())
+
+				return
+			}
+
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+			var id, workspaceID types.String
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &id)...)
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("workspace_id"), &workspaceID)...)
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+			assert.Equal(t, tt.wantID, id)
+			assert.Equal(t, tt.wantWorkspaceID, workspaceID)
+		})
+	}
+}
+
+func TestImportStateByIdentity(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	req, resp := newImportStateTest(ctx, "", map[string]*string{"id": stringPtr("my-id"), "workspace_id": nil})
+	helpers.ImportStateByIdentity(ctx, req, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var id types.String
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	assert.Equal(t, types.StringValue("my-id"), id)
+
+	req, resp = newImportStateTest(ctx, "my-id", nil)
+	helpers.ImportStateByIdentity(ctx, req, resp)
+	assert.True(t, resp.Diagnostics.HasError())
+}
@@ -0,0 +1,331 @@
+package helpers
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// SchemaViolation is a value that does not conform to a JSON schema.
+type SchemaViolation struct {
+	// Path is the location of the value, such as "retries" or "env.items[0]".
+	Path    string
+	Message string
+}
+
+func (v SchemaViolation) String() string {
+	if v.Path == "" {
+		return v.Message
+	}
+
+	return fmt.Sprintf("%s: %s", v.Path, v.Message)
+}
+
+// ValidateJSONSchema validates a decoded JSON value against a JSON schema, as
+// generated by Prefect for flow parameters and work pool job variables.
+//
+// It supports the subset of JSON schema used by Prefect: types, enums and
+// constants, object properties, array items, local references, and the
+// anyOf, oneOf and allOf combinators. Like Prefect does for deployments,
+// required properties are not checked, as they can be set on each flow run.
+// Values that Prefect resolves at run time, such as "{{ prefect.variables.x }}"
+// templates and "__prefect_kind" objects, are not checked either.
+func ValidateJSONSchema(schema map[string]any, value any) []SchemaViolation {
+	validator := schemaValidator{root: schema}
+	validator.validate(schema, value, "")
+
+	return validator.violations
+}
+
+type schemaValidator struct {
+	root       map[string]any
+	violations []SchemaViolation
+}
+
+func (v *schemaValidator) addViolation(path, format string, args ...any) {
+	v.violations = append(v.violations, SchemaViolation{Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+// maxRefDepth bounds how many references are followed in a row,
+// so that a reference to itself cannot loop forever.
+const maxRefDepth = 32
+
+func (v *schemaValidator) validate(schema map[string]any, value any, path string) {
+	schema = v.resolve(schema)
+	if schema == nil || isRunTimeValue(value) {
+		return
+	}
+
+	if allOf, ok := schema["allOf"].([]any); ok {
+		for _, subSchema := range allOf {
+			if subSchema, ok := subSchema.(map[string]any); ok {
+				v.validate(subSchema, value, path)
+			}
+		}
+	}
+
+	for _, combinator := range []string{"anyOf", "oneOf"} {
+		if options, ok := schema[combinator].([]any); ok && !v.matchesAny(options, value) {
+			// When a single option has the type of the value, such as for an
+			// optional object, report why the value does not conform to it.
+			if option, ok := v.optionOfType(options, value); ok {
+				v.validate(option, value, path)
+			} else if types := v.optionTypes(options); len(types) > 0 {
+				v.addViolation(path, "expected %s, got %s", strings.Join(types, " or "), typeOf(value))
+			} else {
+				v.addViolation(path, "does not match any of the allowed schemas")
+			}
+
+			return
+		}
+	}
+
+	if types := schemaTypes(schema); len(types) > 0 && !slices.ContainsFunc(types, func(t string) bool { return hasType(value, t) }) {
+		v.addViolation(path, "expected %s, got %s", strings.Join(types, " or "), typeOf(value))
+
+		return
+	}
+
+	if enum, ok := schema["enum"].([]any); ok && !slices.ContainsFunc(enum, func(allowed any) bool { return reflect.DeepEqual(allowed, value) }) {
+		v.addViolation(path, "must be one of %s, got %s", formatValues(enum), formatValue(value))
+	}
+
+	if constant, ok := schema["const"]; ok && !reflect.DeepEqual(constant, value) {
+		v.addViolation(path, "must be %s, got %s", formatValue(constant), formatValue(value))
+	}
+
+	switch value := value.(type) {
+	case map[string]any:
+		v.validateObject(schema, value, path)
+	case []any:
+		if items, ok := schema["items"].(map[string]any); ok {
+			for i, item := range value {
+				v.validate(items, item, fmt.Sprintf("%s[%d]", path, i))
+			}
+		}
+	}
+}
+
+func (v *schemaValidator) validateObject(schema map[string]any, value map[string]any, path string) {
+	properties, _ := schema["properties"].(map[string]any)
+
+	keys := make([]string, 0, len(value))
+	for key := range value {
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		keyPath := joinSchemaPath(path, key)
+
+		if property, ok := properties[key].(map[string]any); ok {
+			v.validate(property, value[key], keyPath)
+
+			continue
+		}
+
+		switch additional := schema["additionalProperties"].(type) {
+		case bool:
+			if !additional {
+				v.addViolation(keyPath, "is not an allowed property")
+			}
+		case map[string]any:
+			v.validate(additional, value[key], keyPath)
+		}
+	}
+}
+
+// matchesAny reports whether the value conforms to any of the schemas.
+func (v *schemaValidator) matchesAny(options []any, value any) bool {
+	for _, option := range options {
+		option, ok := option.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		nested := schemaValidator{root: v.root}
+		nested.validate(option, value, "")
+
+		if len(nested.violations) == 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// resolve follows local references, such as "#/definitions/Config".
+// It returns nil for references that cannot be resolved, which are not checked.
+func (v *schemaValidator) resolve(schema map[string]any) map[string]any {
+	for range maxRefDepth {
+		ref, ok := schema["$ref"].(string)
+		if !ok {
+			return schema
+		}
+
+		if !strings.HasPrefix(ref, "#/") {
+			return nil
+		}
+
+		var target any = v.root
+		for _, segment := range strings.Split(strings.TrimPrefix(ref, "#/"), "/") {
+			object, ok := target.(map[string]any)
+			if !ok {
+				return nil
+			}
+
+			target = object[strings.ReplaceAll(strings.ReplaceAll(segment, "~1", "/"), "~0", "~")]
+		}
+
+		if schema, ok = target.(map[string]any); !ok {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// isRunTimeValue reports whether Prefect resolves the value when a flow run
+// is created, such as a template or a value hydrated from a block.
+func isRunTimeValue(value any) bool {
+	switch value := value.(type) {
+	case string:
+		return strings.Contains(value, "{{") && strings.Contains(value, "}}")
+	case map[string]any:
+		_, ok := value["__prefect_kind"]
+
+		return ok
+	}
+
+	return false
+}
+
+func schemaTypes(schema map[string]any) []string {
+	switch schemaType := schema["type"].(type) {
+	case string:
+		return []string{schemaType}
+	case []any:
+		types := []string{}
+		for _, t := range schemaType {
+			if t, ok := t.(string); ok {
+				types = append(types, t)
+			}
+		}
+
+		return types
+	}
+
+	return nil
+}
+
+func hasType(value any, schemaType string) bool {
+	switch schemaType {
+	case "integer":
+		number, ok := value.(float64)
+
+		return ok && number == math.Trunc(number)
+	case "number":
+		_, ok := value.(float64)
+
+		return ok
+	case "string", "boolean", "object", "array", "null":
+		return typeOf(value) == schemaType
+	}
+
+	// Unknown types are not checked.
+	return true
+}
+
+func typeOf(value any) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64:
+		return "number"
+	case string:
+		return "string"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	}
+
+	return fmt.Sprintf("%T", value)
+}
+
+// optionOfType returns the only schema that has the type of the value.
+func (v *schemaValidator) optionOfType(options []any, value any) (map[string]any, bool) {
+	var matches []map[string]any
+
+	for _, option := range options {
+		option, ok := option.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		option = v.resolve(option)
+		if types := schemaTypes(option); slices.ContainsFunc(types, func(t string) bool { return hasType(value, t) }) {
+			matches = append(matches, option)
+		}
+	}
+
+	if len(matches) != 1 {
+		return nil, false
+	}
+
+	return matches[0], true
+}
+
+// optionTypes returns the types allowed by any of the schemas.
+func (v *schemaValidator) optionTypes(options []any) []string {
+	types := []string{}
+
+	for _, option := range options {
+		option, ok := option.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		for _, t := range schemaTypes(v.resolve(option)) {
+			if !slices.Contains(types, t) {
+				types = append(types, t)
+			}
+		}
+	}
+
+	return types
+}
+
+func formatValue(value any) string {
+	switch value := value.(type) {
+	case string:
+		return strconv.Quote(value)
+	case nil:
+		return "null"
+	}
+
+	return fmt.Sprint(value)
+}
+
+func formatValues(values []any) string {
+	formatted := make([]string, 0, len(values))
+	for _, value := range values {
+		formatted = append(formatted, formatValue(value))
+	}
+
+	return strings.Join(formatted, ", ")
+}
+
+func joinSchemaPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
@@ -0,0 +1,121 @@
+package helpers_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// parameterSchema is a parameter schema as generated by Prefect for a flow.
+const parameterSchema = `{
+	"title": "Parameters",
+	"type": "object",
+	"properties": {
+		"name": {"title": "name", "type": "string"},
+		"retries": {"title": "retries", "default": 3, "type": "integer"},
+		"ratio": {"title": "ratio", "type": "number"},
+		"mode": {"title": "mode", "enum": ["fast", "slow"]},
+		"tags": {"title": "tags", "type": "array", "items": {"type": "string"}},
+		"config": {"title": "config", "anyOf": [{"$ref": "#/definitions/Config"}, {"type": "null"}]}
+	},
+	"required": ["name"],
+	"definitions": {
+		"Config": {
+			"title": "Config",
+			"type": "object",
+			"properties": {
+				"enabled": {"type": "boolean"},
+				"limit": {"type": ["integer", "null"]}
+			},
+			"additionalProperties": false
+		}
+	}
+}`
+
+func TestValidateJSONSchema(t *testing.T) {
+	t.Parallel()
+
+	var schema map[string]any
+	require.NoError(t, json.Unmarshal([]byte(parameterSchema), &schema))
+
+	tests := []struct {
+		name  string
+		value string
+		want  []string
+	}{
+		{
+			name:  "valid values",
+			value: `{"name": "test", "retries": 5, "ratio": 0.5, "mode": "fast", "tags": ["a", "b"], "config": {"enabled": true, "limit": null}}`,
+			want:  nil,
+		},
+		{
+			name:  "missing required values are not checked",
+			value: `{}`,
+			want:  nil,
+		},
+		{
+			name:  "optional value set to null",
+			value: `{"config": null}`,
+			want:  nil,
+		},
+		{
+			name:  "not an object",
+			value: `["test"]`,
+			want:  []string{"expected object, got array"},
+		},
+		{
+			name:  "wrong types",
+			value: `{"name": 1, "retries": "3", "ratio": true}`,
+			want:  []string{"name: expected string, got number", "ratio: expected number, got boolean", "retries: expected integer, got string"},
+		},
+		{
+			name:  "integer with a fraction",
+			value: `{"retries": 1.5}`,
+			want:  []string{"retries: expected integer, got number"},
+		},
+		{
+			name:  "value not in enum",
+			value: `{"mode": "medium"}`,
+			want:  []string{`mode: must be one of "fast", "slow", got "medium"`},
+		},
+		{
+			name:  "wrong array item",
+			value: `{"tags": ["a", 2]}`,
+			want:  []string{"tags[1]: expected string, got number"},
+		},
+		{
+			name:  "wrong nested value through a reference",
+			value: `{"config": {"enabled": "yes", "extra": 1}}`,
+			want:  []string{"config.enabled: expected boolean, got string", "config.extra: is not an allowed property"},
+		},
+		{
+			name:  "no matching option",
+			value: `{"config": "on"}`,
+			want:  []string{"config: expected object or null, got string"},
+		},
+		{
+			name:  "values resolved at run time",
+			value: `{"retries": "{{ prefect.variables.retries }}", "config": {"__prefect_kind": "json", "value": "{}"}}`,
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var value any
+			require.NoError(t, json.Unmarshal([]byte(tt.value), &value))
+
+			var got []string
+			for _, violation := range helpers.ValidateJSONSchema(schema, value) {
+				got = append(got, violation.String())
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
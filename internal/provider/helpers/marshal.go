@@ -1,6 +1,8 @@
 package helpers
 
 import (
+	"encoding/json"
+
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 )
@@ -18,3 +20,39 @@ func UnmarshalOptional(attribute jsontypes.Normalized) (map[string]interface{},
 
 	return result, diags
 }
+
+// MarshalOptional is the reverse of UnmarshalOptional, mapping an object read
+// from the API to an optional attribute. The API reads an unset object back as
+// an empty one, so an empty object is mapped to the form it is configured with:
+// `{}` if the configured value, such as the planned or prior value, is an empty
+// object, and null otherwise.
+func MarshalOptional(value map[string]interface{}, configured jsontypes.Normalized) (jsontypes.Normalized, error) {
+	if len(value) == 0 {
+		if isEmptyJSONObject(configured) {
+			return jsontypes.NewNormalizedValue("{}"), nil
+		}
+
+		return jsontypes.NewNormalizedNull(), nil
+	}
+
+	byteSlice, err := json.Marshal(value)
+	if err != nil {
+		return jsontypes.NewNormalizedNull(), err
+	}
+
+	return jsontypes.NewNormalizedValue(string(byteSlice)), nil
+}
+
+// isEmptyJSONObject reports whether an attribute holds an empty JSON object, `{}`.
+func isEmptyJSONObject(attribute jsontypes.Normalized) bool {
+	if attribute.IsNull() || attribute.IsUnknown() {
+		return false
+	}
+
+	var value map[string]interface{}
+	if err := json.Unmarshal([]byte(attribute.ValueString()), &value); err != nil {
+		return false
+	}
+
+	return value != nil && len(value) == 0
+}
@@ -0,0 +1,41 @@
+package helpers_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarshalOptional(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		value      map[string]interface{}
+		configured jsontypes.Normalized
+		want       jsontypes.Normalized
+	}{
+		{name: "object", value: map[string]interface{}{"key": "value"}, configured: jsontypes.NewNormalizedNull(), want: jsontypes.NewNormalizedValue(`{"key":"value"}`)},
+		{name: "empty object configured as null", value: map[string]interface{}{}, configured: jsontypes.NewNormalizedNull(), want: jsontypes.NewNormalizedNull()},
+		{name: "empty object configured as empty object", value: map[string]interface{}{}, configured: jsontypes.NewNormalizedValue("{}"), want: jsontypes.NewNormalizedValue("{}")},
+		{name: "empty object configured with whitespace", value: map[string]interface{}{}, configured: jsontypes.NewNormalizedValue(" { } "), want: jsontypes.NewNormalizedValue("{}")},
+		{name: "unset object configured as empty object", value: nil, configured: jsontypes.NewNormalizedValue("{}"), want: jsontypes.NewNormalizedValue("{}")},
+		{name: "empty object configured as object", value: map[string]interface{}{}, configured: jsontypes.NewNormalizedValue(`{"key": "value"}`), want: jsontypes.NewNormalizedNull()},
+		{name: "empty object configured as empty array", value: map[string]interface{}{}, configured: jsontypes.NewNormalizedValue("[]"), want: jsontypes.NewNormalizedNull()},
+		{name: "empty object configured as JSON null", value: map[string]interface{}{}, configured: jsontypes.NewNormalizedValue("null"), want: jsontypes.NewNormalizedNull()},
+		{name: "empty object configured as unknown", value: map[string]interface{}{}, configured: jsontypes.NewNormalizedUnknown(), want: jsontypes.NewNormalizedNull()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := helpers.MarshalOptional(tt.value, tt.configured)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,177 @@
+package helpers
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/teambition/rrule-go"
+)
+
+// ParseCron checks that an expression is valid for Prefect's cron schedules.
+func ParseCron(expression string) error {
+	_, err := parseCron(expression)
+
+	return err
+}
+
+// LoadTimezone returns the location for an IANA timezone name,
+// defaulting to UTC when the name is empty.
+func LoadTimezone(name string) (*time.Location, error) {
+	if name == "" {
+		return time.UTC, nil
+	}
+
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone %q: %w", name, err)
+	}
+
+	return location, nil
+}
+
+// CronNextRuns returns up to count times after the given time at which a cron
+// schedule fires in the given location.
+//
+// When dayOr is false and the expression restricts both the day of month and
+// the day of week, a time must match both of them, mirroring the day_or
+// setting of Prefect's cron schedules.
+func CronNextRuns(expression string, dayOr bool, location *time.Location, after time.Time, count int) ([]time.Time, error) {
+	schedule, err := parseCron(expression)
+	if err != nil {
+		return nil, err
+	}
+
+	return schedule.nextRuns(dayOr, location, after, count), nil
+}
+
+// parseRRule parses an RRule schedule in the given location, reporting
+// whether it has a DTSTART.
+func parseRRule(rule string, location *time.Location) (*rrule.Set, bool, error) {
+	lines := strings.Split(strings.TrimSpace(rule), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace(line)
+
+		// Like Prefect, accept a bare rule without the RRULE: property name.
+		if strings.HasPrefix(strings.ToUpper(lines[i]), "FREQ=") {
+			lines[i] = "RRULE:" + lines[i]
+		}
+	}
+
+	set, err := rrule.StrSliceToRRuleSetInLoc(lines, location)
+	if err != nil {
+		return nil, false, fmt.Errorf("invalid rrule %q: %w", rule, err)
+	}
+
+	if set.GetRRule() == nil && len(set.GetRDate()) == 0 {
+		return nil, false, fmt.Errorf("invalid rrule %q: it must contain an RRULE or RDATE", rule)
+	}
+
+	return set, strings.HasPrefix(strings.ToUpper(lines[0]), "DTSTART"), nil
+}
+
+// ParseRRule checks that a rule is valid for Prefect's RRule schedules.
+func ParseRRule(rule string) error {
+	_, _, err := parseRRule(rule, time.UTC)
+
+	return err
+}
+
+// RRuleNextRuns returns up to count times after the given time at which an
+// RRule schedule fires in the given location.
+//
+// Rules without a DTSTART start from the given time.
+func RRuleNextRuns(rule string, location *time.Location, after time.Time, count int) ([]time.Time, error) {
+	set, hasStart, err := parseRRule(rule, location)
+	if err != nil {
+		return nil, err
+	}
+
+	if !hasStart {
+		set.DTStart(after.In(location))
+	}
+
+	runs := make([]time.Time, 0, count)
+	next := set.Iterator()
+
+	for len(runs) < count {
+		value, ok := next()
+		if !ok {
+			break
+		}
+
+		if value.After(after) {
+			runs = append(runs, value.In(location))
+		}
+	}
+
+	return runs, nil
+}
+
+// anchorDateLayouts are the layouts accepted for the anchor date of interval
+// schedules. Dates without an offset are in the timezone of the schedule.
+var anchorDateLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999",
+	time.DateOnly,
+}
+
+// ParseAnchorDate parses the anchor date of an interval schedule, as an
+// ISO 8601 date or date and time, in the given location unless it has an offset.
+func ParseAnchorDate(value string, location *time.Location) (time.Time, error) {
+	for _, layout := range anchorDateLayouts {
+		if anchor, err := time.ParseInLocation(layout, value, location); err == nil {
+			return anchor, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid anchor date %q: expected an ISO 8601 timestamp, such as 2024-01-01T00:00:00Z", value)
+}
+
+// IntervalNextRuns returns up to count times after the given time at which an
+// interval schedule fires in the given location, starting from its anchor.
+//
+// Like Prefect, intervals of whole days keep the same local time across
+// daylight saving time changes, while shorter intervals do not.
+func IntervalNextRuns(interval time.Duration, anchor time.Time, location *time.Location, after time.Time, count int) ([]time.Time, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("invalid interval %s: it must be positive", interval)
+	}
+
+	anchor = anchor.In(location)
+	runs := make([]time.Time, 0, count)
+
+	const day = 24 * time.Hour
+	if interval%day == 0 {
+		days := int(interval / day)
+
+		// Skip to the last run before the given time, then step through the next ones.
+		steps := 0
+		if after.After(anchor) {
+			steps = int(after.Sub(anchor)/interval) - 1
+		}
+
+		for next := anchor.AddDate(0, 0, max(steps, 0)*days); len(runs) < count; next = next.AddDate(0, 0, days) {
+			if next.After(after) {
+				runs = append(runs, next)
+			}
+		}
+
+		return runs, nil
+	}
+
+	next := anchor
+	if after.After(anchor) {
+		next = anchor.Add((after.Sub(anchor)/interval + 1) * interval)
+	}
+
+	for ; len(runs) < count; next = next.Add(interval) {
+		if next.After(after) {
+			runs = append(runs, next)
+		}
+	}
+
+	return runs, nil
+}
@@ -0,0 +1,329 @@
+package helpers_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func formatRuns(runs []time.Time) []string {
+	formatted := make([]string, 0, len(runs))
+	for _, run := range runs {
+		formatted = append(formatted, run.Format(time.RFC3339))
+	}
+
+	return formatted
+}
+
+func TestParseCron(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		expression string
+		wantErr    bool
+	}{
+		{expression: "0 9 * * 1-5"},
+		{expression: "*/15 * * * *"},
+		{expression: "0 0 1 JAN *"},
+		{expression: "@daily"},
+		{expression: "0 9 * * 1#1"},
+		{expression: "0 9 * * mon#1,fri#3"},
+		{expression: "0 0 L * *"},
+		{expression: "0 0 15-L * *"},
+		{expression: "0 0 * * L5"},
+		{expression: "0 0 * * 7"},
+		{expression: "0 0 * * * 30"},
+		{expression: "0 0 1 1 * 0 2030"},
+		{expression: "0 9 * * 1#6", wantErr: true},
+		{expression: "0 0 L5 * *", wantErr: true},
+		{expression: "0 0 * * * 60", wantErr: true},
+		{expression: "0 0 * * * 0 1969", wantErr: true},
+		{expression: "0 0 * * * 0 * *", wantErr: true},
+		{expression: "0 0 5-1 * *", wantErr: true},
+		{expression: "*/0 * * * *", wantErr: true},
+		{expression: "@every 5m", wantErr: true},
+		{expression: "0 9 * *", wantErr: true},
+		{expression: "0 25 * * *", wantErr: true},
+		{expression: "not a cron", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			t.Parallel()
+
+			err := helpers.ParseCron(tt.expression)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCronNextRuns(t *testing.T) {
+	t.Parallel()
+
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	// A Wednesday, at 07:00 in New York.
+	after := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		expression string
+		dayOr      bool
+		location   *time.Location
+		count      int
+		want       []string
+	}{
+		{
+			name:       "weekdays in a timezone",
+			expression: "0 9 * * 1-5",
+			dayOr:      true,
+			location:   newYork,
+			count:      3,
+			want:       []string{"2025-01-01T09:00:00-05:00", "2025-01-02T09:00:00-05:00", "2025-01-03T09:00:00-05:00"},
+		},
+		{
+			name:       "day of month or day of week",
+			expression: "0 0 13 * 5",
+			dayOr:      true,
+			location:   time.UTC,
+			count:      3,
+			want:       []string{"2025-01-03T00:00:00Z", "2025-01-10T00:00:00Z", "2025-01-13T00:00:00Z"},
+		},
+		{
+			name:       "day of month and day of week",
+			expression: "0 0 13 * 5",
+			dayOr:      false,
+			location:   time.UTC,
+			count:      2,
+			want:       []string{"2025-06-13T00:00:00Z", "2026-02-13T00:00:00Z"},
+		},
+		{
+			name:       "first monday of the month",
+			expression: "0 9 * * 1#1",
+			dayOr:      true,
+			location:   time.UTC,
+			count:      3,
+			want:       []string{"2025-01-06T09:00:00Z", "2025-02-03T09:00:00Z", "2025-03-03T09:00:00Z"},
+		},
+		{
+			name:       "last day of the month",
+			expression: "0 0 L * *",
+			dayOr:      true,
+			location:   time.UTC,
+			count:      3,
+			want:       []string{"2025-01-31T00:00:00Z", "2025-02-28T00:00:00Z", "2025-03-31T00:00:00Z"},
+		},
+		{
+			name:       "last friday of the month",
+			expression: "0 0 * * L5",
+			dayOr:      true,
+			location:   time.UTC,
+			count:      3,
+			want:       []string{"2025-01-31T00:00:00Z", "2025-02-28T00:00:00Z", "2025-03-28T00:00:00Z"},
+		},
+		{
+			name:       "seconds and years",
+			expression: "0 0 1 1 * 30 2027",
+			dayOr:      true,
+			location:   time.UTC,
+			count:      2,
+			want:       []string{"2027-01-01T00:00:30Z"},
+		},
+		{
+			name:       "time skipped by daylight saving time",
+			expression: "30 2 * 3 0",
+			dayOr:      true,
+			location:   newYork,
+			count:      2,
+			want:       []string{"2025-03-02T02:30:00-05:00", "2025-03-16T02:30:00-04:00"},
+		},
+		{
+			name:       "schedule that never fires",
+			expression: "0 0 30 2 *",
+			dayOr:      true,
+			location:   time.UTC,
+			count:      1,
+			want:       []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			runs, err := helpers.CronNextRuns(tt.expression, tt.dayOr, tt.location, after, tt.count)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, formatRuns(runs))
+		})
+	}
+}
+
+func TestRRuleNextRuns(t *testing.T) {
+	t.Parallel()
+
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	after := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		rule     string
+		location *time.Location
+		count    int
+		want     []string
+		wantErr  bool
+	}{
+		{
+			name:     "bare rule starting after the given time",
+			rule:     "FREQ=WEEKLY;BYDAY=MO,FR;BYHOUR=9;BYMINUTE=0;BYSECOND=0",
+			location: newYork,
+			count:    3,
+			want:     []string{"2025-01-03T09:00:00-05:00", "2025-01-06T09:00:00-05:00", "2025-01-10T09:00:00-05:00"},
+		},
+		{
+			name:     "rule with a start date",
+			rule:     "DTSTART:20240101T060000Z\nRRULE:FREQ=DAILY;INTERVAL=10",
+			location: time.UTC,
+			count:    2,
+			want:     []string{"2025-01-05T06:00:00Z", "2025-01-15T06:00:00Z"},
+		},
+		{
+			name:     "rule that ends",
+			rule:     "DTSTART:20250101T000000Z\nRRULE:FREQ=DAILY;COUNT=3",
+			location: time.UTC,
+			count:    5,
+			want:     []string{"2025-01-02T00:00:00Z", "2025-01-03T00:00:00Z"},
+		},
+		{
+			name:     "invalid rule",
+			rule:     "FREQ=SOMETIMES",
+			location: time.UTC,
+			count:    1,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			runs, err := helpers.RRuleNextRuns(tt.rule, tt.location, after, tt.count)
+			if tt.wantErr {
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, formatRuns(runs))
+		})
+	}
+}
+
+func TestParseAnchorDate(t *testing.T) {
+	t.Parallel()
+
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	tests := []struct {
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{value: "2024-01-01T00:00:00Z", want: "2024-01-01T00:00:00Z"},
+		{value: "2024-01-01T00:00:00+01:00", want: "2024-01-01T00:00:00+01:00"},
+		{value: "2024-01-01T09:30:00.5", want: "2024-01-01T09:30:00-05:00"},
+		{value: "2024-01-01 09:30:00", want: "2024-01-01T09:30:00-05:00"},
+		{value: "2024-07-01", want: "2024-07-01T00:00:00-04:00"},
+		{value: "01/01/2024", wantErr: true},
+		{value: "2024-13-01T00:00:00Z", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			t.Parallel()
+
+			anchor, err := helpers.ParseAnchorDate(tt.value, newYork)
+			if tt.wantErr {
+				assert.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, anchor.Format(time.RFC3339))
+		})
+	}
+}
+
+func TestIntervalNextRuns(t *testing.T) {
+	t.Parallel()
+
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		interval time.Duration
+		anchor   time.Time
+		after    time.Time
+		count    int
+		want     []string
+	}{
+		{
+			name:     "anchor in the past",
+			interval: 90 * time.Minute,
+			anchor:   time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC),
+			after:    time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC),
+			count:    3,
+			want:     []string{"2025-01-01T08:30:00-05:00", "2025-01-01T10:00:00-05:00", "2025-01-01T11:30:00-05:00"},
+		},
+		{
+			name:     "anchor in the future",
+			interval: time.Hour,
+			anchor:   time.Date(2025, time.February, 1, 0, 0, 0, 0, time.UTC),
+			after:    time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC),
+			count:    2,
+			want:     []string{"2025-01-31T19:00:00-05:00", "2025-01-31T20:00:00-05:00"},
+		},
+		{
+			name:     "daily interval across a daylight saving time change",
+			interval: 24 * time.Hour,
+			anchor:   time.Date(2025, time.January, 1, 9, 0, 0, 0, newYork),
+			after:    time.Date(2025, time.March, 8, 12, 0, 0, 0, newYork),
+			count:    2,
+			want:     []string{"2025-03-09T09:00:00-04:00", "2025-03-10T09:00:00-04:00"},
+		},
+		{
+			name:     "hourly interval across a daylight saving time change",
+			interval: 12 * time.Hour,
+			anchor:   time.Date(2025, time.January, 1, 9, 0, 0, 0, newYork),
+			after:    time.Date(2025, time.March, 8, 12, 0, 0, 0, newYork),
+			count:    2,
+			want:     []string{"2025-03-08T21:00:00-05:00", "2025-03-09T10:00:00-04:00"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			runs, err := helpers.IntervalNextRuns(tt.interval, tt.anchor, newYork, tt.after, tt.count)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, formatRuns(runs))
+		})
+	}
+
+	_, err = helpers.IntervalNextRuns(0, time.Now(), time.UTC, time.Now(), 1)
+	assert.Error(t, err)
+}
@@ -0,0 +1,198 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+)
+
+// Object types that can be imported from a link to the Prefect UI.
+const (
+	UIObjectAutomation = "automation"
+	UIObjectBlock      = "block"
+	UIObjectDeployment = "deployment"
+	UIObjectFlow       = "flow"
+	UIObjectWorkPool   = "work_pool"
+	UIObjectWorkQueue  = "work_queue"
+)
+
+// uiObjectPath is the path of an object in the Prefect UI, where "*" stands
+// for the keys identifying the object.
+type uiObjectPath struct {
+	objectType string
+	segments   []string
+	// byID is set for objects identified by a UUID, rather than by name.
+	byID bool
+}
+
+// uiObjectPaths lists the object paths of the Prefect UI. Paths that extend
+// others come first, so that a link to a work queue is not taken for a link
+// to its work pool.
+var uiObjectPaths = []uiObjectPath{
+	{objectType: UIObjectWorkQueue, segments: []string{"work-pools", "work-pool", "*", "queue", "*"}},
+	{objectType: UIObjectWorkPool, segments: []string{"work-pools", "work-pool", "*"}},
+	{objectType: UIObjectDeployment, segments: []string{"deployments", "deployment", "*"}, byID: true},
+	{objectType: UIObjectFlow, segments: []string{"flows", "flow", "*"}, byID: true},
+	{objectType: UIObjectBlock, segments: []string{"blocks", "block", "*"}, byID: true},
+	{objectType: UIObjectAutomation, segments: []string{"automations", "automation", "*"}, byID: true},
+}
+
+// UIObject is an object linked to from the Prefect UI.
+type UIObject struct {
+	// AccountID and WorkspaceID are uuid.Nil for links to a Prefect server UI.
+	AccountID   uuid.UUID
+	WorkspaceID uuid.UUID
+
+	Type string
+	// Keys identify the object within its workspace, such as its ID,
+	// or the names of a work pool and one of its queues.
+	Keys []string
+}
+
+// IsUIURL reports whether an import identifier is a link, rather than an ID or name.
+func IsUIURL(id string) bool {
+	return strings.HasPrefix(id, "https://") || strings.HasPrefix(id, "http://")
+}
+
+// ParseUIURL parses a link to an object in the Prefect UI, such as
+// https://app.prefect.cloud/account/<id>/workspace/<id>/deployments/deployment/<id>
+// for Prefect Cloud, or http://localhost:4200/deployments/deployment/<id>
+// for a Prefect server.
+func ParseUIURL(rawURL string) (UIObject, error) {
+	var object UIObject
+
+	uiURL, err := url.Parse(rawURL)
+	if err != nil || uiURL.Host == "" {
+		return object, fmt.Errorf("%q is not a valid URL", rawURL)
+	}
+
+	// Split the escaped path, so that names holding a "/" stay in one segment.
+	segments := strings.Split(strings.Trim(uiURL.EscapedPath(), "/"), "/")
+	for i, segment := range segments {
+		if segments[i], err = url.PathUnescape(segment); err != nil {
+			return object, fmt.Errorf("%q is not a valid URL: %w", rawURL, err)
+		}
+	}
+
+	// Links to Prefect Cloud are scoped to an account and a workspace.
+	for i := 0; i+3 < len(segments); i++ {
+		if segments[i] != "account" || segments[i+2] != "workspace" {
+			continue
+		}
+
+		if object.AccountID, err = uuid.Parse(segments[i+1]); err != nil {
+			return object, fmt.Errorf("unable to parse account ID from %q: %w", rawURL, err)
+		}
+
+		if object.WorkspaceID, err = uuid.Parse(segments[i+3]); err != nil {
+			return object, fmt.Errorf("unable to parse workspace ID from %q: %w", rawURL, err)
+		}
+
+		segments = segments[i+4:]
+
+		break
+	}
+
+	for i := range segments {
+		for _, objectPath := range uiObjectPaths {
+			keys, ok := matchUIObjectPath(segments[i:], objectPath.segments)
+			if !ok {
+				continue
+			}
+
+			if objectPath.byID {
+				if _, err := uuid.Parse(keys[0]); err != nil {
+					return object, fmt.Errorf("unable to parse %s ID from %q: %w", objectPath.objectType, rawURL, err)
+				}
+			}
+
+			object.Type = objectPath.objectType
+			object.Keys = keys
+
+			return object, nil
+		}
+	}
+
+	return object, fmt.Errorf("%q is not a link to a %s in the Prefect UI", rawURL, strings.Join(uiObjectTypes(), ", "))
+}
+
+// matchUIObjectPath reports whether segments start with the segments of an
+// object path, returning the segments standing for its keys.
+func matchUIObjectPath(segments, pattern []string) ([]string, bool) {
+	if len(segments) < len(pattern) {
+		return nil, false
+	}
+
+	keys := []string{}
+
+	for i, segment := range pattern {
+		switch {
+		case segment == "*" && segments[i] != "":
+			keys = append(keys, segments[i])
+		case segment != segments[i]:
+			return nil, false
+		}
+	}
+
+	return keys, true
+}
+
+// uiObjectTypes returns the object types that can be parsed from a link.
+func uiObjectTypes() []string {
+	objectTypes := make([]string, 0, len(uiObjectPaths))
+	for _, objectPath := range uiObjectPaths {
+		objectTypes = append(objectTypes, strings.ReplaceAll(objectPath.objectType, "_", " "))
+	}
+
+	return objectTypes
+}
+
+// ImportStateFromUIURL imports the resource into Terraform state from a link
+// to the object in the Prefect UI, setting the keys of the object on the
+// given attributes.
+//
+// The account and workspace IDs of the link, if any, are only set when they
+// differ from the provider's defaults, as the attributes are not computed:
+// configurations relying on the provider's account and workspace would
+// otherwise plan to remove them after the import.
+func ImportStateFromUIURL(ctx context.Context, client api.PrefectClient, req resource.ImportStateRequest, resp *resource.ImportStateResponse, objectType string, attributes ...string) {
+	object, err := ParseUIURL(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", err.Error())
+
+		return
+	}
+
+	if object.Type != objectType || len(object.Keys) != len(attributes) {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected a link to a %s in the Prefect UI, got a link to a %s: %q",
+				strings.ReplaceAll(objectType, "_", " "), strings.ReplaceAll(object.Type, "_", " "), req.ID),
+		)
+
+		return
+	}
+
+	for i, attribute := range attributes {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attribute), object.Keys[i])...)
+	}
+
+	if object.AccountID == uuid.Nil {
+		return
+	}
+
+	if object.AccountID != client.DefaultAccountID() {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("account_id"), object.AccountID.String())...)
+	}
+
+	if object.AccountID != client.DefaultAccountID() || object.WorkspaceID != client.DefaultWorkspaceID() {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace_id"), object.WorkspaceID.String())...)
+	}
+}
@@ -0,0 +1,186 @@
+package helpers_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/prefecthq/terraform-provider-prefect/internal/client"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testAccountID   = "11111111-1111-1111-1111-111111111111"
+	testWorkspaceID = "22222222-2222-2222-2222-222222222222"
+	testObjectID    = "33333333-3333-3333-3333-333333333333"
+)
+
+func TestParseUIURL(t *testing.T) {
+	t.Parallel()
+
+	cloudPrefix := "https://app.prefect.cloud/account/" + testAccountID + "/workspace/" + testWorkspaceID
+
+	tests := []struct {
+		name       string
+		url        string
+		want       helpers.UIObject
+		wantErrMsg string
+	}{
+		{
+			name: "cloud deployment",
+			url:  cloudPrefix + "/deployments/deployment/" + testObjectID,
+			want: helpers.UIObject{
+				AccountID:   uuid.MustParse(testAccountID),
+				WorkspaceID: uuid.MustParse(testWorkspaceID),
+				Type:        helpers.UIObjectDeployment,
+				Keys:        []string{testObjectID},
+			},
+		},
+		{
+			name: "cloud deployment tab with query",
+			url:  cloudPrefix + "/deployments/deployment/" + testObjectID + "/runs?tab=Runs",
+			want: helpers.UIObject{
+				AccountID:   uuid.MustParse(testAccountID),
+				WorkspaceID: uuid.MustParse(testWorkspaceID),
+				Type:        helpers.UIObjectDeployment,
+				Keys:        []string{testObjectID},
+			},
+		},
+		{
+			name: "cloud work queue",
+			url:  cloudPrefix + "/work-pools/work-pool/my-pool/queue/my%2Fqueue",
+			want: helpers.UIObject{
+				AccountID:   uuid.MustParse(testAccountID),
+				WorkspaceID: uuid.MustParse(testWorkspaceID),
+				Type:        helpers.UIObjectWorkQueue,
+				Keys:        []string{"my-pool", "my/queue"},
+			},
+		},
+		{
+			name: "server work pool",
+			url:  "http://localhost:4200/work-pools/work-pool/my%20pool",
+			want: helpers.UIObject{Type: helpers.UIObjectWorkPool, Keys: []string{"my pool"}},
+		},
+		{
+			name: "server block under a path prefix",
+			url:  "https://prefect.example.com/ui/blocks/block/" + testObjectID,
+			want: helpers.UIObject{Type: helpers.UIObjectBlock, Keys: []string{testObjectID}},
+		},
+		{
+			name:       "invalid object ID",
+			url:        cloudPrefix + "/flows/flow/my-flow",
+			wantErrMsg: "unable to parse flow ID",
+		},
+		{
+			name:       "invalid workspace ID",
+			url:        "https://app.prefect.cloud/account/" + testAccountID + "/workspace/my-workspace/flows/flow/" + testObjectID,
+			wantErrMsg: "unable to parse workspace ID",
+		},
+		{
+			name:       "unsupported object",
+			url:        cloudPrefix + "/flow-runs/flow-run/" + testObjectID,
+			wantErrMsg: "is not a link to a work queue, work pool, deployment, flow, block, automation in the Prefect UI",
+		},
+		{
+			name:       "not a URL",
+			url:        "https://",
+			wantErrMsg: "is not a valid URL",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := helpers.ParseUIURL(tt.url)
+			if tt.wantErrMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErrMsg)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestImportStateFromUIURL(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	cloudPrefix := "https://app.prefect.cloud/account/" + testAccountID + "/workspace/" + testWorkspaceID
+	otherID := uuid.MustParse("44444444-4444-4444-4444-444444444444")
+
+	tests := []struct {
+		name               string
+		defaultAccountID   uuid.UUID
+		defaultWorkspaceID uuid.UUID
+		wantAccountID      types.String
+		wantWorkspaceID    types.String
+	}{
+		{
+			name:               "link to the provider's workspace",
+			defaultAccountID:   uuid.MustParse(testAccountID),
+			defaultWorkspaceID: uuid.MustParse(testWorkspaceID),
+			wantAccountID:      types.StringNull(),
+			wantWorkspaceID:    types.StringNull(),
+		},
+		{
+			name:             "link to another workspace of the provider's account",
+			defaultAccountID: uuid.MustParse(testAccountID),
+			wantAccountID:    types.StringNull(),
+			wantWorkspaceID:  types.StringValue(testWorkspaceID),
+		},
+		{
+			name:               "link to another account",
+			defaultAccountID:   otherID,
+			defaultWorkspaceID: uuid.MustParse(testWorkspaceID),
+			wantAccountID:      types.StringValue(testAccountID),
+			wantWorkspaceID:    types.StringValue(testWorkspaceID),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			prefectClient, err := client.New(client.WithDefaults(tt.defaultAccountID, tt.defaultWorkspaceID))
+			require.NoError(t, err)
+
+			req, resp := newImportStateTest(ctx, cloudPrefix+"/work-pools/work-pool/my-pool", nil)
+			helpers.ImportStateFromUIURL(ctx, prefectClient, req, resp, helpers.UIObjectWorkPool, "name")
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+			var name, accountID, workspaceID types.String
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("name"), &name)...)
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("account_id"), &accountID)...)
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("workspace_id"), &workspaceID)...)
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+			assert.Equal(t, types.StringValue("my-pool"), name)
+			assert.Equal(t, tt.wantAccountID, accountID)
+			assert.Equal(t, tt.wantWorkspaceID, workspaceID)
+		})
+	}
+}
+
+func TestImportStateFromUIURLWrongObjectType(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	cloudPrefix := "https://app.prefect.cloud/account/" + testAccountID + "/workspace/" + testWorkspaceID
+
+	prefectClient, err := client.New()
+	require.NoError(t, err)
+
+	req, resp := newImportStateTest(ctx, cloudPrefix+"/deployments/deployment/"+testObjectID, nil)
+	helpers.ImportStateFromUIURL(ctx, prefectClient, req, resp, helpers.UIObjectWorkPool, "name")
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "Expected a link to a work pool in the Prefect UI, got a link to a deployment")
+}
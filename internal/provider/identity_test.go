@@ -0,0 +1,49 @@
+package provider_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	prefectProvider "github.com/prefecthq/terraform-provider-prefect/internal/provider"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestResourceIdentities checks that every resource declares an identity,
+// can be imported from it, and that each identity attribute matches a state
+// attribute, which is what the import helpers rely on.
+func TestResourceIdentities(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	p := prefectProvider.New()
+
+	for _, newResource := range p.Resources(ctx) {
+		r := newResource()
+
+		var metadata resource.MetadataResponse
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "prefect"}, &metadata)
+
+		t.Run(metadata.TypeName, func(t *testing.T) {
+			t.Parallel()
+
+			withIdentity, ok := r.(resource.ResourceWithIdentity)
+			require.True(t, ok, "resource does not declare an identity")
+
+			_, ok = r.(resource.ResourceWithImportState)
+			assert.True(t, ok, "resource cannot be imported")
+
+			var schema resource.SchemaResponse
+			r.Schema(ctx, resource.SchemaRequest{}, &schema)
+
+			var identity resource.IdentitySchemaResponse
+			withIdentity.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identity)
+			require.False(t, identity.Diagnostics.HasError(), identity.Diagnostics)
+
+			for name := range identity.IdentitySchema.Attributes {
+				assert.Contains(t, schema.Schema.Attributes, name, "identity attribute is not a state attribute")
+			}
+		})
+	}
+}
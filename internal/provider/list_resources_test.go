@@ -0,0 +1,116 @@
+package provider_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider"
+)
+
+// TestListResourcesHaveIdentities checks that the resource of every list
+// resource declares an identity, as list results carry the identity that
+// `terraform query` generates import blocks from.
+func TestListResourcesHaveIdentities(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	p := &provider.PrefectProvider{}
+
+	for _, newListResource := range p.ListResources(ctx) {
+		listResource := newListResource()
+
+		var metadata resource.MetadataResponse
+		listResource.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "prefect"}, &metadata)
+
+		t.Run(metadata.TypeName, func(t *testing.T) {
+			t.Parallel()
+
+			_, ok := listResource.(resource.ResourceWithIdentity)
+			assert.True(t, ok, "list resource does not declare an identity")
+		})
+	}
+}
+
+// TestListResourceThroughProviderServer lists work pools through the provider
+// server as served by main.go, rather than the framework server used by the
+// acceptance tests.
+func TestListResourceThroughProviderServer(t *testing.T) {
+	t.Parallel()
+
+	server := newConfiguredProviderServer(t)
+
+	workPools, err := server.client.WorkPools(uuid.Nil, server.workspaceID)
+	require.NoError(t, err)
+
+	for _, name := range []string{"pool-a", "pool-b"} {
+		_, err := workPools.Create(context.Background(), api.WorkPoolCreate{Name: name, Type: "process"})
+		require.NoError(t, err)
+	}
+
+	listServer, ok := server.ProviderServer.(tfprotov6.ListResourceServer)
+	require.True(t, ok)
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	config := dynamicValue(t, server.schemas.ListResourceSchemas["prefect_work_pool"], map[string]string{
+		"workspace_id": server.workspaceID.String(),
+	})
+
+	validateResp, err := listServer.ValidateListResourceConfig(ctx, &tfprotov6.ValidateListResourceConfigRequest{
+		TypeName: "prefect_work_pool",
+		Config:   config,
+	})
+	require.NoError(t, err)
+	requireNoErrorDiagnostics(t, validateResp.Diagnostics)
+
+	stream, err := listServer.ListResource(ctx, &tfprotov6.ListResourceRequest{
+		TypeName: "prefect_work_pool",
+		Config:   config,
+		Limit:    10,
+	})
+	require.NoError(t, err)
+
+	// Nothing is requested from the API until the results are read.
+	assert.Empty(t, requestSummaries(t, &output))
+
+	identitySchemas, err := server.GetResourceIdentitySchemas(ctx, &tfprotov6.GetResourceIdentitySchemasRequest{})
+	require.NoError(t, err)
+
+	identityType := identitySchemas.IdentitySchemas["prefect_work_pool"].ValueType()
+
+	var names []string
+
+	for result := range stream.Results {
+		requireNoErrorDiagnostics(t, result.Diagnostics)
+		require.NotNil(t, result.Identity)
+
+		identity, err := result.Identity.IdentityData.Unmarshal(identityType)
+		require.NoError(t, err)
+
+		var attributes map[string]tftypes.Value
+		require.NoError(t, identity.As(&attributes))
+
+		var name string
+		require.NoError(t, attributes["name"].As(&name))
+		assert.Equal(t, result.DisplayName, name)
+
+		names = append(names, name)
+	}
+
+	assert.ElementsMatch(t, []string{"pool-a", "pool-b"}, names)
+
+	summaries := requestSummaries(t, &output)
+	require.Len(t, summaries, 1)
+	assert.Greater(t, summaries[0]["api_requests"], float64(0))
+}
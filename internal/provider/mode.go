@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/client"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+// deploymentConfig holds the resolved deployment mode and additional
+// Prefect Cloud hostnames for the API client.
+type deploymentConfig struct {
+	mode       client.Mode
+	cloudHosts []string
+}
+
+// isCloud reports whether the endpoint addresses Prefect Cloud in this mode.
+func (m deploymentConfig) isCloud(endpoint string) bool {
+	switch m.mode {
+	case client.ModeCloud:
+		return true
+	case client.ModeServer:
+		return false
+	default:
+		return helpers.IsCloudEndpoint(endpoint, m.cloudHosts...)
+	}
+}
+
+// resolveDeploymentConfig resolves the deployment mode and additional Prefect Cloud
+// hostnames from the provider configuration, falling back to environment
+// variables. The mode defaults to auto.
+func resolveDeploymentConfig(ctx context.Context, config *PrefectProviderModel) (deploymentConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	resolved := deploymentConfig{mode: client.ModeAuto}
+
+	if !config.Mode.IsNull() {
+		resolved.mode = client.Mode(config.Mode.ValueString())
+	} else if value, ok := os.LookupEnv(envMode); ok && value != "" {
+		resolved.mode = client.Mode(value)
+	}
+
+	if !config.CloudHosts.IsNull() {
+		diags.Append(config.CloudHosts.ElementsAs(ctx, &resolved.cloudHosts, false)...)
+	} else if value, ok := os.LookupEnv(envCloudHosts); ok {
+		for _, host := range strings.Split(value, ",") {
+			if host = strings.TrimSpace(host); host != "" {
+				resolved.cloudHosts = append(resolved.cloudHosts, host)
+			}
+		}
+	}
+
+	if diags.HasError() {
+		return resolved, diags
+	}
+
+	// Apply the options to a throwaway client, so that invalid values from
+	// environment variables are reported as configuration errors.
+	if _, err := client.New(client.WithMode(resolved.mode), client.WithCloudHosts(resolved.cloudHosts)); err != nil {
+		diags.AddAttributeError(
+			path.Root("mode"),
+			"Invalid deployment mode configuration",
+			fmt.Sprintf("The provider's mode or cloud_hosts settings are not valid: %s", err),
+		)
+	}
+
+	return resolved, diags
+}
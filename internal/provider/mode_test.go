@@ -0,0 +1,90 @@
+package provider_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+//nolint:paralleltest // uses t.Setenv
+func TestConfigureMode(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(server.Close)
+
+	tests := []struct {
+		name       string
+		attributes map[string]string
+		env        map[string]string
+		wantError  string
+	}{
+		{
+			name:       "unknown host in auto mode",
+			attributes: map[string]string{},
+		},
+		{
+			name:       "custom Cloud host requires an API key",
+			attributes: map[string]string{},
+			env:        map[string]string{"PREFECT_CLIENT_CLOUD_HOSTS": "prefect.example.com, 127.0.0.1"},
+			wantError:  "Missing Prefect API Key",
+		},
+		{
+			name:       "cloud mode requires an API key",
+			attributes: map[string]string{"mode": "cloud"},
+			wantError:  "Missing Prefect API Key",
+		},
+		{
+			name:       "cloud mode with an API key",
+			attributes: map[string]string{"mode": "cloud", "api_key": "pnu_attribute"},
+		},
+		{
+			name:       "server mode overrides a custom Cloud host",
+			attributes: map[string]string{"mode": "server"},
+			env:        map[string]string{"PREFECT_CLIENT_CLOUD_HOSTS": "127.0.0.1"},
+		},
+		{
+			name:       "mode from environment variable",
+			attributes: map[string]string{},
+			env:        map[string]string{"PREFECT_CLIENT_MODE": "cloud"},
+			wantError:  "Missing Prefect API Key",
+		},
+		{
+			name:       "invalid mode in environment variable",
+			attributes: map[string]string{},
+			env:        map[string]string{"PREFECT_CLIENT_MODE": "hybrid"},
+			wantError:  "Invalid deployment mode configuration",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, key := range []string{
+				"PREFECT_API_URL", "PREFECT_API_KEY", "PREFECT_CLOUD_ACCOUNT_ID", "PREFECT_PROFILE",
+				"PREFECT_CLIENT_MODE", "PREFECT_CLIENT_CLOUD_HOSTS",
+			} {
+				unsetenv(t, key)
+			}
+
+			for key, value := range tt.env {
+				t.Setenv(key, value)
+			}
+
+			tt.attributes["endpoint"] = server.URL + "/api"
+
+			resp := configureProvider(t, tt.attributes)
+			if tt.wantError == "" {
+				require.False(t, resp.Diagnostics.HasError(), "unexpected errors: %v", resp.Diagnostics.Errors())
+
+				return
+			}
+
+			require.True(t, resp.Diagnostics.HasError())
+			assert.Equal(t, tt.wantError, resp.Diagnostics.Errors()[0].Summary())
+		})
+	}
+}
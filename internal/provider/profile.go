@@ -0,0 +1,185 @@
+package provider
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	defaultHomeDir      = ".prefect"
+	defaultProfilesFile = "profiles.toml"
+)
+
+// Profile is a named set of settings from a Prefect CLI profiles file,
+// such as PREFECT_API_URL and PREFECT_API_KEY.
+type Profile struct {
+	Name     string
+	Path     string
+	Settings map[string]string
+}
+
+// profilesFile maps the structure of a Prefect CLI profiles file.
+type profilesFile struct {
+	Profiles map[string]map[string]any `toml:"profiles"`
+}
+
+// ProfilesPath returns the path of the Prefect CLI profiles file, following
+// the same rules as the Prefect CLI: PREFECT_PROFILES_PATH if set, otherwise
+// profiles.toml in PREFECT_HOME, which defaults to ~/.prefect.
+func ProfilesPath() (string, error) {
+	if profilesPath, ok := os.LookupEnv(envProfilesPath); ok && profilesPath != "" {
+		return expandHome(profilesPath)
+	}
+
+	if home, ok := os.LookupEnv(envHome); ok && home != "" {
+		home, err := expandHome(home)
+		if err != nil {
+			return "", err
+		}
+
+		return filepath.Join(home, defaultProfilesFile), nil
+	}
+
+	userHome, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("unable to determine the home directory: %w", err)
+	}
+
+	return filepath.Join(userHome, defaultHomeDir, defaultProfilesFile), nil
+}
+
+// LoadProfile loads the named profile from the Prefect CLI profiles file.
+func LoadProfile(name string) (*Profile, error) {
+	profilesPath, err := ProfilesPath()
+	if err != nil {
+		return nil, err
+	}
+
+	return LoadProfileFromFile(profilesPath, name)
+}
+
+// LoadProfileFromFile loads the named profile from a Prefect CLI profiles file.
+func LoadProfileFromFile(profilesPath, name string) (*Profile, error) {
+	var file profilesFile
+	if _, err := toml.DecodeFile(profilesPath, &file); err != nil {
+		return nil, fmt.Errorf("unable to read Prefect profiles from %s: %w", profilesPath, err)
+	}
+
+	settings, ok := file.Profiles[name]
+	if !ok {
+		available := make([]string, 0, len(file.Profiles))
+		for profileName := range file.Profiles {
+			available = append(available, profileName)
+		}
+
+		slices.Sort(available)
+
+		return nil, fmt.Errorf("profile %q not found in %s, available profiles: %s", name, profilesPath, strings.Join(available, ", "))
+	}
+
+	profile := &Profile{
+		Name:     name,
+		Path:     profilesPath,
+		Settings: make(map[string]string, len(settings)),
+	}
+
+	// Settings are usually strings, but booleans and numbers are also valid TOML.
+	for key, value := range settings {
+		profile.Settings[key] = fmt.Sprint(value)
+	}
+
+	return profile, nil
+}
+
+// expandHome expands a leading ~ in a path to the user's home directory,
+// as the Prefect CLI does for PREFECT_HOME and PREFECT_PROFILES_PATH.
+func expandHome(path string) (string, error) {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path, nil
+	}
+
+	userHome, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("unable to determine the home directory: %w", err)
+	}
+
+	return filepath.Join(userHome, strings.TrimPrefix(path, "~")), nil
+}
+
+// settingResolver resolves provider settings from their attribute, then
+// environment variables, then the selected Prefect CLI profile, and records
+// which source each setting was read from.
+type settingResolver struct {
+	profile *Profile
+
+	// sources maps each resolved setting to a description of its source.
+	sources map[string]string
+
+	// overridden lists profile settings that were ignored because a
+	// higher-precedence source also set them.
+	overridden []string
+}
+
+func newSettingResolver(profile *Profile) *settingResolver {
+	return &settingResolver{
+		profile: profile,
+		sources: map[string]string{},
+	}
+}
+
+// resolve returns the value of a setting, or an empty string if it is not set.
+// Environment variables are checked in order, and profileKey names the
+// setting in the profile.
+func (r *settingResolver) resolve(attribute types.String, attributeName, profileKey string, envVars ...string) string {
+	var value, source string
+
+	found := false
+
+	if !attribute.IsNull() {
+		value, source, found = attribute.ValueString(), fmt.Sprintf("the %s attribute", attributeName), true
+	}
+
+	for _, envVar := range envVars {
+		if found {
+			break
+		}
+
+		if envValue, ok := os.LookupEnv(envVar); ok {
+			value, source, found = envValue, fmt.Sprintf("the %s environment variable", envVar), true
+		}
+	}
+
+	if r.profile != nil {
+		if profileValue, ok := r.profile.Settings[profileKey]; ok {
+			switch {
+			case !found:
+				value, source, found = profileValue, fmt.Sprintf("%s in the %q profile", profileKey, r.profile.Name), true
+			case profileValue != value:
+				r.overridden = append(r.overridden, fmt.Sprintf("%s: %s in the profile was overridden by %s", attributeName, profileKey, source))
+			}
+		}
+	}
+
+	if found {
+		r.sources[attributeName] = source
+	}
+
+	return value
+}
+
+// sourceList returns the source of each resolved setting, sorted by setting.
+func (r *settingResolver) sourceList() []string {
+	list := make([]string, 0, len(r.sources))
+	for _, setting := range slices.Sorted(maps.Keys(r.sources)) {
+		list = append(list, fmt.Sprintf("%s: %s", setting, r.sources[setting]))
+	}
+
+	return list
+}
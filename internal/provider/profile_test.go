@@ -0,0 +1,330 @@
+package provider_test
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwprovider "github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/client"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testProfiles = `
+active = "dev"
+
+[profiles.dev]
+PREFECT_API_URL = "%s"
+PREFECT_API_KEY = "pnu_profile"
+PREFECT_DEBUG_MODE = true
+
+[profiles.selfhosted]
+PREFECT_API_URL = "%s"
+PREFECT_API_AUTH_STRING = "admin:profile"
+`
+
+// writeProfiles writes a profiles file with both profiles pointing at the
+// endpoint, and returns its path.
+func writeProfiles(t *testing.T, endpoint string) string {
+	t.Helper()
+
+	profilesPath := filepath.Join(t.TempDir(), "profiles.toml")
+
+	content := []byte(strings.ReplaceAll(testProfiles, "%s", endpoint))
+	require.NoError(t, os.WriteFile(profilesPath, content, 0o600))
+
+	return profilesPath
+}
+
+func TestLoadProfileFromFile(t *testing.T) {
+	t.Parallel()
+
+	profilesPath := writeProfiles(t, "https://prefect.internal/api")
+
+	tests := []struct {
+		name         string
+		profile      string
+		wantSettings map[string]string
+		wantErr      string
+	}{
+		{
+			name:    "cloud profile",
+			profile: "dev",
+			wantSettings: map[string]string{
+				"PREFECT_API_URL":    "https://prefect.internal/api",
+				"PREFECT_API_KEY":    "pnu_profile",
+				"PREFECT_DEBUG_MODE": "true",
+			},
+		},
+		{
+			name:    "self-hosted profile",
+			profile: "selfhosted",
+			wantSettings: map[string]string{
+				"PREFECT_API_URL":         "https://prefect.internal/api",
+				"PREFECT_API_AUTH_STRING": "admin:profile",
+			},
+		},
+		{
+			name:    "missing profile",
+			profile: "prod",
+			wantErr: `profile "prod" not found in ` + profilesPath + `, available profiles: dev, selfhosted`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			profile, err := provider.LoadProfileFromFile(profilesPath, tt.profile)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.profile, profile.Name)
+			assert.Equal(t, profilesPath, profile.Path)
+			assert.Equal(t, tt.wantSettings, profile.Settings)
+		})
+	}
+}
+
+func TestLoadProfileFromInvalidFile(t *testing.T) {
+	t.Parallel()
+
+	profilesPath := filepath.Join(t.TempDir(), "profiles.toml")
+	require.NoError(t, os.WriteFile(profilesPath, []byte("[profiles.dev"), 0o600))
+
+	_, err := provider.LoadProfileFromFile(profilesPath, "dev")
+	require.Error(t, err)
+
+	_, err = provider.LoadProfileFromFile(filepath.Join(t.TempDir(), "missing.toml"), "dev")
+	require.Error(t, err)
+}
+
+// unsetenv unsets an environment variable for the duration of the test.
+func unsetenv(t *testing.T, key string) {
+	t.Helper()
+
+	t.Setenv(key, "")
+	require.NoError(t, os.Unsetenv(key))
+}
+
+//nolint:paralleltest // uses t.Setenv
+func TestProfilesPath(t *testing.T) {
+	home, err := os.UserHomeDir()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		profilesPath string
+		prefectHome  string
+		want         string
+	}{
+		{
+			name: "default",
+			want: filepath.Join(home, ".prefect", "profiles.toml"),
+		},
+		{
+			name:        "PREFECT_HOME",
+			prefectHome: "/opt/prefect",
+			want:        "/opt/prefect/profiles.toml",
+		},
+		{
+			name:        "PREFECT_HOME with tilde",
+			prefectHome: "~/prefect",
+			want:        filepath.Join(home, "prefect", "profiles.toml"),
+		},
+		{
+			name:         "PREFECT_PROFILES_PATH takes priority",
+			profilesPath: "/etc/prefect/profiles.toml",
+			prefectHome:  "/opt/prefect",
+			want:         "/etc/prefect/profiles.toml",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			unsetenv(t, "PREFECT_PROFILES_PATH")
+			unsetenv(t, "PREFECT_HOME")
+
+			if tt.profilesPath != "" {
+				t.Setenv("PREFECT_PROFILES_PATH", tt.profilesPath)
+			}
+
+			if tt.prefectHome != "" {
+				t.Setenv("PREFECT_HOME", tt.prefectHome)
+			}
+
+			got, err := provider.ProfilesPath()
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// configureProvider runs the provider's Configure method with the given
+// attribute values, leaving all other attributes null.
+func configureProvider(t *testing.T, attributes map[string]string) *fwprovider.ConfigureResponse {
+	t.Helper()
+
+	ctx := context.Background()
+	prefectProvider := provider.New()
+
+	schemaResp := &fwprovider.SchemaResponse{}
+	prefectProvider.Schema(ctx, fwprovider.SchemaRequest{}, schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	objectType, ok := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	require.True(t, ok)
+
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+
+	for name, value := range attributes {
+		values[name] = tftypes.NewValue(tftypes.String, value)
+	}
+
+	resp := &fwprovider.ConfigureResponse{}
+	prefectProvider.Configure(ctx, fwprovider.ConfigureRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(objectType, values),
+		},
+	}, resp)
+
+	return resp
+}
+
+//nolint:paralleltest // uses t.Setenv
+func TestConfigureWithProfile(t *testing.T) {
+	var authorization atomic.Value
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization.Store(r.Header.Get("Authorization"))
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(server.Close)
+
+	profilesPath := writeProfiles(t, server.URL+"/api")
+	basic := func(key string) string {
+		return "Basic " + base64.StdEncoding.EncodeToString([]byte(key))
+	}
+
+	tests := []struct {
+		name              string
+		attributes        map[string]string
+		env               map[string]string
+		wantAuthorization string
+		wantWarning       string
+		wantErr           bool
+	}{
+		{
+			name:              "profile attribute",
+			attributes:        map[string]string{"profile": "dev"},
+			wantAuthorization: "Bearer pnu_profile",
+			wantWarning:       `api_key: PREFECT_API_KEY in the "dev" profile`,
+		},
+		{
+			name:              "PREFECT_PROFILE environment variable",
+			env:               map[string]string{"PREFECT_PROFILE": "dev"},
+			wantAuthorization: "Bearer pnu_profile",
+			wantWarning:       `endpoint: PREFECT_API_URL in the "dev" profile`,
+		},
+		{
+			name:              "environment variable overrides profile",
+			attributes:        map[string]string{"profile": "dev"},
+			env:               map[string]string{"PREFECT_API_KEY": "pnu_env"},
+			wantAuthorization: "Bearer pnu_env",
+			wantWarning:       "api_key: PREFECT_API_KEY in the profile was overridden by the PREFECT_API_KEY environment variable",
+		},
+		{
+			name:              "attribute overrides environment variable and profile",
+			attributes:        map[string]string{"profile": "dev", "api_key": "pnu_attribute"},
+			env:               map[string]string{"PREFECT_API_KEY": "pnu_env"},
+			wantAuthorization: "Bearer pnu_attribute",
+			wantWarning:       "api_key: PREFECT_API_KEY in the profile was overridden by the api_key attribute",
+		},
+		{
+			name:              "auth string from profile",
+			attributes:        map[string]string{"profile": "selfhosted"},
+			wantAuthorization: basic("admin:profile"),
+			wantWarning:       `basic_auth_key: PREFECT_API_AUTH_STRING in the "selfhosted" profile`,
+		},
+		{
+			name:              "PREFECT_API_AUTH_STRING environment variable",
+			env:               map[string]string{"PREFECT_API_AUTH_STRING": "admin:env"},
+			attributes:        map[string]string{"endpoint": server.URL + "/api"},
+			wantAuthorization: basic("admin:env"),
+		},
+		{
+			name:       "missing profile",
+			attributes: map[string]string{"profile": "prod"},
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, key := range []string{
+				"PREFECT_API_URL", "PREFECT_API_KEY", "PREFECT_BASIC_AUTH_KEY", "PREFECT_API_AUTH_STRING",
+				"PREFECT_PROFILE", "PREFECT_CLOUD_ACCOUNT_ID", "PREFECT_HOME",
+			} {
+				unsetenv(t, key)
+			}
+
+			t.Setenv("PREFECT_PROFILES_PATH", profilesPath)
+
+			for key, value := range tt.env {
+				t.Setenv(key, value)
+			}
+
+			resp := configureProvider(t, tt.attributes)
+			if tt.wantErr {
+				require.True(t, resp.Diagnostics.HasError())
+
+				return
+			}
+
+			require.False(t, resp.Diagnostics.HasError(), "unexpected errors: %v", resp.Diagnostics.Errors())
+
+			warnings := resp.Diagnostics.Warnings()
+			if tt.wantWarning == "" {
+				assert.Empty(t, warnings)
+			} else {
+				require.Len(t, warnings, 1)
+				assert.Equal(t, diag.SeverityWarning, warnings[0].Severity())
+				assert.Contains(t, warnings[0].Detail(), tt.wantWarning)
+				assert.NotContains(t, warnings[0].Detail(), "pnu_", "setting values must not be shown")
+			}
+
+			prefectClient, ok := resp.ResourceData.(*client.Client)
+			require.True(t, ok)
+
+			workPools, err := prefectClient.WorkPools(uuid.Nil, uuid.Nil)
+			require.NoError(t, err)
+
+			_, err = workPools.Get(context.Background(), "pool")
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantAuthorization, authorization.Load())
+		})
+	}
+}
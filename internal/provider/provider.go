@@ -5,32 +5,83 @@ import (
 	"fmt"
 	"net/url"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/prefecthq/terraform-provider-prefect/internal/client"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/actions"
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/datasources"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/ephemeralresources"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/functions"
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/resources"
 )
 
-var _ = provider.Provider(&PrefectProvider{})
+var (
+	_ = provider.Provider(&PrefectProvider{})
+	_ = provider.ProviderWithEphemeralResources(&PrefectProvider{})
+	_ = provider.ProviderWithFunctions(&PrefectProvider{})
+	_ = provider.ProviderWithListResources(&PrefectProvider{})
+	_ = provider.ProviderWithActions(&PrefectProvider{})
+)
 
 const (
 	envAccountID    = "PREFECT_CLOUD_ACCOUNT_ID"
 	envAPIURL       = "PREFECT_API_URL"
 	envAPIKey       = "PREFECT_API_KEY" //nolint:gosec // this is just the environment variable key, not a credential
 	envBasicAuthKey = "PREFECT_BASIC_AUTH_KEY"
+	envAuthString   = "PREFECT_API_AUTH_STRING"
+	envProfile      = "PREFECT_PROFILE"
+	envHome         = "PREFECT_HOME"
+	envProfilesPath = "PREFECT_PROFILES_PATH"
+	envMode         = "PREFECT_CLIENT_MODE"
+	envCloudHosts   = "PREFECT_CLIENT_CLOUD_HOSTS"
+	envMaxRetries   = "PREFECT_CLIENT_MAX_RETRIES"
+	envRetryWaitMin = "PREFECT_CLIENT_RETRY_WAIT_MIN"
+	envRetryWaitMax = "PREFECT_CLIENT_RETRY_WAIT_MAX"
+	envRetryTimeout = "PREFECT_CLIENT_RETRY_TIMEOUT"
+
+	envRateLimit             = "PREFECT_CLIENT_RATE_LIMIT"
+	envRateLimitBurst        = "PREFECT_CLIENT_RATE_LIMIT_BURST"
+	envMaxConcurrentRequests = "PREFECT_CLIENT_MAX_CONCURRENT_REQUESTS"
+
+	envHTTPTracing = "PREFECT_CLIENT_HTTP_TRACING"
+	envReadCache   = "PREFECT_CLIENT_READ_CACHE"
+
+	envCACertFile         = "PREFECT_API_SSL_CERT_FILE"
+	envClientCertFile     = "PREFECT_CLIENT_CERT_FILE"
+	envClientKeyFile      = "PREFECT_CLIENT_KEY_FILE"
+	envInsecureSkipVerify = "PREFECT_API_TLS_INSECURE_SKIP_VERIFY"
+	envRequestTimeout     = "PREFECT_CLIENT_REQUEST_TIMEOUT"
+	envExtraHeaders       = "PREFECT_CLIENT_CUSTOM_HEADERS"
 
 	defaultAPIURL = "https://api.prefect.cloud"
+
+	// minServerVersion is the oldest self-hosted server version the provider supports.
+	minServerVersion = "3.0.0"
+
+	defaultMaxRetries   = 4
+	defaultRetryWaitMin = 1 * time.Second
+	defaultRetryWaitMax = 30 * time.Second
 )
 
 // New returns a new Prefect Provider instance.
@@ -50,6 +101,15 @@ func (p *PrefectProvider) Schema(_ context.Context, _ provider.SchemaRequest, re
 	resp.Schema = schema.Schema{
 		Description: "Use the [Prefect](https://prefect.io) provider to configure your Prefect infrastructure.",
 		Attributes: map[string]schema.Attribute{
+			"profile": schema.StringAttribute{
+				Description: "Name of a Prefect CLI profile to read the `PREFECT_API_URL`, `PREFECT_API_KEY` and `PREFECT_API_AUTH_STRING` settings from." +
+					" Profiles are read from `profiles.toml` in the `PREFECT_HOME` directory, which defaults to `~/.prefect`, or from the file set in `PREFECT_PROFILES_PATH`." +
+					" Provider attributes and environment variables take precedence over profile settings." +
+					" When a profile is used, a warning lists the source each setting was read from, and any profile settings that were overridden." +
+					" The sources are also logged when `TF_LOG` or `TF_LOG_PROVIDER` is set to `INFO`." +
+					" Can also be set via the `PREFECT_PROFILE` environment variable.",
+				Optional: true,
+			},
 			"endpoint": schema.StringAttribute{
 				Description: "The Prefect API URL. Can also be set via the `PREFECT_API_URL` environment variable." +
 					" Defaults to `https://api.prefect.cloud` if not configured." +
@@ -66,7 +126,7 @@ func (p *PrefectProvider) Schema(_ context.Context, _ provider.SchemaRequest, re
 				Sensitive:   true,
 			},
 			"basic_auth_key": schema.StringAttribute{
-				Description: "Prefect basic auth key. Can also be set via the `PREFECT_BASIC_AUTH_KEY` environment variable.",
+				Description: "Prefect basic auth key. Can also be set via the `PREFECT_BASIC_AUTH_KEY` or `PREFECT_API_AUTH_STRING` environment variables.",
 				Optional:    true,
 				Sensitive:   true,
 			},
@@ -80,6 +140,153 @@ func (p *PrefectProvider) Schema(_ context.Context, _ provider.SchemaRequest, re
 				Description: "Default Prefect Cloud Workspace ID.",
 				Optional:    true,
 			},
+			"mode": schema.StringAttribute{
+				Description: "Whether the `endpoint` is Prefect Cloud (`cloud`) or a self-hosted Prefect server (`server`)." +
+					" With `auto`, Prefect Cloud is detected from the endpoint host, which must be a Prefect Cloud API host or listed in `cloud_hosts`." +
+					" In `cloud` mode, an `api_key` is required and requests are scoped to an account and workspace." +
+					" In `server` mode, requests are never scoped to an account or workspace." +
+					" Can also be set via the `PREFECT_CLIENT_MODE` environment variable. Defaults to `auto`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(client.ModeAuto), string(client.ModeCloud), string(client.ModeServer)),
+				},
+			},
+			"cloud_hosts": schema.ListAttribute{
+				Description: "Additional hostnames that serve Prefect Cloud, such as private-link hostnames, detected as Prefect Cloud in `auto` mode." +
+					" Hostnames must match the `endpoint` host exactly, without a scheme or port." +
+					" Can also be set via the `PREFECT_CLIENT_CLOUD_HOSTS` environment variable, as a comma-separated list.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"max_retries": schema.Int64Attribute{
+				Description: "Maximum number of times a failed API request is retried." +
+					" Requests are retried on connection errors, 429 responses and 5xx responses." +
+					" Can also be set via the `PREFECT_CLIENT_MAX_RETRIES` environment variable. Defaults to `4`.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_wait_min": schema.StringAttribute{
+				Description: "Minimum time to wait between retries, as a Go duration string such as `500ms` or `1s`." +
+					" Can also be set via the `PREFECT_CLIENT_RETRY_WAIT_MIN` environment variable. Defaults to `1s`.",
+				Optional: true,
+			},
+			"retry_wait_max": schema.StringAttribute{
+				Description: "Maximum time to wait between retries, as a Go duration string such as `30s`." +
+					" A `Retry-After` header on a 429 or 503 response takes priority over this value." +
+					" Can also be set via the `PREFECT_CLIENT_RETRY_WAIT_MAX` environment variable. Defaults to `30s`.",
+				Optional: true,
+			},
+			"retry_timeout": schema.StringAttribute{
+				Description: "Total time allowed for a single API request, including all of its retries, as a Go duration string such as `5m`." +
+					" Can also be set via the `PREFECT_CLIENT_RETRY_TIMEOUT` environment variable. Defaults to no timeout.",
+				Optional: true,
+			},
+			"rate_limit": schema.Float64Attribute{
+				Description: "Maximum sustained number of API requests per second, shared by all resources and data sources." +
+					" Retries count towards this limit. Useful to stay under Prefect Cloud rate limits when applying with high `-parallelism`." +
+					" Can also be set via the `PREFECT_CLIENT_RATE_LIMIT` environment variable. Defaults to `0`, which means no limit.",
+				Optional: true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"rate_limit_burst": schema.Int64Attribute{
+				Description: "Number of API requests that may be sent in a burst above `rate_limit`." +
+					" Can also be set via the `PREFECT_CLIENT_RATE_LIMIT_BURST` environment variable. Defaults to `rate_limit` rounded up.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Description: "Maximum number of API requests in flight at the same time, shared by all resources and data sources." +
+					" Can also be set via the `PREFECT_CLIENT_MAX_CONCURRENT_REQUESTS` environment variable. Defaults to `0`, which means no limit.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"http_tracing": schema.BoolAttribute{
+				Description: "Log every API request and response at TRACE level, including the method, URL, timing, retry attempt, headers and bodies." +
+					" Block document data, variable values, API keys and `Authorization` headers are redacted." +
+					" Logs are only shown when `TF_LOG` or `TF_LOG_PROVIDER` is set to `TRACE`." +
+					" Can also be set via the `PREFECT_CLIENT_HTTP_TRACING` environment variable. Defaults to `false`.",
+				Optional: true,
+			},
+			"read_cache": schema.BoolAttribute{
+				Description: "Cache API reads for the duration of a plan or apply, to reduce the number of requests when refreshing many resources." +
+					" Concurrent reads of deployments and deployment schedules are fetched with a single request, and work pools, block types, block schemas and worker metadata are only fetched once." +
+					" Writes made by the provider drop the cached deployments and work pools, but changes made outside of Terraform during a plan or apply may not be seen." +
+					" Can also be set via the `PREFECT_CLIENT_READ_CACHE` environment variable. Defaults to `false`.",
+				Optional: true,
+			},
+			"adopt_existing": schema.BoolAttribute{
+				Description: "Default for the `adopt_existing` attribute of the `prefect_work_pool`, `prefect_variable`, `prefect_block` and `prefect_global_concurrency_limit` resources." +
+					" When `true`, creating one of these resources adopts an existing object with the same name, updating it to the configured values, instead of failing." +
+					" Defaults to `false`.",
+				Optional: true,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Description: "Path to a PEM-encoded CA bundle to trust in addition to the system certificates, for servers using a private CA." +
+					" Can also be set via the `PREFECT_API_SSL_CERT_FILE` environment variable. Conflicts with `ca_cert_pem`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("ca_cert_pem")),
+				},
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Description: "PEM-encoded CA bundle to trust in addition to the system certificates, for servers using a private CA. Conflicts with `ca_cert_file`.",
+				Optional:    true,
+			},
+			"client_cert_file": schema.StringAttribute{
+				Description: "Path to a PEM-encoded client certificate, for servers that require mutual TLS. Requires a client key." +
+					" Can also be set via the `PREFECT_CLIENT_CERT_FILE` environment variable. Conflicts with `client_cert_pem`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("client_cert_pem")),
+				},
+			},
+			"client_cert_pem": schema.StringAttribute{
+				Description: "PEM-encoded client certificate, for servers that require mutual TLS. Requires a client key. Conflicts with `client_cert_file`.",
+				Optional:    true,
+			},
+			"client_key_file": schema.StringAttribute{
+				Description: "Path to the PEM-encoded private key for the client certificate." +
+					" Can also be set via the `PREFECT_CLIENT_KEY_FILE` environment variable. Conflicts with `client_key_pem`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("client_key_pem")),
+				},
+			},
+			"client_key_pem": schema.StringAttribute{
+				Description: "PEM-encoded private key for the client certificate. Conflicts with `client_key_file`.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Description: "Skip verification of the server's TLS certificate. Only use this for development." +
+					" Can also be set via the `PREFECT_API_TLS_INSECURE_SKIP_VERIFY` environment variable. Defaults to `false`.",
+				Optional: true,
+			},
+			"proxy_url": schema.StringAttribute{
+				Description: "URL of an HTTP(S) or SOCKS5 proxy to send API requests through, such as `http://proxy.internal:3128`." +
+					" Defaults to the proxy configured in the `HTTPS_PROXY`, `HTTP_PROXY` and `NO_PROXY` environment variables.",
+				Optional: true,
+			},
+			"request_timeout": schema.StringAttribute{
+				Description: "Time allowed for a single attempt of an API request, as a Go duration string such as `30s`. Attempts that time out are retried." +
+					" Can also be set via the `PREFECT_CLIENT_REQUEST_TIMEOUT` environment variable. Defaults to no timeout.",
+				Optional: true,
+			},
+			"extra_headers": schema.MapAttribute{
+				Description: "Static headers to send with every API request, such as a routing header required by a gateway in front of a self-hosted server." +
+					" These cannot override the `Authorization`, `Content-Type` and `Accept` headers set by the provider, and their values are redacted from HTTP traces." +
+					" Can also be set via the `PREFECT_CLIENT_CUSTOM_HEADERS` environment variable, as a JSON object.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -96,14 +303,33 @@ func (p *PrefectProvider) Configure(ctx context.Context, req provider.ConfigureR
 		return
 	}
 
-	// Extract endpoint from configuration or environment variable.
-	var endpoint string
-	if !config.Endpoint.IsNull() {
-		endpoint = config.Endpoint.ValueString()
-	} else if apiURLEnvVar, ok := os.LookupEnv(envAPIURL); ok {
-		endpoint = apiURLEnvVar
+	// Load the Prefect CLI profile selected in configuration or environment variable, if any.
+	var profile *Profile
+	profileName := config.Profile.ValueString()
+	if config.Profile.IsNull() {
+		profileName = os.Getenv(envProfile)
 	}
 
+	if profileName != "" {
+		var err error
+		profile, err = LoadProfile(profileName)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("profile"),
+				"Unable to load Prefect profile",
+				fmt.Sprintf("Could not load the Prefect profile %q: %s", profileName, err),
+			)
+
+			return
+		}
+	}
+
+	// Settings are read from configuration, then environment variables,
+	// then the Prefect profile.
+	settings := newSettingResolver(profile)
+
+	// Extract endpoint from configuration, environment variable or profile.
+	endpoint := settings.resolve(config.Endpoint, "endpoint", envAPIURL, envAPIURL)
 	if endpoint == "" {
 		endpoint = defaultAPIURL
 	}
@@ -117,22 +343,35 @@ func (p *PrefectProvider) Configure(ctx context.Context, req provider.ConfigureR
 		)
 	}
 
-	// Extract the API Key from configuration or environment variable.
-	var apiKey string
-	if !config.APIKey.IsNull() {
-		apiKey = config.APIKey.ValueString()
-	} else if apiKeyEnvVar, ok := os.LookupEnv(envAPIKey); ok {
-		apiKey = apiKeyEnvVar
+	// Extract the API Key from configuration, environment variable or profile.
+	apiKey := settings.resolve(config.APIKey, "api_key", envAPIKey, envAPIKey)
+
+	// Extract the basic auth key from configuration, environment variables or profile.
+	// The Prefect CLI reads the basic auth key from PREFECT_API_AUTH_STRING.
+	basicAuthKey := settings.resolve(config.BasicAuthKey, "basic_auth_key", envAuthString, envBasicAuthKey, envAuthString)
+
+	// When a profile is used, settings may come from several places, so
+	// show which source won for each of them, without their values.
+	if profile != nil {
+		detail := fmt.Sprintf("The provider settings were read from the following sources, using the %q profile from %s:\n\n- %s",
+			profile.Name, profile.Path, strings.Join(settings.sourceList(), "\n- "))
+
+		if len(settings.overridden) > 0 {
+			detail += fmt.Sprintf("\n\nSome settings in the profile were overridden by provider attributes or environment variables,"+
+				" which take precedence over the profile:\n\n- %s", strings.Join(settings.overridden, "\n- "))
+		}
+
+		resp.Diagnostics.AddWarning("Prefect provider settings read from a profile", detail)
 	}
 
-	// Extract with basic auth key from configuration or environment variable.
-	var basicAuthKey string
-	if !config.BasicAuthKey.IsNull() {
-		basicAuthKey = config.BasicAuthKey.ValueString()
-	} else if basicAuthKeyEnvVar, ok := os.LookupEnv(envBasicAuthKey); ok {
-		basicAuthKey = basicAuthKeyEnvVar
+	// Log where each setting came from before anything can fail, so that
+	// authentication errors can be traced back to an unexpected source.
+	for setting, source := range settings.sources {
+		ctx = tflog.SetField(ctx, "prefect_"+setting+"_source", source)
 	}
 
+	tflog.Info(ctx, "Resolved Prefect provider settings")
+
 	// Extract the Account ID from configuration, the PREFECT_CLOUD_ACCOUNT_ID
 	// environment variable, or the PREFECT_API_URL environment variable.
 	var accountID uuid.UUID
@@ -149,8 +388,8 @@ func (p *PrefectProvider) Configure(ctx context.Context, req provider.ConfigureR
 
 			return
 		}
-	} else if URLContainsIDs(endpoint) {
-		aID, err := GetAccountIDFromPath(endpointURL.Path)
+	} else if helpers.URLContainsIDs(endpoint) {
+		aID, err := helpers.GetAccountIDFromPath(endpointURL.Path)
 		if err != nil {
 			resp.Diagnostics.AddAttributeError(
 				path.Root("account_id"),
@@ -169,8 +408,8 @@ func (p *PrefectProvider) Configure(ctx context.Context, req provider.ConfigureR
 	var workspaceID uuid.UUID
 	if !config.WorkspaceID.IsNull() {
 		workspaceID = config.WorkspaceID.ValueUUID()
-	} else if URLContainsIDs(endpoint) {
-		wID, err := GetWorkspaceIDFromPath(endpointURL.Path)
+	} else if helpers.URLContainsIDs(endpoint) {
+		wID, err := helpers.GetWorkspaceIDFromPath(endpointURL.Path)
 		if err != nil {
 			resp.Diagnostics.AddAttributeError(
 				path.Root("workspace_id"),
@@ -184,17 +423,35 @@ func (p *PrefectProvider) Configure(ctx context.Context, req provider.ConfigureR
 		workspaceID = wID
 	}
 
+	deployment, diags := resolveDeploymentConfig(ctx, config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if deployment.mode == client.ModeServer && helpers.IsCloudEndpoint(endpointURL.Host, deployment.cloudHosts...) {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("mode"),
+			"Prefect Cloud endpoint configured in server mode",
+			fmt.Sprintf("The Prefect API Endpoint host %q is a Prefect Cloud host, however, the provider mode is %q, "+
+				"so requests will not be scoped to an account or workspace. "+
+				"Potential resolutions: set the mode attribute or PREFECT_CLIENT_MODE environment variable to %q or %q.",
+				endpointURL.Host, client.ModeServer, client.ModeCloud, client.ModeAuto),
+		)
+	}
+
 	// If the endpoint is pointed to Prefect Cloud, we will ensure
 	// that a valid API Key is passed.
 	// Additionally, we will warn if an Account ID is missing,
 	// as it's likely that this is a user misconfiguration.
-	if helpers.IsCloudEndpoint(endpointURL.Host) {
+	if deployment.isCloud(endpointURL.Host) {
 		if apiKey == "" {
 			resp.Diagnostics.AddAttributeError(
 				path.Root("api_key"),
 				"Missing Prefect API Key",
 				"The Prefect API Endpoint is configured to Prefect Cloud, however, the Prefect API Key is empty. "+
-					"Potential resolutions: set the endpoint attribute or PREFECT_API_URL environment variable to a Prefect server installation, set the PREFECT_API_KEY environment variable, or configure the api_key attribute.",
+					"Potential resolutions: set the endpoint attribute or PREFECT_API_URL environment variable to a Prefect server installation, set the PREFECT_API_KEY environment variable, "+
+					"configure the api_key attribute, or set the mode attribute to \"server\" if the endpoint is a self-hosted Prefect server.",
 			)
 
 			return
@@ -215,7 +472,7 @@ func (p *PrefectProvider) Configure(ctx context.Context, req provider.ConfigureR
 	//
 	// Or, if the endpoint did not contain the account and workspace IDs,
 	// just ensure it has the '/api' suffix.
-	if URLContainsIDs(endpoint) {
+	if helpers.URLContainsIDs(endpoint) {
 		endpoint = fmt.Sprintf("%s://%s/api", endpointURL.Scheme, endpointURL.Host)
 	} else if !strings.HasSuffix(endpoint, "/api") {
 		endpoint = fmt.Sprintf("%s/api", endpoint)
@@ -228,6 +485,7 @@ func (p *PrefectProvider) Configure(ctx context.Context, req provider.ConfigureR
 	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "prefect_basic_auth_key")
 	ctx = tflog.SetField(ctx, "prefect_account_id", accountID)
 	ctx = tflog.SetField(ctx, "prefect_workspace_id", workspaceID)
+	ctx = tflog.SetField(ctx, "prefect_mode", deployment.mode)
 	tflog.Debug(ctx, "Creating Prefect client")
 
 	// Extracts the host (without the /api suffix),
@@ -237,12 +495,71 @@ func (p *PrefectProvider) Configure(ctx context.Context, req provider.ConfigureR
 	// endpoint host to construct custom URLs as a resource attribute.
 	endpointHost := fmt.Sprintf("%s://%s", endpointURL.Scheme, endpointURL.Host)
 
-	prefectClient, err := client.New(
+	retry, diags := resolveRetryConfig(config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	throttle, diags := resolveThrottleConfig(config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpTracing := false
+	if !config.HTTPTracing.IsNull() {
+		httpTracing = config.HTTPTracing.ValueBool()
+	} else if httpTracingEnvVar, ok := os.LookupEnv(envHTTPTracing); ok {
+		httpTracing, err = strconv.ParseBool(httpTracingEnvVar)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("http_tracing"),
+				"Invalid HTTP tracing setting defined in "+envHTTPTracing,
+				fmt.Sprintf("The %s value %q is not a valid boolean: %s", envHTTPTracing, httpTracingEnvVar, err),
+			)
+
+			return
+		}
+	}
+
+	readCache := false
+	if !config.ReadCache.IsNull() {
+		readCache = config.ReadCache.ValueBool()
+	} else if readCacheEnvVar, ok := os.LookupEnv(envReadCache); ok {
+		readCache, err = strconv.ParseBool(readCacheEnvVar)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("read_cache"),
+				"Invalid read cache setting defined in "+envReadCache,
+				fmt.Sprintf("The %s value %q is not a valid boolean: %s", envReadCache, readCacheEnvVar, err),
+			)
+
+			return
+		}
+	}
+
+	transportOptions, diags := resolveTransportOptions(ctx, config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	prefectClient, err := client.New(append([]client.Option{
 		client.WithEndpoint(endpoint, endpointHost),
 		client.WithAPIKey(apiKey),
 		client.WithBasicAuthKey(basicAuthKey),
 		client.WithDefaults(accountID, workspaceID),
-	)
+		client.WithMode(deployment.mode),
+		client.WithCloudHosts(deployment.cloudHosts),
+		client.WithRetryPolicy(retry.maxRetries, retry.waitMin, retry.waitMax),
+		client.WithRetryTimeout(retry.timeout),
+		client.WithRateLimit(throttle.rateLimit, throttle.rateLimitBurst),
+		client.WithMaxConcurrentRequests(throttle.maxConcurrentRequests),
+		client.WithHTTPTracing(httpTracing),
+		client.WithReadCache(readCache),
+		client.WithAdoptExisting(config.AdoptExisting.ValueBool()),
+	}, transportOptions...)...)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to create Prefect API Client",
@@ -254,13 +571,51 @@ func (p *PrefectProvider) Configure(ctx context.Context, req provider.ConfigureR
 	}
 	p.client = prefectClient
 
-	// Pass client to DataSource and Resource type Configure methods
+	// Detect the server flavor, version and plan once, so that resources can
+	// fail early when they are not supported. Detection failures are not
+	// fatal, as the API may restrict access to the endpoints used.
+	serverInfo, err := prefectClient.DetectServer(ctx)
+	if err != nil {
+		tflog.Warn(ctx, "Unable to detect the Prefect server version", map[string]any{"error": err.Error()})
+	}
+
+	ctx = tflog.SetField(ctx, "prefect_server_flavor", serverInfo.Flavor)
+	ctx = tflog.SetField(ctx, "prefect_server_version", serverInfo.Version)
+	ctx = tflog.SetField(ctx, "prefect_plan_type", serverInfo.PlanType)
+
+	if atLeast, known := serverInfo.VersionAtLeast(minServerVersion); serverInfo.IsOSS() && known && !atLeast {
+		resp.Diagnostics.AddWarning(
+			"Unsupported Prefect server version",
+			fmt.Sprintf("The provider is connected to Prefect server %s, but it is only tested against Prefect server %s and later. "+
+				"Some resources may not work as expected until the server is upgraded.", serverInfo.Version, minServerVersion),
+		)
+	}
+
+	// Pass client to DataSource, Resource, EphemeralResource, ListResource and Action type Configure methods
 	resp.DataSourceData = prefectClient
 	resp.ResourceData = prefectClient
+	resp.EphemeralResourceData = prefectClient
+	resp.ListResourceData = prefectClient
+	resp.ActionData = prefectClient
 
 	tflog.Info(ctx, "Configured Prefect client", map[string]any{"success": true})
 }
 
+// Actions defines the actions implemented in the provider.
+func (p *PrefectProvider) Actions(_ context.Context) []func() action.Action {
+	return []func() action.Action{
+		actions.NewAutomationDisableAction,
+		actions.NewAutomationEnableAction,
+		actions.NewDeploymentSchedulesPauseAction,
+		actions.NewDeploymentSchedulesResumeAction,
+		actions.NewGlobalConcurrencyLimitResetAction,
+		actions.NewWorkPoolPauseAction,
+		actions.NewWorkPoolResumeAction,
+		actions.NewWorkQueuePauseAction,
+		actions.NewWorkQueueResumeAction,
+	}
+}
+
 // DataSources defines the data sources implemented in the provider.
 func (p *PrefectProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
@@ -287,6 +642,39 @@ func (p *PrefectProvider) DataSources(_ context.Context) []func() datasource.Dat
 	}
 }
 
+// EphemeralResources defines the ephemeral resources implemented in the provider.
+func (p *PrefectProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		ephemeralresources.NewServiceAccountAPIKeyEphemeralResource,
+		ephemeralresources.NewUserAPIKeyEphemeralResource,
+	}
+}
+
+// ListResources defines the list resources implemented in the provider.
+func (p *PrefectProvider) ListResources(_ context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		resources.NewAutomationListResource,
+		resources.NewBlockListResource,
+		resources.NewDeploymentListResource,
+		resources.NewGlobalConcurrencyLimitListResource,
+		resources.NewVariableListResource,
+		resources.NewWebhookListResource,
+		resources.NewWorkPoolListResource,
+		resources.NewWorkQueueListResource,
+	}
+}
+
+// Functions defines the functions implemented in the provider.
+func (p *PrefectProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		functions.NewCronNextRunsFunction,
+		functions.NewParseAPIURLFunction,
+		functions.NewRRuleNextRunsFunction,
+		functions.NewUIURLFunction,
+		functions.NewValidateCronFunction,
+	}
+}
+
 // Resources defines the resources implemented in the provider.
 func (p *PrefectProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
@@ -0,0 +1,225 @@
+package provider
+
+import (
+	"context"
+	"iter"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/client"
+)
+
+// requestSummaryServer wraps a provider server to log a summary of the
+// Prefect API requests made while serving each resource and data source RPC.
+// This helps explain slow plans and applies, for example by showing which
+// resources were retried or throttled.
+type requestSummaryServer struct {
+	tfprotov6.ProviderServer
+}
+
+// The list resource and action RPCs are not part of tfprotov6.ProviderServer
+// yet, and are only served when the server implements these interfaces.
+//
+//nolint:staticcheck // the interfaces are deprecated in favour of ProviderServer, which does not include the RPCs yet
+var (
+	_ = tfprotov6.ProviderServerWithListResource(&requestSummaryServer{})
+	_ = tfprotov6.ProviderServerWithActions(&requestSummaryServer{})
+)
+
+// NewRequestSummaryServer wraps a provider server so that each resource,
+// data source, ephemeral resource, list resource and action RPC logs the
+// number of API requests, retries and failures it caused at DEBUG level.
+//
+//nolint:ireturn // required by Terraform API
+func NewRequestSummaryServer(server tfprotov6.ProviderServer) tfprotov6.ProviderServer {
+	return &requestSummaryServer{ProviderServer: server}
+}
+
+// summarizeRequests calls an RPC with a context that records API requests,
+// and logs a summary of them once the RPC returns. The RPC name and resource
+// type are already set on the logger by terraform-plugin-go.
+func summarizeRequests[Resp any](ctx context.Context, call func(context.Context) (Resp, error)) (Resp, error) {
+	ctx, stats := client.ContextWithCallStats(ctx)
+
+	resp, err := call(ctx)
+
+	logRequestSummary(ctx, stats)
+
+	return resp, err
+}
+
+// summarizeStreamedRequests is like summarizeRequests, for RPCs that stream
+// their results. The results are only computed, and the API requests made,
+// while Terraform iterates over them, so the summary is logged afterwards.
+func summarizeStreamedRequests[T any](ctx context.Context, call func(context.Context) (iter.Seq[T], error)) (iter.Seq[T], error) {
+	ctx, stats := client.ContextWithCallStats(ctx)
+
+	results, err := call(ctx)
+	if err != nil || results == nil {
+		logRequestSummary(ctx, stats)
+
+		return results, err
+	}
+
+	return func(yield func(T) bool) {
+		defer logRequestSummary(ctx, stats)
+
+		for result := range results {
+			if !yield(result) {
+				return
+			}
+		}
+	}, nil
+}
+
+func logRequestSummary(ctx context.Context, stats *client.CallStats) {
+	if stats.Requests() > 0 {
+		tflog.Debug(ctx, "Prefect API request summary", stats.Fields())
+	}
+}
+
+// notImplementedDiagnostics returns the diagnostics returned by tf6server
+// when the provider server does not implement an RPC.
+func notImplementedDiagnostics(rpc string) []*tfprotov6.Diagnostic {
+	return []*tfprotov6.Diagnostic{
+		{
+			Severity: tfprotov6.DiagnosticSeverityError,
+			Summary:  "Provider " + rpc + " Not Implemented",
+			Detail:   "A " + rpc + " call was received by the provider, however the provider does not implement the call.",
+		},
+	}
+}
+
+func (s *requestSummaryServer) ReadResource(ctx context.Context, req *tfprotov6.ReadResourceRequest) (*tfprotov6.ReadResourceResponse, error) {
+	return summarizeRequests(ctx, func(ctx context.Context) (*tfprotov6.ReadResourceResponse, error) {
+		//nolint:wrapcheck // errors are returned to Terraform unchanged
+		return s.ProviderServer.ReadResource(ctx, req)
+	})
+}
+
+func (s *requestSummaryServer) PlanResourceChange(ctx context.Context, req *tfprotov6.PlanResourceChangeRequest) (*tfprotov6.PlanResourceChangeResponse, error) {
+	return summarizeRequests(ctx, func(ctx context.Context) (*tfprotov6.PlanResourceChangeResponse, error) {
+		//nolint:wrapcheck // errors are returned to Terraform unchanged
+		return s.ProviderServer.PlanResourceChange(ctx, req)
+	})
+}
+
+func (s *requestSummaryServer) ApplyResourceChange(ctx context.Context, req *tfprotov6.ApplyResourceChangeRequest) (*tfprotov6.ApplyResourceChangeResponse, error) {
+	return summarizeRequests(ctx, func(ctx context.Context) (*tfprotov6.ApplyResourceChangeResponse, error) {
+		//nolint:wrapcheck // errors are returned to Terraform unchanged
+		return s.ProviderServer.ApplyResourceChange(ctx, req)
+	})
+}
+
+func (s *requestSummaryServer) ImportResourceState(ctx context.Context, req *tfprotov6.ImportResourceStateRequest) (*tfprotov6.ImportResourceStateResponse, error) {
+	return summarizeRequests(ctx, func(ctx context.Context) (*tfprotov6.ImportResourceStateResponse, error) {
+		//nolint:wrapcheck // errors are returned to Terraform unchanged
+		return s.ProviderServer.ImportResourceState(ctx, req)
+	})
+}
+
+func (s *requestSummaryServer) ReadDataSource(ctx context.Context, req *tfprotov6.ReadDataSourceRequest) (*tfprotov6.ReadDataSourceResponse, error) {
+	return summarizeRequests(ctx, func(ctx context.Context) (*tfprotov6.ReadDataSourceResponse, error) {
+		//nolint:wrapcheck // errors are returned to Terraform unchanged
+		return s.ProviderServer.ReadDataSource(ctx, req)
+	})
+}
+
+func (s *requestSummaryServer) OpenEphemeralResource(ctx context.Context, req *tfprotov6.OpenEphemeralResourceRequest) (*tfprotov6.OpenEphemeralResourceResponse, error) {
+	return summarizeRequests(ctx, func(ctx context.Context) (*tfprotov6.OpenEphemeralResourceResponse, error) {
+		//nolint:wrapcheck // errors are returned to Terraform unchanged
+		return s.ProviderServer.OpenEphemeralResource(ctx, req)
+	})
+}
+
+func (s *requestSummaryServer) ValidateListResourceConfig(ctx context.Context, req *tfprotov6.ValidateListResourceConfigRequest) (*tfprotov6.ValidateListResourceConfigResponse, error) {
+	//nolint:staticcheck // see above
+	server, ok := s.ProviderServer.(tfprotov6.ProviderServerWithListResource)
+	if !ok {
+		return &tfprotov6.ValidateListResourceConfigResponse{Diagnostics: notImplementedDiagnostics("ValidateListResourceConfig")}, nil
+	}
+
+	//nolint:wrapcheck // errors are returned to Terraform unchanged
+	return server.ValidateListResourceConfig(ctx, req)
+}
+
+func (s *requestSummaryServer) ListResource(ctx context.Context, req *tfprotov6.ListResourceRequest) (*tfprotov6.ListResourceServerStream, error) {
+	//nolint:staticcheck // see above
+	server, ok := s.ProviderServer.(tfprotov6.ProviderServerWithListResource)
+	if !ok {
+		return &tfprotov6.ListResourceServerStream{
+			Results: func(yield func(tfprotov6.ListResourceResult) bool) {
+				yield(tfprotov6.ListResourceResult{Diagnostics: notImplementedDiagnostics("ListResource")})
+			},
+		}, nil
+	}
+
+	results, err := summarizeStreamedRequests(ctx, func(ctx context.Context) (iter.Seq[tfprotov6.ListResourceResult], error) {
+		stream, err := server.ListResource(ctx, req)
+		if err != nil || stream == nil {
+			//nolint:wrapcheck // errors are returned to Terraform unchanged
+			return nil, err
+		}
+
+		return stream.Results, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &tfprotov6.ListResourceServerStream{Results: results}, nil
+}
+
+func (s *requestSummaryServer) ValidateActionConfig(ctx context.Context, req *tfprotov6.ValidateActionConfigRequest) (*tfprotov6.ValidateActionConfigResponse, error) {
+	//nolint:staticcheck // see above
+	server, ok := s.ProviderServer.(tfprotov6.ProviderServerWithActions)
+	if !ok {
+		return &tfprotov6.ValidateActionConfigResponse{Diagnostics: notImplementedDiagnostics("ValidateActionConfig")}, nil
+	}
+
+	//nolint:wrapcheck // errors are returned to Terraform unchanged
+	return server.ValidateActionConfig(ctx, req)
+}
+
+func (s *requestSummaryServer) PlanAction(ctx context.Context, req *tfprotov6.PlanActionRequest) (*tfprotov6.PlanActionResponse, error) {
+	//nolint:staticcheck // see above
+	server, ok := s.ProviderServer.(tfprotov6.ProviderServerWithActions)
+	if !ok {
+		return &tfprotov6.PlanActionResponse{Diagnostics: notImplementedDiagnostics("PlanAction")}, nil
+	}
+
+	return summarizeRequests(ctx, func(ctx context.Context) (*tfprotov6.PlanActionResponse, error) {
+		//nolint:wrapcheck // errors are returned to Terraform unchanged
+		return server.PlanAction(ctx, req)
+	})
+}
+
+func (s *requestSummaryServer) InvokeAction(ctx context.Context, req *tfprotov6.InvokeActionRequest) (*tfprotov6.InvokeActionServerStream, error) {
+	//nolint:staticcheck // see above
+	server, ok := s.ProviderServer.(tfprotov6.ProviderServerWithActions)
+	if !ok {
+		return &tfprotov6.InvokeActionServerStream{
+			Events: func(yield func(tfprotov6.InvokeActionEvent) bool) {
+				yield(tfprotov6.InvokeActionEvent{
+					Type: tfprotov6.CompletedInvokeActionEventType{Diagnostics: notImplementedDiagnostics("InvokeAction")},
+				})
+			},
+		}, nil
+	}
+
+	events, err := summarizeStreamedRequests(ctx, func(ctx context.Context) (iter.Seq[tfprotov6.InvokeActionEvent], error) {
+		stream, err := server.InvokeAction(ctx, req)
+		if err != nil || stream == nil {
+			//nolint:wrapcheck // errors are returned to Terraform unchanged
+			return nil, err
+		}
+
+		return stream.Events, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &tfprotov6.InvokeActionServerStream{Events: events}, nil
+}
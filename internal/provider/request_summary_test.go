@@ -0,0 +1,152 @@
+package provider_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/client"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils/fakeserver"
+)
+
+// newWrappedProviderServer returns the provider server as served by main.go.
+//
+//nolint:ireturn // mirrors the Terraform API
+func newWrappedProviderServer() tfprotov6.ProviderServer {
+	return provider.NewRequestSummaryServer(providerserver.NewProtocol6(&provider.PrefectProvider{})())
+}
+
+func TestRequestSummaryServerOptionalInterfaces(t *testing.T) {
+	t.Parallel()
+
+	server := newWrappedProviderServer()
+
+	//nolint:staticcheck // tf6server still serves list resources and actions through these interfaces
+	assert.Implements(t, (*tfprotov6.ProviderServerWithListResource)(nil), server)
+	//nolint:staticcheck // tf6server still serves list resources and actions through these interfaces
+	assert.Implements(t, (*tfprotov6.ProviderServerWithActions)(nil), server)
+}
+
+// configuredProviderServer is the provider server as served by main.go,
+// configured for a fake Prefect API.
+type configuredProviderServer struct {
+	tfprotov6.ProviderServer
+
+	// schemas are the provider's schemas.
+	schemas *tfprotov6.GetProviderSchemaResponse
+
+	// client is a client for the fake Prefect API.
+	client api.PrefectClient
+
+	// workspaceID is the ID of a workspace created in the fake Prefect API.
+	workspaceID uuid.UUID
+}
+
+// newConfiguredProviderServer starts a fake Prefect API with a workspace and
+// returns the provider server as served by main.go, configured for the API.
+func newConfiguredProviderServer(t *testing.T) *configuredProviderServer {
+	t.Helper()
+
+	const apiKey = "pnu_fake"
+
+	ctx := context.Background()
+
+	fake := fakeserver.New(fakeserver.WithAPIKey(apiKey))
+	fake.Start()
+	t.Cleanup(fake.Close)
+
+	prefectClient, err := client.New(
+		client.WithEndpoint(fake.URL()+"/api", fake.URL()),
+		client.WithAPIKey(apiKey),
+		client.WithDefaults(fake.AccountID(), uuid.Nil),
+	)
+	require.NoError(t, err)
+
+	workspaces, err := prefectClient.Workspaces(uuid.Nil)
+	require.NoError(t, err)
+
+	workspace, err := workspaces.Create(ctx, api.WorkspaceCreate{Name: "test", Handle: "test"})
+	require.NoError(t, err)
+
+	server := &configuredProviderServer{
+		ProviderServer: newWrappedProviderServer(),
+		client:         prefectClient,
+		workspaceID:    workspace.ID,
+	}
+
+	server.schemas, err = server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	requireNoErrorDiagnostics(t, server.schemas.Diagnostics)
+
+	configureResp, err := server.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{
+		Config: dynamicValue(t, server.schemas.Provider, map[string]string{
+			"endpoint":   fake.URL() + "/api",
+			"api_key":    apiKey,
+			"account_id": fake.AccountID().String(),
+		}),
+	})
+	require.NoError(t, err)
+	requireNoErrorDiagnostics(t, configureResp.Diagnostics)
+
+	return server
+}
+
+// dynamicValue encodes a configuration for the given schema, with the given
+// string attributes set and all other attributes null.
+func dynamicValue(t *testing.T, schema *tfprotov6.Schema, attributes map[string]string) *tfprotov6.DynamicValue {
+	t.Helper()
+
+	objectType, ok := schema.ValueType().(tftypes.Object)
+	require.True(t, ok)
+
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+
+	for name, value := range attributes {
+		require.Contains(t, objectType.AttributeTypes, name)
+		values[name] = tftypes.NewValue(tftypes.String, value)
+	}
+
+	value, err := tfprotov6.NewDynamicValue(objectType, tftypes.NewValue(objectType, values))
+	require.NoError(t, err)
+
+	return &value
+}
+
+func requireNoErrorDiagnostics(t *testing.T, diags []*tfprotov6.Diagnostic) {
+	t.Helper()
+
+	for _, diagnostic := range diags {
+		require.NotEqual(t, tfprotov6.DiagnosticSeverityError, diagnostic.Severity, "%s: %s", diagnostic.Summary, diagnostic.Detail)
+	}
+}
+
+// requestSummaries returns the request summaries logged to output.
+func requestSummaries(t *testing.T, output *bytes.Buffer) []map[string]any {
+	t.Helper()
+
+	entries, err := tflogtest.MultilineJSONDecode(output)
+	require.NoError(t, err)
+
+	var summaries []map[string]any
+
+	for _, entry := range entries {
+		if entry["@message"] == "Prefect API request summary" {
+			summaries = append(summaries, entry)
+		}
+	}
+
+	return summaries
+}
@@ -2,6 +2,7 @@ package resources
 
 import (
 	"context"
+	"errors"
 	"strconv"
 
 	"github.com/google/uuid"
@@ -22,7 +23,9 @@ import (
 
 var (
 	_ = resource.ResourceWithConfigure(&AccountResource{})
+	_ = resource.ResourceWithModifyPlan(&AccountResource{})
 	_ = resource.ResourceWithImportState(&AccountResource{})
+	_ = resource.ResourceWithIdentity(&AccountResource{})
 )
 
 // AccountResource contains state for the resource.
@@ -33,6 +36,7 @@ type AccountResource struct {
 // AccountResourceModel defines the Terraform resource model.
 type AccountResourceModel struct {
 	BaseModel
+	DestroyModel
 
 	Name         types.String `tfsdk:"name"`
 	Handle       types.String `tfsdk:"handle"`
@@ -71,6 +75,19 @@ func (r *AccountResource) Configure(_ context.Context, req resource.ConfigureReq
 	r.client = client
 }
 
+// ModifyPlan fails plans destroying the account while its deletion protection
+// is enabled, and checks that the server supports the resource when it is
+// created. See helpers.CheckServerCapability.
+func (r *AccountResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	checkDeletionProtection(ctx, req, resp, "Account")
+
+	if r.client == nil || !helpers.IsCreate(req) {
+		return
+	}
+
+	resp.Diagnostics.Append(helpers.CheckServerCapability(r.client.ServerInfo(), "The prefect_account resource", "", helpers.AllCloudPlans...)...)
+}
+
 // Schema defines the schema for the resource.
 func (r *AccountResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
@@ -79,7 +96,8 @@ func (r *AccountResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 			"\n"+
 			"Note that this resource can only be imported, as account creation is not currently supported "+
 			"via the API. Additionally, be aware that account deletion is possible once it is imported, "+
-			"so be attentive to any destroy plans or unlink the resource through `terraform state rm`.",
+			"so be attentive to any destroy plans. Set `deletion_protection` to prevent the account from being deleted, "+
+			"or `on_destroy` to `abandon` to only remove it from the Terraform state when the resource is destroyed.",
 			helpers.AllCloudPlans...,
 		),
 		Version: 0,
@@ -154,10 +172,17 @@ func (r *AccountResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"deletion_protection": deletionProtectionAttribute("account", "also deletes every workspace in it"),
+			"on_destroy":          onDestroyAttribute("account"),
 		},
 	}
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (r *AccountResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = unscopedIDIdentitySchema("Account ID (UUID)")
+}
+
 // copyAccountToModel maps an API response to a model that is saved in Terraform state.
 // A model can be a Terraform Plan, State, or Config object.
 func copyAccountToModel(_ context.Context, account *api.Account, tfModel *AccountResourceModel) diag.Diagnostics {
@@ -235,6 +260,12 @@ func (r *AccountResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 	account, err := client.Get(ctx)
 	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Account", "get", err))
 
 		return
@@ -257,7 +288,12 @@ func (r *AccountResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	state.setDefaults()
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, UnscopedIDIdentityModel{
+		ID: state.ID,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -344,6 +380,9 @@ func (r *AccountResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, UnscopedIDIdentityModel{
+		ID: plan.ID,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -358,6 +397,12 @@ func (r *AccountResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	shouldDelete, diags := state.shouldDelete(ctx, "Account")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || !shouldDelete {
+		return
+	}
+
 	accountID, err := uuid.Parse(state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.ParseUUIDErrorDiagnostic("Account", err))
@@ -385,6 +430,12 @@ func (r *AccountResource) Delete(ctx context.Context, req resource.DeleteRequest
 
 // ImportState imports the resource into Terraform state.
 func (r *AccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if helpers.IsImportByIdentity(req) {
+		helpers.ImportStateFromIdentity(ctx, req, resp)
+
+		return
+	}
+
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -19,7 +20,9 @@ import (
 
 var (
 	_ = resource.ResourceWithConfigure(&AccountMemberResource{})
+	_ = resource.ResourceWithModifyPlan(&AccountMemberResource{})
 	_ = resource.ResourceWithImportState(&AccountMemberResource{})
+	_ = resource.ResourceWithIdentity(&AccountMemberResource{})
 )
 
 // AccountMemberResource contains state for the resource.
@@ -43,6 +46,12 @@ type AccountMemberResourceModel struct {
 	AccountID customtypes.UUIDValue `tfsdk:"account_id"`
 }
 
+// AccountMemberResourceIdentityModel defines the Terraform resource identity model.
+type AccountMemberResourceIdentityModel struct {
+	AccountID customtypes.UUIDValue `tfsdk:"account_id"`
+	Email     types.String          `tfsdk:"email"`
+}
+
 // NewAccountMemberResource returns a new AccountMemberResource.
 //
 //nolint:ireturn // required by Terraform API
@@ -71,6 +80,16 @@ func (r *AccountMemberResource) Configure(_ context.Context, req resource.Config
 	r.client = client
 }
 
+// ModifyPlan checks that the server supports the resource when it is created.
+// See helpers.CheckServerCapability.
+func (r *AccountMemberResource) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || !helpers.IsCreate(req) {
+		return
+	}
+
+	resp.Diagnostics.Append(helpers.CheckServerCapability(r.client.ServerInfo(), "The prefect_account_member resource", "", helpers.AllCloudPlans...)...)
+}
+
 // Schema defines the schema for the resource.
 func (r *AccountMemberResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
@@ -140,6 +159,16 @@ func (r *AccountMemberResource) Schema(_ context.Context, _ resource.SchemaReque
 	}
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (r *AccountMemberResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = accountIdentitySchema(map[string]identityschema.Attribute{
+		"email": identityschema.StringAttribute{
+			RequiredForImport: true,
+			Description:       "Email of the account member",
+		},
+	})
+}
+
 func copyAccountMemberToModel(member *api.AccountMembership, tfModel *AccountMemberResourceModel) diag.Diagnostics {
 	tfModel.ID = types.StringValue(member.ID)
 	tfModel.ActorID = customtypes.NewUUIDValue(member.ActorID)
@@ -197,6 +226,10 @@ func (r *AccountMemberResource) Read(ctx context.Context, req resource.ReadReque
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, AccountMemberResourceIdentityModel{
+		AccountID: state.AccountID,
+		Email:     state.Email,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -256,6 +289,10 @@ func (r *AccountMemberResource) Update(ctx context.Context, req resource.UpdateR
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, AccountMemberResourceIdentityModel{
+		AccountID: plan.AccountID,
+		Email:     plan.Email,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -296,5 +333,11 @@ func (r *AccountMemberResource) Delete(ctx context.Context, req resource.DeleteR
 // ImportState imports the resource into Terraform state.
 // Import syntax: <account_email>.
 func (r *AccountMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if helpers.IsImportByIdentity(req) {
+		helpers.ImportStateFromIdentity(ctx, req, resp)
+
+		return
+	}
+
 	resource.ImportStatePassthroughID(ctx, path.Root("email"), req, resp)
 }
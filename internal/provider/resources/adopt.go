@@ -0,0 +1,56 @@
+package resources
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+// adoptExistingAttribute returns the schema of the `adopt_existing` attribute,
+// for resources that can adopt an existing object with the same name on create.
+func adoptExistingAttribute(objectName string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Description: fmt.Sprintf("Whether to adopt an existing %s with the same name on create, updating it to the configured values, instead of failing. "+
+			"This makes re-running a partially applied configuration idempotent. Defaults to the provider's `adopt_existing` setting.", objectName),
+		Optional: true,
+	}
+}
+
+// shouldAdopt reports whether a failed create should adopt the existing
+// object, which is the case for conflicts when `adopt_existing` is set,
+// either on the resource or as the provider's default.
+func shouldAdopt(client api.PrefectClient, adoptExisting types.Bool, err error) bool {
+	if !errors.Is(err, api.ErrConflict) {
+		return false
+	}
+
+	if adoptExisting.IsNull() || adoptExisting.IsUnknown() {
+		return client.AdoptExisting()
+	}
+
+	return adoptExisting.ValueBool()
+}
+
+// createErrorDiagnostic returns an error diagnostic for a failed create,
+// pointing to `adopt_existing` when an object with the same name exists.
+//
+//nolint:ireturn // required by Terraform API
+func createErrorDiagnostic(resourceName string, err error) diag.Diagnostic {
+	if !errors.Is(err, api.ErrConflict) {
+		return helpers.ResourceClientErrorDiagnostic(resourceName, "create", err)
+	}
+
+	return diag.NewAttributeErrorDiagnostic(
+		path.Root("name"),
+		"Error during create "+resourceName,
+		fmt.Sprintf("Could not create %s, as one with the same name already exists. "+
+			"Import the existing %s, or set `adopt_existing = true` to adopt it on create. Error: %s", resourceName, resourceName, err.Error()),
+	)
+}
@@ -0,0 +1,78 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var _ = list.ListResourceWithConfigure(&AutomationResource{})
+
+// NewAutomationListResource returns a new AutomationResource as a list resource.
+//
+//nolint:ireturn // required by Terraform API
+func NewAutomationListResource() list.ListResource {
+	return &AutomationResource{}
+}
+
+// ListResourceConfigSchema defines the schema of the list resource configuration.
+func (r *AutomationResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = workspaceListSchema("Lists the Automations of a workspace.", nil)
+}
+
+// List streams the Automations of a workspace.
+func (r *AutomationResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config WorkspaceListModel
+
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	client, err := r.client.Automations(config.AccountID.ValueUUID(), config.WorkspaceID.ValueUUID())
+	if err != nil {
+		diags.Append(helpers.CreateClientErrorDiagnostic("Automation", err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	automations := client.Iterate(ctx, listOptions(req))
+
+	streamListResults(ctx, req, stream, "Automation", automations, func(automation *api.Automation, result *list.ListResult) {
+		result.DisplayName = automation.Name
+
+		result.Diagnostics.Append(result.Identity.Set(ctx, IDIdentityModel{
+			AccountID:   config.AccountID,
+			WorkspaceID: config.WorkspaceID,
+			ID:          types.StringValue(automation.ID.String()),
+		})...)
+		if result.Diagnostics.HasError() || !req.IncludeResource {
+			return
+		}
+
+		var model AutomationResourceModel
+
+		// Like during import, the trigger must hold an empty TriggerModel
+		// rather than null, as it is not modeled by a pointer.
+		result.Diagnostics.Append(setNullResource(ctx, result.Resource)...)
+		result.Diagnostics.Append(result.Resource.SetAttribute(ctx, path.Root("trigger"), TriggerModel{})...)
+		result.Diagnostics.Append(result.Resource.Get(ctx, &model)...)
+		if result.Diagnostics.HasError() {
+			return
+		}
+
+		model.AccountID = config.AccountID
+		model.WorkspaceID = config.WorkspaceID
+
+		result.Diagnostics.Append(mapAutomationAPIToTerraform(ctx, automation, &model)...)
+		result.Diagnostics.Append(result.Resource.Set(ctx, model)...)
+	})
+}
@@ -3,6 +3,7 @@ package resources
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strconv"
 
@@ -23,7 +24,9 @@ import (
 
 var (
 	_ = resource.ResourceWithConfigure(&AutomationResource{})
+	_ = resource.ResourceWithModifyPlan(&AutomationResource{})
 	_ = resource.ResourceWithImportState(&AutomationResource{})
+	_ = resource.ResourceWithIdentity(&AutomationResource{})
 	_ = resource.ResourceWithConfigValidators(&AutomationResource{})
 )
 
@@ -63,6 +66,16 @@ func (r *AutomationResource) Configure(_ context.Context, req resource.Configure
 	r.client = client
 }
 
+// ModifyPlan checks that the server supports the resource when it is created.
+// See helpers.CheckServerCapability.
+func (r *AutomationResource) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || !helpers.IsCreate(req) {
+		return
+	}
+
+	resp.Diagnostics.Append(helpers.CheckServerCapability(r.client.ServerInfo(), "The prefect_automation resource", "3.0.0", helpers.AllPlans...)...)
+}
+
 // Schema defines the schema for the resource.
 func (r *AutomationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
@@ -78,6 +91,11 @@ For more information, see [automate overview](https://docs.prefect.io/v3/automat
 	}
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (r *AutomationResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("Automation ID (UUID)")
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *AutomationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan AutomationResourceModel
@@ -114,6 +132,11 @@ func (r *AutomationResource) Create(ctx context.Context, req resource.CreateRequ
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IDIdentityModel{
+		AccountID:   plan.AccountID,
+		WorkspaceID: plan.WorkspaceID,
+		ID:          plan.ID,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -145,6 +168,12 @@ func (r *AutomationResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	automation, err := client.Get(ctx, automationID)
 	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Automation", "get", err))
 
 		return
@@ -156,6 +185,11 @@ func (r *AutomationResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IDIdentityModel{
+		AccountID:   state.AccountID,
+		WorkspaceID: state.WorkspaceID,
+		ID:          state.ID,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -211,6 +245,11 @@ func (r *AutomationResource) Update(ctx context.Context, req resource.UpdateRequ
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IDIdentityModel{
+		AccountID:   plan.AccountID,
+		WorkspaceID: plan.WorkspaceID,
+		ID:          plan.ID,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -253,8 +292,18 @@ func (r *AutomationResource) Delete(ctx context.Context, req resource.DeleteRequ
 }
 
 // ImportState imports the resource into Terraform state.
+//
+// Allows input values in the form of:
+// - "id,workspace_id" or "id"
+// - a link to the automation in the Prefect UI
+//
+// or the identity set in an import block.
 func (r *AutomationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStateByID(ctx, req, resp)
+	if helpers.IsUIURL(req.ID) {
+		helpers.ImportStateFromUIURL(ctx, r.client, req, resp, helpers.UIObjectAutomation, "id")
+	} else {
+		helpers.ImportStateByID(ctx, req, resp)
+	}
 
 	// We need to set the trigger to an empty TriggerModel during import
 	// to avoid null value errors (Value Conversion Errors) from the provider framework.
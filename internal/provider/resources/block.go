@@ -2,35 +2,50 @@ package resources
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/avast/retry-go/v4"
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/prefecthq/terraform-provider-prefect/internal/api"
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
 )
 
+var (
+	_ = resource.ResourceWithIdentity(&BlockResource{})
+	_ = resource.ResourceWithModifyPlan(&BlockResource{})
+)
+
 type BlockResource struct {
 	client api.PrefectClient
 }
 
 type BlockResourceModel struct {
 	BaseModel
+	DestroyModel
 
 	AccountID   customtypes.UUIDValue `tfsdk:"account_id"`
 	WorkspaceID customtypes.UUIDValue `tfsdk:"workspace_id"`
 
-	Name     types.String         `tfsdk:"name"`
-	TypeSlug types.String         `tfsdk:"type_slug"`
-	Data     jsontypes.Normalized `tfsdk:"data"`
+	Name          types.String         `tfsdk:"name"`
+	TypeSlug      types.String         `tfsdk:"type_slug"`
+	Data          jsontypes.Normalized `tfsdk:"data"`
+	DataWO        jsontypes.Normalized `tfsdk:"data_wo"`
+	DataWOVersion types.Int64          `tfsdk:"data_wo_version"`
+	AdoptExisting types.Bool           `tfsdk:"adopt_existing"`
 }
 
 // NewBlockResource returns a new BlockResource.
@@ -61,6 +76,12 @@ func (r *BlockResource) Configure(_ context.Context, req resource.ConfigureReque
 	r.client = client
 }
 
+// ModifyPlan fails plans destroying or replacing the block while its deletion protection
+// is enabled.
+func (r *BlockResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	checkDeletionProtection(ctx, req, resp, "Block", "type_slug")
+}
+
 func (r *BlockResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: helpers.DescriptionWithPlans(
@@ -76,7 +97,9 @@ func (r *BlockResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				"\n"+
 				"Use `prefect block type inspect <slug>` to view the data schema for a given Block type. Use this to construct the `data` attribute value (as JSON string)."+
 				"\n"+
-				"*NOTE:* if a Block is managed in Terraform, the `.data` attribute will NOT be re-reconciled if the remote value is changed. This means that a TF-managed Block will only update the API, and not the other way around.",
+				"*NOTE:* if a Block is managed in Terraform, the `.data` attribute will NOT be re-reconciled if the remote value is changed. This means that a TF-managed Block will only update the API, and not the other way around."+
+				"\n"+
+				"To keep secrets out of Terraform state, use the write-only `data_wo` attribute instead of `data` (Terraform 1.11 and later), and increment `data_wo_version` whenever its value changes.",
 			helpers.AllPlans...,
 		),
 		Version: 0,
@@ -113,10 +136,27 @@ func (r *BlockResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				},
 			},
 			"data": schema.StringAttribute{
-				Required:    true,
+				Optional:    true,
 				Sensitive:   true,
 				CustomType:  jsontypes.NormalizedType{},
-				Description: "The user-inputted Block payload, as a JSON string. Use `jsonencode` on the provided value to satisfy the underlying JSON type. The value's schema will depend on the selected `type` slug. Use `prefect block type inspect <slug>` to view the data schema for a given Block type.",
+				Description: "The user-inputted Block payload, as a JSON string. Use `jsonencode` on the provided value to satisfy the underlying JSON type. The value's schema will depend on the selected `type` slug. Use `prefect block type inspect <slug>` to view the data schema for a given Block type. Exactly one of `data` or `data_wo` must be set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("data_wo")),
+				},
+			},
+			"data_wo": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				CustomType:  jsontypes.NormalizedType{},
+				Description: "Write-only alternative to `data`, which is sent to the API but never stored in Terraform state or plans. Requires Terraform 1.11 or later. As its value is not stored, changes to it are only applied when `data_wo_version` changes.",
+			},
+			"data_wo_version": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Version of the `data_wo` value. Increment this whenever `data_wo` changes, to update the Block with its new value.",
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("data_wo")),
+				},
 			},
 			"account_id": schema.StringAttribute{
 				Optional:    true,
@@ -128,10 +168,18 @@ func (r *BlockResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				CustomType:  customtypes.UUIDType{},
 				Description: "Workspace ID (UUID) where the Block is located. In Prefect Cloud, either the `prefect_block` resource or the provider's `workspace_id` must be set.",
 			},
+			"adopt_existing":      adoptExistingAttribute("block of the same type"),
+			"deletion_protection": deletionProtectionAttribute("block", "breaks the deployments and flows that load it"),
+			"on_destroy":          onDestroyAttribute("block"),
 		},
 	}
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (r *BlockResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("Block ID (UUID)")
+}
+
 // getBlockSchemas fetches the block schemas for a given block type slug.
 //
 //nolint:ireturn // required by Terraform API
@@ -211,6 +259,26 @@ func copyBlockToModel(block *api.BlockDocument, tfModel *BlockResourceModel) dia
 	return nil
 }
 
+// blockData returns the Block payload to send to the API, from either the
+// `data` attribute in the plan or the write-only `data_wo` attribute, which
+// is only available in the configuration.
+func blockData(ctx context.Context, config tfsdk.Config, plan BlockResourceModel) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	value := plan.Data
+	if value.IsNull() {
+		diags.Append(config.GetAttribute(ctx, path.Root("data_wo"), &value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	var data map[string]interface{}
+	diags.Append(value.Unmarshal(&data)...)
+
+	return data, diags
+}
+
 // Create will create the Block resource through the API and insert it into the State.
 func (r *BlockResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan BlockResourceModel
@@ -238,20 +306,25 @@ func (r *BlockResource) Create(ctx context.Context, req resource.CreateRequest,
 	// Here, we unmarshal the user-provided `data` JSON string to a map[string]interface{}
 	// because we'll later need to re-marshall the entire BlockDocumentCreate payload
 	// when sending it back up to the API
-	var data map[string]interface{}
-	resp.Diagnostics.Append(plan.Data.Unmarshal(&data)...)
+	data, diags := blockData(ctx, req.Config, plan)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	createdBlockDocument, err := blockDocumentClient.Create(ctx, api.BlockDocumentCreate{
+	payload := api.BlockDocumentCreate{
 		Name:          plan.Name.ValueString(),
 		Data:          data,
 		BlockSchemaID: latestBlockSchema.ID,
 		BlockTypeID:   latestBlockSchema.BlockTypeID,
-	})
+	}
+
+	createdBlockDocument, err := blockDocumentClient.Create(ctx, payload)
+	if shouldAdopt(r.client, plan.AdoptExisting, err) {
+		createdBlockDocument, err = adoptBlock(ctx, blockDocumentClient, plan.TypeSlug.ValueString(), payload)
+	}
 	if err != nil {
-		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Block Document", "create", err))
+		resp.Diagnostics.Append(createErrorDiagnostic("Block Document", err))
 
 		return
 	}
@@ -271,11 +344,42 @@ func (r *BlockResource) Create(ctx context.Context, req resource.CreateRequest,
 	// be a state conflict between the plan <> fetched value.
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IDIdentityModel{
+		AccountID:   plan.AccountID,
+		WorkspaceID: plan.WorkspaceID,
+		ID:          plan.ID,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 }
 
+// adoptBlock updates an existing block of the same type and name to match
+// the create payload, and returns it.
+func adoptBlock(ctx context.Context, client api.BlockDocumentClient, typeSlug string, payload api.BlockDocumentCreate) (*api.BlockDocument, error) {
+	block, err := client.GetByName(ctx, typeSlug, payload.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get existing block: %w", err)
+	}
+
+	err = client.Update(ctx, block.ID, api.BlockDocumentUpdate{
+		BlockSchemaID: payload.BlockSchemaID,
+		Data:          payload.Data,
+		// Replace the data of the existing block, as on Update().
+		MergeExistingData: false,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update existing block: %w", err)
+	}
+
+	block, err = client.GetWithoutSecrets(ctx, block.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get existing block: %w", err)
+	}
+
+	return block, nil
+}
+
 // Read refreshes the Terraform state with the latest data.
 func (r *BlockResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var state BlockResourceModel
@@ -308,8 +412,16 @@ func (r *BlockResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	block, err := client.Get(ctx, blockID)
+	// The Block's data is not persisted to state (see below), so there is no
+	// need to download the values of its secret fields.
+	block, err := client.GetWithoutSecrets(ctx, blockID)
 	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Block", "get", err))
 
 		return
@@ -329,8 +441,15 @@ func (r *BlockResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	// "inconsistent result after apply" errors. For now, we'll skip copying the
 	// retrieved Block's Data field and use what was specified in the plan.
 
+	state.setDefaults()
+
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IDIdentityModel{
+		AccountID:   state.AccountID,
+		WorkspaceID: state.WorkspaceID,
+		ID:          state.ID,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -364,8 +483,8 @@ func (r *BlockResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	var data map[string]interface{}
-	resp.Diagnostics.Append(plan.Data.Unmarshal(&data)...)
+	data, diags := blockData(ctx, req.Config, plan)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -387,14 +506,14 @@ func (r *BlockResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	block, err := blockDocumentClient.Get(ctx, blockID)
+	block, err := blockDocumentClient.GetWithoutSecrets(ctx, blockID)
 	if err != nil {
 		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Block", "get", err))
 
 		return
 	}
 
-	diags := copyBlockToModel(block, &plan)
+	diags = copyBlockToModel(block, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -410,6 +529,11 @@ func (r *BlockResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IDIdentityModel{
+		AccountID:   plan.AccountID,
+		WorkspaceID: plan.WorkspaceID,
+		ID:          plan.ID,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -424,6 +548,12 @@ func (r *BlockResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	shouldDelete, diags := state.shouldDelete(ctx, "Block")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || !shouldDelete {
+		return
+	}
+
 	blockDocumentClient, err := r.client.BlockDocuments(state.AccountID.ValueUUID(), state.WorkspaceID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Block Document", err))
@@ -452,6 +582,18 @@ func (r *BlockResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 // ImportState imports the resource into Terraform state.
+//
+// Allows input values in the form of:
+// - "id,workspace_id" or "id"
+// - a link to the block in the Prefect UI
+//
+// or the identity set in an import block.
 func (r *BlockResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if helpers.IsUIURL(req.ID) {
+		helpers.ImportStateFromUIURL(ctx, r.client, req, resp, helpers.UIObjectBlock, "id")
+
+		return
+	}
+
 	helpers.ImportStateByID(ctx, req, resp)
 }
@@ -2,9 +2,11 @@ package resources
 
 import (
 	"context"
+	"errors"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -14,6 +16,13 @@ import (
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
 )
 
+var (
+	_ = resource.ResourceWithConfigure(&BlockAccessResource{})
+	_ = resource.ResourceWithModifyPlan(&BlockAccessResource{})
+	_ = resource.ResourceWithImportState(&BlockAccessResource{})
+	_ = resource.ResourceWithIdentity(&BlockAccessResource{})
+)
+
 type BlockAccessResource struct {
 	client api.PrefectClient
 }
@@ -28,6 +37,13 @@ type BlockAccessResourceModel struct {
 	WorkspaceID    customtypes.UUIDValue `tfsdk:"workspace_id"`
 }
 
+// BlockAccessResourceIdentityModel defines the Terraform resource identity model.
+type BlockAccessResourceIdentityModel struct {
+	AccountID   customtypes.UUIDValue `tfsdk:"account_id"`
+	WorkspaceID customtypes.UUIDValue `tfsdk:"workspace_id"`
+	BlockID     customtypes.UUIDValue `tfsdk:"block_id"`
+}
+
 // NewBlockAccessResource returns a new BlockAccessResource.
 //
 //nolint:ireturn // required by Terraform API
@@ -38,6 +54,7 @@ func NewBlockAccessResource() resource.Resource {
 // Metadata returns the resource type name.
 func (r *BlockAccessResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_block_access"
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 // Configure initializes runtime state for the resource.
@@ -56,6 +73,16 @@ func (r *BlockAccessResource) Configure(_ context.Context, req resource.Configur
 	r.client = client
 }
 
+// ModifyPlan checks that the server supports the resource when it is created.
+// See helpers.CheckServerCapability.
+func (r *BlockAccessResource) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || !helpers.IsCreate(req) {
+		return
+	}
+
+	resp.Diagnostics.Append(helpers.CheckServerCapability(r.client.ServerInfo(), "The prefect_block_access resource", "", helpers.PlanPrefectCloudPro, helpers.PlanPrefectCloudEnterprise)...)
+}
+
 func (r *BlockAccessResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	defaultEmptyList, _ := basetypes.NewListValue(types.StringType, []attr.Value{})
 
@@ -118,6 +145,13 @@ Leave fields empty to use the default access controls
 		},
 	}
 }
+
+// IdentitySchema defines the identity schema for the resource.
+func (r *BlockAccessResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = workspaceIdentitySchema(map[string]identityschema.Attribute{
+		"block_id": uuidIdentityAttribute("Block ID (UUID)"),
+	})
+}
 func (r *BlockAccessResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan BlockAccessResourceModel
 
@@ -172,6 +206,11 @@ func (r *BlockAccessResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, BlockAccessResourceIdentityModel{
+		AccountID:   plan.AccountID,
+		WorkspaceID: plan.WorkspaceID,
+		BlockID:     plan.BlockID,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -193,6 +232,12 @@ func (r *BlockAccessResource) Read(ctx context.Context, req resource.ReadRequest
 
 	_, err = client.GetAccess(ctx, state.BlockID.ValueUUID())
 	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Block Document", "Get Access", err))
 
 		return
@@ -203,6 +248,11 @@ func (r *BlockAccessResource) Read(ctx context.Context, req resource.ReadRequest
 	// This is something to be revisited in the future.
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, BlockAccessResourceIdentityModel{
+		AccountID:   state.AccountID,
+		WorkspaceID: state.WorkspaceID,
+		BlockID:     state.BlockID,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -261,6 +311,11 @@ func (r *BlockAccessResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, BlockAccessResourceIdentityModel{
+		AccountID:   plan.AccountID,
+		WorkspaceID: plan.WorkspaceID,
+		BlockID:     plan.BlockID,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -298,3 +353,9 @@ func (r *BlockAccessResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 }
+
+// ImportState imports the resource into Terraform state from the identity
+// set in an import block.
+func (r *BlockAccessResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	helpers.ImportStateByIdentity(ctx, req, resp)
+}
@@ -0,0 +1,92 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var _ = list.ListResourceWithConfigure(&BlockResource{})
+
+// BlockListModel defines the configuration of the Block list resource.
+type BlockListModel struct {
+	WorkspaceListModel
+
+	TypeSlug types.String `tfsdk:"type_slug"`
+}
+
+// NewBlockListResource returns a new BlockResource as a list resource.
+//
+//nolint:ireturn // required by Terraform API
+func NewBlockListResource() list.ListResource {
+	return &BlockResource{}
+}
+
+// ListResourceConfigSchema defines the schema of the list resource configuration.
+func (r *BlockResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = workspaceListSchema("Lists the Blocks of a workspace, optionally only those of a block type.", map[string]listschema.Attribute{
+		"type_slug": listschema.StringAttribute{
+			Optional:    true,
+			Description: "Only list the Blocks of the block type with this slug",
+		},
+	})
+}
+
+// List streams the Blocks of a workspace.
+func (r *BlockResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config BlockListModel
+
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	client, err := r.client.BlockDocuments(config.AccountID.ValueUUID(), config.WorkspaceID.ValueUUID())
+	if err != nil {
+		diags.Append(helpers.CreateClientErrorDiagnostic("Block", err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	var typeSlugs []string
+	if !config.TypeSlug.IsNull() {
+		typeSlugs = []string{config.TypeSlug.ValueString()}
+	}
+
+	blocks := client.Iterate(ctx, typeSlugs, listOptions(req))
+
+	streamListResults(ctx, req, stream, "Block", blocks, func(block *api.BlockDocument, result *list.ListResult) {
+		result.DisplayName = block.Name
+
+		result.Diagnostics.Append(result.Identity.Set(ctx, IDIdentityModel{
+			AccountID:   config.AccountID,
+			WorkspaceID: config.WorkspaceID,
+			ID:          types.StringValue(block.ID.String()),
+		})...)
+		if result.Diagnostics.HasError() || !req.IncludeResource {
+			return
+		}
+
+		var model BlockResourceModel
+		result.Diagnostics.Append(getNullResource(ctx, result.Resource, &model)...)
+		if result.Diagnostics.HasError() {
+			return
+		}
+
+		model.AccountID = config.AccountID
+		model.WorkspaceID = config.WorkspaceID
+
+		result.Diagnostics.Append(copyBlockToModel(block, &model)...)
+		model.setDefaults()
+
+		result.Diagnostics.Append(result.Resource.Set(ctx, model)...)
+	})
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/prefecthq/terraform-provider-prefect/internal/api"
 	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
 )
@@ -169,8 +170,201 @@ func TestAccResource_block(t *testing.T) {
 	})
 }
 
+func fixtureAccBlockWriteOnly(cfg blockFixtureConfig, version int) string {
+	tmpl := `
+{{ .Config.Workspace }}
+
+resource "prefect_block" "{{ .Config.BlockName }}" {
+	name = "{{ .Config.BlockName }}"
+	type_slug = "secret"
+	data_wo = jsonencode({
+		"value" = "{{ .Config.BlockValue }}"
+	})
+	data_wo_version = {{ .Version }}
+	workspace_id = prefect_workspace.test.id
+	depends_on = [prefect_workspace.test]
+}`
+
+	return testutils.RenderTemplate(tmpl, struct {
+		Config  blockFixtureConfig
+		Version int
+	}{cfg, version})
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_block_write_only(t *testing.T) {
+	randomName := testutils.NewRandomPrefixedString()
+	randomValue := testutils.NewRandomPrefixedString()
+	randomValue2 := testutils.NewRandomPrefixedString()
+
+	workspace := testutils.NewEphemeralWorkspace()
+
+	blockResourceName := fmt.Sprintf("prefect_block.%s", randomName)
+
+	var blockDocument api.BlockDocument
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				// Check that the write-only data is sent, but not stored in state
+				Config: fixtureAccBlockWriteOnly(blockFixtureConfig{
+					Workspace:  workspace.Resource,
+					BlockName:  randomName,
+					BlockValue: randomValue,
+				}, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBlockExists(blockResourceName, &blockDocument),
+					testAccCheckBlockValues(&blockDocument, ExpectedBlockValues{
+						Name:     randomName,
+						TypeSlug: "secret",
+						Data:     fmt.Sprintf(`{"value":%q}`, randomValue),
+					}),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					testutils.ExpectKnownValueNull(blockResourceName, "data"),
+					testutils.ExpectKnownValueNull(blockResourceName, "data_wo"),
+					testutils.ExpectKnownValueNumber(blockResourceName, "data_wo_version", 1),
+				},
+			},
+			{
+				// Check that a changed value is not applied until the version changes
+				Config: fixtureAccBlockWriteOnly(blockFixtureConfig{
+					Workspace:  workspace.Resource,
+					BlockName:  randomName,
+					BlockValue: randomValue2,
+				}, 1),
+				PlanOnly: true,
+			},
+			{
+				// Check that incrementing the version applies the new value
+				Config: fixtureAccBlockWriteOnly(blockFixtureConfig{
+					Workspace:  workspace.Resource,
+					BlockName:  randomName,
+					BlockValue: randomValue2,
+				}, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBlockExists(blockResourceName, &blockDocument),
+					testAccCheckBlockValues(&blockDocument, ExpectedBlockValues{
+						Name:     randomName,
+						TypeSlug: "secret",
+						Data:     fmt.Sprintf(`{"value":%q}`, randomValue2),
+					}),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					testutils.ExpectKnownValueNull(blockResourceName, "data_wo"),
+					testutils.ExpectKnownValueNumber(blockResourceName, "data_wo_version", 2),
+				},
+			},
+		},
+	})
+}
+
 // testAccCheckBlockExists is a Custom Check Function that
 // verifies that the API object was created correctly.
+func fixtureAccBlockForget(cfg blockFixtureConfig) string {
+	tmpl := `
+{{ .Workspace }}
+
+removed {
+	from = prefect_block.{{ .BlockName }}
+
+	lifecycle {
+		destroy = false
+	}
+}
+`
+
+	return testutils.RenderTemplate(tmpl, cfg)
+}
+
+func fixtureAccBlockAdopt(cfg blockFixtureConfig) string {
+	tmpl := `
+{{ .Workspace }}
+
+resource "prefect_block" "adopted" {
+	name = "{{ .BlockName }}"
+	type_slug = "secret"
+	data = jsonencode({
+		"value" = "{{ .BlockValue }}"
+	})
+	workspace_id = prefect_workspace.test.id
+	adopt_existing = true
+}
+`
+
+	return testutils.RenderTemplate(tmpl, cfg)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_block_adopt_existing(t *testing.T) {
+	randomName := testutils.NewRandomPrefixedString()
+	randomValue := testutils.NewRandomPrefixedString()
+	randomValue2 := testutils.NewRandomPrefixedString()
+
+	workspace := testutils.NewEphemeralWorkspace()
+
+	resourceName := "prefect_block.adopted"
+
+	var created, adopted api.BlockDocument
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_7_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fixtureAccBlock(blockFixtureConfig{
+					Workspace:  workspace.Resource,
+					BlockName:  randomName,
+					BlockValue: randomValue,
+				}),
+				Check: testAccCheckBlockExists("prefect_block."+randomName, &created),
+			},
+			{
+				// Remove the block from state, leaving it in the workspace
+				Config: fixtureAccBlockForget(blockFixtureConfig{
+					Workspace: workspace.Resource,
+					BlockName: randomName,
+				}),
+			},
+			{
+				// Check that the existing block is adopted and updated, instead of failing
+				Config: fixtureAccBlockAdopt(blockFixtureConfig{
+					Workspace:  workspace.Resource,
+					BlockName:  randomName,
+					BlockValue: randomValue2,
+				}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBlockExists(resourceName, &adopted),
+					testAccCheckBlockValues(&adopted, ExpectedBlockValues{
+						Name:     randomName,
+						TypeSlug: "secret",
+						Data:     fmt.Sprintf(`{"value":%q}`, randomValue2),
+					}),
+					resource.TestCheckResourceAttrWith(resourceName, "id", func(id string) error {
+						if id != created.ID.String() {
+							return fmt.Errorf("expected the existing block %s to be adopted, got %s", created.ID, id)
+						}
+
+						return nil
+					}),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					testutils.ExpectKnownValue(resourceName, "name", randomName),
+					testutils.ExpectKnownValue(resourceName, "data", fmt.Sprintf(`{"value":%q}`, randomValue2)),
+				},
+			},
+		},
+	})
+}
+
 func testAccCheckBlockExists(blockResourceName string, blockDocument *api.BlockDocument) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Get the block resource we just created from the state
@@ -3,12 +3,16 @@ package resources
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -33,6 +37,8 @@ import (
 var (
 	_ = resource.ResourceWithConfigure(&DeploymentResource{})
 	_ = resource.ResourceWithImportState(&DeploymentResource{})
+	_ = resource.ResourceWithIdentity(&DeploymentResource{})
+	_ = resource.ResourceWithModifyPlan(&DeploymentResource{})
 )
 
 // DeploymentResource contains state for the resource.
@@ -42,6 +48,22 @@ type DeploymentResource struct {
 
 // DeploymentResourceModel defines the Terraform resource model.
 type DeploymentResourceModel struct {
+	DeploymentModel
+	DestroyModel
+
+	// Schedules are specific to the resource. They are null unless
+	// configured, in which case they are the complete set of schedules.
+	Schedules []DeploymentInlineScheduleModel `tfsdk:"schedules"`
+
+	// Triggers are specific to the resource. They are null unless configured,
+	// in which case they are the complete set of automations owned by the
+	// deployment.
+	Triggers []DeploymentTriggerModel `tfsdk:"triggers"`
+}
+
+// DeploymentModel defines the attributes of a deployment shared by the
+// resource and data source models.
+type DeploymentModel struct {
 	BaseModel
 
 	AccountID   customtypes.UUIDValue `tfsdk:"account_id"`
@@ -80,24 +102,35 @@ type PullStepModel struct {
 	// One of:
 	// - set_working_directory
 	// - git_clone
+	// - run_shell_script
+	// - pip_install_requirements
 	// - pull_from_azure_blob_storage
 	// - pull_from_gcs
 	// - pull_from_s3
+	// - custom
 	Type types.String `tfsdk:"type"`
 
+	// ID identifies the step, so that later steps can refer to its outputs.
+	// Used on all PullStep types, except custom.
+	ID types.String `tfsdk:"id"`
+
 	// Credentials is the credentials to use for the pull step.
-	// Used on all PullStep types.
+	// Used on all PullStep types, except set_working_directory and custom.
 	Credentials types.String `tfsdk:"credentials"`
 
 	// Requires is a list of Python package dependencies.
 	Requires types.String `tfsdk:"requires"`
 
 	//
-	// Fields for set_working_directory
+	// Fields for set_working_directory, run_shell_script and pip_install_requirements
 	//
 
 	Directory types.String `tfsdk:"directory"`
 
+	// StreamOutput is whether to stream the output of the step.
+	// Used on run_shell_script and pip_install_requirements.
+	StreamOutput types.Bool `tfsdk:"stream_output"`
+
 	//
 	// Fields for git_clone
 	//
@@ -114,6 +147,12 @@ type PullStepModel struct {
 	// IncludeSubmodules is whether to include submodules in the clone.
 	IncludeSubmodules types.Bool `tfsdk:"include_submodules"`
 
+	// The commit to check out.
+	CommitSha types.String `tfsdk:"commit_sha"`
+
+	// The directories to limit the clone to, with a sparse checkout.
+	Directories types.List `tfsdk:"directories"`
+
 	//
 	// Fields for pull_from_{cloud}
 	//
@@ -123,6 +162,36 @@ type PullStepModel struct {
 
 	// The folder in the bucket where files are stored.
 	Folder types.String `tfsdk:"folder"`
+
+	//
+	// Fields for run_shell_script
+	//
+
+	// The script to run.
+	Script types.String `tfsdk:"script"`
+
+	// Environment variables to set for the script.
+	Env types.Map `tfsdk:"env"`
+
+	// ExpandEnvVars is whether to expand environment variables in the script.
+	ExpandEnvVars types.Bool `tfsdk:"expand_env_vars"`
+
+	//
+	// Fields for pip_install_requirements
+	//
+
+	// The requirements file to install.
+	RequirementsFile types.String `tfsdk:"requirements_file"`
+
+	//
+	// Fields for custom
+	//
+
+	// The fully-qualified name of the function of the step.
+	Function types.String `tfsdk:"function"`
+
+	// The arguments of the function, as JSON.
+	Args jsontypes.Normalized `tfsdk:"args"`
 }
 
 // NewDeploymentResource returns a new DeploymentResource.
@@ -218,7 +287,7 @@ func (r *DeploymentResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Default:     booldefault.StaticBool(false),
 			},
 			"enforce_parameter_schema": schema.BoolAttribute{
-				Description: "Whether or not the deployment should enforce the parameter schema.",
+				Description: "Whether or not the deployment should enforce the parameter schema. When `true`, `parameters` are validated against `parameter_openapi_schema` when planning, and parameters that are not in the schema are rejected.",
 				Optional:    true,
 				Computed:    true,
 				Default:     booldefault.StaticBool(false),
@@ -238,7 +307,7 @@ func (r *DeploymentResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				},
 			},
 			"job_variables": schema.StringAttribute{
-				Description: "Overrides for the flow's infrastructure configuration.",
+				Description: "Overrides for the flow's infrastructure configuration. When planning, they are validated against the `variables` schema of the base job template of the work pool, if it exists.",
 				Optional:    true,
 				Computed:    true,
 				CustomType:  jsontypes.NormalizedType{},
@@ -349,15 +418,25 @@ func (r *DeploymentResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					types.ObjectType{
 						AttrTypes: map[string]attr.Type{
 							"type":               types.StringType,
+							"id":                 types.StringType,
 							"credentials":        types.StringType,
 							"requires":           types.StringType,
 							"directory":          types.StringType,
+							"stream_output":      types.BoolType,
 							"repository":         types.StringType,
 							"branch":             types.StringType,
 							"access_token":       types.StringType,
 							"bucket":             types.StringType,
 							"folder":             types.StringType,
 							"include_submodules": types.BoolType,
+							"commit_sha":         types.StringType,
+							"directories":        types.ListType{ElemType: types.StringType},
+							"script":             types.StringType,
+							"env":                types.MapType{ElemType: types.StringType},
+							"expand_env_vars":    types.BoolType,
+							"requirements_file":  types.StringType,
+							"function":           types.StringType,
+							"args":               jsontypes.NormalizedType{},
 						},
 					},
 					[]attr.Value{},
@@ -371,26 +450,39 @@ func (r *DeploymentResource) Schema(_ context.Context, _ resource.SchemaRequest,
 								stringvalidator.OneOf(
 									"set_working_directory",
 									"git_clone",
+									"run_shell_script",
+									"pip_install_requirements",
 									"pull_from_azure_blob_storage",
 									"pull_from_gcs",
 									"pull_from_s3",
+									"custom",
 								),
 							},
 						},
+						"id": schema.StringAttribute{
+							Description: "An identifier for the pull step, so that later steps can refer to its outputs, such as `{{ clone-step.directory }}`. Not used for type 'custom', which sets it in 'args' instead.",
+							Optional:    true,
+							Validators:  stringConflictsWithValidators(customAttributes),
+						},
 						"credentials": schema.StringAttribute{
-							Description: "Credentials to use for the pull step. Refer to a {GitHub,GitLab,BitBucket} credentials block.",
+							Description: "Credentials to use for the pull step. Refer to a {GitHub,GitLab,BitBucket} credentials block, such as `{{ prefect.blocks.github-credentials.my-block }}`.",
 							Optional:    true,
+							Validators:  stringConflictsWithValidators(customAttributes),
 						},
 						"requires": schema.StringAttribute{
 							Description: "A list of Python package dependencies.",
 							Optional:    true,
+							Validators:  stringConflictsWithValidators(customAttributes),
 						},
 						"directory": schema.StringAttribute{
-							Description: "(For type 'set_working_directory') The directory to set as the working directory.",
+							Description: "(For type 'set_working_directory') The directory to set as the working directory. (For types 'run_shell_script' and 'pip_install_requirements') The directory to run the step in.",
 							Optional:    true,
-							Validators: []validator.String{
-								stringvalidator.ConflictsWith(pathExpressionsForAttributes(nonDirectoryAttributes)...),
-							},
+							Validators:  stringConflictsWithValidators(nonDirectoryAttributes),
+						},
+						"stream_output": schema.BoolAttribute{
+							Description: "(For types 'run_shell_script' and 'pip_install_requirements') Whether to stream the output of the step.",
+							Optional:    true,
+							Validators:  boolConflictsWithValidators(nonStreamOutputAttributes),
 						},
 						"repository": schema.StringAttribute{
 							Description: "(For type 'git_clone') The URL of the repository to clone.",
@@ -412,6 +504,19 @@ func (r *DeploymentResource) Schema(_ context.Context, _ resource.SchemaRequest,
 							Optional:    true,
 							Validators:  boolConflictsWithValidators(nonGitCloneAttributes),
 						},
+						"commit_sha": schema.StringAttribute{
+							Description: "(For type 'git_clone') The commit to check out. If not provided, the latest commit of the branch is used.",
+							Optional:    true,
+							Validators:  stringConflictsWithValidators(nonGitCloneAttributes),
+						},
+						"directories": schema.ListAttribute{
+							Description: "(For type 'git_clone') The directories of the repository to clone, with a sparse checkout. If not provided, the whole repository is cloned.",
+							Optional:    true,
+							ElementType: types.StringType,
+							Validators: []validator.List{
+								listvalidator.ConflictsWith(pathExpressionsForAttributes(nonGitCloneAttributes)...),
+							},
+						},
 						"bucket": schema.StringAttribute{
 							Description: "(For type 'pull_from_*') The name of the bucket where files are stored.",
 							Optional:    true,
@@ -422,22 +527,68 @@ func (r *DeploymentResource) Schema(_ context.Context, _ resource.SchemaRequest,
 							Optional:    true,
 							Validators:  stringConflictsWithValidators(nonPullFromAttributes),
 						},
+						"script": schema.StringAttribute{
+							Description: "(For type 'run_shell_script', required) The script to run.",
+							Optional:    true,
+							Validators:  stringConflictsWithValidators(nonRunShellScriptAttributes),
+						},
+						"env": schema.MapAttribute{
+							Description: "(For type 'run_shell_script') Environment variables to set for the script.",
+							Optional:    true,
+							ElementType: types.StringType,
+							Validators: []validator.Map{
+								mapvalidator.ConflictsWith(pathExpressionsForAttributes(nonRunShellScriptAttributes)...),
+							},
+						},
+						"expand_env_vars": schema.BoolAttribute{
+							Description: "(For type 'run_shell_script') Whether to expand environment variables in the script.",
+							Optional:    true,
+							Validators:  boolConflictsWithValidators(nonRunShellScriptAttributes),
+						},
+						"requirements_file": schema.StringAttribute{
+							Description: "(For type 'pip_install_requirements') The requirements file to install. Defaults to `requirements.txt`.",
+							Optional:    true,
+							Validators:  stringConflictsWithValidators(nonPipInstallRequirementsAttributes),
+						},
+						"function": schema.StringAttribute{
+							Description: "(For type 'custom', required) The fully-qualified name of the function of the step, such as `my_collection.deployments.steps.pull_from_artifactory`. " +
+								"Built-in steps with arguments that their own type has no attributes for, such as ones added in newer Prefect versions, are read as type 'custom' as well.",
+							Optional:   true,
+							Validators: stringConflictsWithValidators(nonCustomAttributes),
+						},
+						"args": schema.StringAttribute{
+							Description: "(For type 'custom') The arguments of the function, as a JSON object.",
+							Optional:    true,
+							CustomType:  jsontypes.NormalizedType{},
+							Validators:  stringConflictsWithValidators(nonCustomAttributes),
+						},
 					},
 				},
 			},
+			"schedules":           deploymentInlineSchedulesAttribute(),
+			"triggers":            deploymentTriggersAttribute(),
+			"deletion_protection": deletionProtectionAttribute("deployment", "also deletes its schedules and scheduled flow runs"),
+			"on_destroy":          onDestroyAttribute("deployment"),
 		},
 	}
 }
 
-func mapPullStepsTerraformToAPI(tfPullSteps []PullStepModel) ([]api.PullStep, diag.Diagnostics) {
+// IdentitySchema defines the identity schema for the resource.
+func (r *DeploymentResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("Deployment ID (UUID)")
+}
+
+func mapPullStepsTerraformToAPI(ctx context.Context, tfPullSteps []PullStepModel) ([]api.PullStep, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	pullSteps := make([]api.PullStep, 0)
 
 	for i := range tfPullSteps {
 		tfPullStep := tfPullSteps[i]
+		stepPath := path.Root("pull_steps").AtListIndex(i)
 
 		pullStepCommon := api.PullStepCommon{
+			ID:          tfPullStep.ID.ValueStringPointer(),
 			Credentials: tfPullStep.Credentials.ValueStringPointer(),
 			Requires:    tfPullStep.Requires.ValueStringPointer(),
 		}
@@ -453,19 +604,64 @@ func mapPullStepsTerraformToAPI(tfPullSteps []PullStepModel) ([]api.PullStep, di
 		var apiPullStep api.PullStep
 		switch tfPullStep.Type.ValueString() {
 		case "git_clone":
+			var directories []string
+			if !tfPullStep.Directories.IsNull() {
+				directories = []string{}
+				diags.Append(tfPullStep.Directories.ElementsAs(ctx, &directories, false)...)
+			}
+
 			apiPullStep.PullStepGitClone = &api.PullStepGitClone{
 				PullStepCommon:    pullStepCommon,
 				Repository:        tfPullStep.Repository.ValueStringPointer(),
 				Branch:            tfPullStep.Branch.ValueStringPointer(),
 				AccessToken:       tfPullStep.AccessToken.ValueStringPointer(),
 				IncludeSubmodules: tfPullStep.IncludeSubmodules.ValueBoolPointer(),
+				CommitSha:         tfPullStep.CommitSha.ValueStringPointer(),
+				Directories:       directories,
 			}
 
 		case "set_working_directory":
+			if tfPullStep.Directory.IsNull() {
+				diags.AddAttributeError(stepPath.AtName("directory"), "Missing pull step attribute", "Pull steps of type 'set_working_directory' require 'directory'.")
+
+				continue
+			}
+
 			apiPullStep.PullStepSetWorkingDirectory = &api.PullStepSetWorkingDirectory{
+				ID:        tfPullStep.ID.ValueStringPointer(),
 				Directory: tfPullStep.Directory.ValueStringPointer(),
 			}
 
+		case "run_shell_script":
+			if tfPullStep.Script.IsNull() {
+				diags.AddAttributeError(stepPath.AtName("script"), "Missing pull step attribute", "Pull steps of type 'run_shell_script' require 'script'.")
+
+				continue
+			}
+
+			var env map[string]string
+			if !tfPullStep.Env.IsNull() {
+				env = map[string]string{}
+				diags.Append(tfPullStep.Env.ElementsAs(ctx, &env, false)...)
+			}
+
+			apiPullStep.PullStepRunShellScript = &api.PullStepRunShellScript{
+				PullStepCommon: pullStepCommon,
+				Script:         tfPullStep.Script.ValueStringPointer(),
+				Directory:      tfPullStep.Directory.ValueStringPointer(),
+				Env:            env,
+				StreamOutput:   tfPullStep.StreamOutput.ValueBoolPointer(),
+				ExpandEnvVars:  tfPullStep.ExpandEnvVars.ValueBoolPointer(),
+			}
+
+		case "pip_install_requirements":
+			apiPullStep.PullStepPipInstallRequirements = &api.PullStepPipInstallRequirements{
+				PullStepCommon:   pullStepCommon,
+				RequirementsFile: tfPullStep.RequirementsFile.ValueStringPointer(),
+				Directory:        tfPullStep.Directory.ValueStringPointer(),
+				StreamOutput:     tfPullStep.StreamOutput.ValueBoolPointer(),
+			}
+
 		case "pull_from_azure_blob_storage":
 			apiPullStep.PullStepPullFromAzureBlobStorage = &pullStepPullFrom
 
@@ -474,6 +670,37 @@ func mapPullStepsTerraformToAPI(tfPullSteps []PullStepModel) ([]api.PullStep, di
 
 		case "pull_from_s3":
 			apiPullStep.PullStepPullFromS3 = &pullStepPullFrom
+
+		case "custom":
+			function := tfPullStep.Function.ValueString()
+			if function == "" {
+				diags.AddAttributeError(stepPath.AtName("function"), "Missing pull step attribute", "Pull steps of type 'custom' require 'function'.")
+
+				continue
+			}
+
+			args := map[string]any{}
+			if !tfPullStep.Args.IsNull() {
+				diags.Append(tfPullStep.Args.Unmarshal(&args)...)
+			}
+
+			// Built-in steps are decoded into their own type when read, unless
+			// they have other arguments, so they must be configured with that
+			// type to avoid a diff.
+			if api.IsBuiltInPullStep(function, args) {
+				diags.AddAttributeError(
+					stepPath.AtName("function"),
+					"Invalid pull step function",
+					fmt.Sprintf("Function %q is a built-in pull step, and its own pull step type has attributes for all of its arguments. Use that type instead of 'custom'.", function),
+				)
+
+				continue
+			}
+
+			apiPullStep.PullStepCustom = &api.PullStepCustom{
+				Function: function,
+				Args:     args,
+			}
 		}
 
 		pullSteps = append(pullSteps, apiPullStep)
@@ -482,7 +709,11 @@ func mapPullStepsTerraformToAPI(tfPullSteps []PullStepModel) ([]api.PullStep, di
 	return pullSteps, diags
 }
 
-func mapPullStepsAPIToTerraform(pullSteps []api.PullStep) ([]PullStepModel, diag.Diagnostics) {
+// mapPullStepsAPIToTerraform maps the pull steps of a deployment to their models.
+// Custom steps are always sent with their `args`, so empty `args` are mapped to
+// the form of those of the prior pull step at the same position; see
+// helpers.MarshalOptional.
+func mapPullStepsAPIToTerraform(ctx context.Context, pullSteps []api.PullStep, prior []PullStepModel) ([]PullStepModel, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	tfPullStepsModel := make([]PullStepModel, 0)
@@ -490,7 +721,11 @@ func mapPullStepsAPIToTerraform(pullSteps []api.PullStep) ([]PullStepModel, diag
 	for i := range pullSteps {
 		pullStep := pullSteps[i]
 
-		var pullStepModel PullStepModel
+		pullStepModel := PullStepModel{
+			Directories: types.ListNull(types.StringType),
+			Env:         types.MapNull(types.StringType),
+			Args:        jsontypes.NewNormalizedNull(),
+		}
 
 		// PullStepGitClone
 		if pullStep.PullStepGitClone != nil {
@@ -499,8 +734,16 @@ func mapPullStepsAPIToTerraform(pullSteps []api.PullStep) ([]PullStepModel, diag
 			pullStepModel.Branch = types.StringPointerValue(pullStep.PullStepGitClone.Branch)
 			pullStepModel.AccessToken = types.StringPointerValue(pullStep.PullStepGitClone.AccessToken)
 			pullStepModel.IncludeSubmodules = types.BoolPointerValue(pullStep.PullStepGitClone.IncludeSubmodules)
+			pullStepModel.CommitSha = types.StringPointerValue(pullStep.PullStepGitClone.CommitSha)
+
+			if pullStep.PullStepGitClone.Directories != nil {
+				directories, directoriesDiags := types.ListValueFrom(ctx, types.StringType, pullStep.PullStepGitClone.Directories)
+				diags.Append(directoriesDiags...)
+				pullStepModel.Directories = directories
+			}
 
 			// common fields
+			pullStepModel.ID = types.StringPointerValue(pullStep.PullStepGitClone.ID)
 			pullStepModel.Credentials = types.StringPointerValue(pullStep.PullStepGitClone.Credentials)
 			pullStepModel.Requires = types.StringPointerValue(pullStep.PullStepGitClone.Requires)
 		}
@@ -508,9 +751,43 @@ func mapPullStepsAPIToTerraform(pullSteps []api.PullStep) ([]PullStepModel, diag
 		// PullStepSetWorkingDirectory
 		if pullStep.PullStepSetWorkingDirectory != nil {
 			pullStepModel.Type = types.StringValue("set_working_directory")
-			pullStepModel.Directory = types.StringValue(*pullStep.PullStepSetWorkingDirectory.Directory)
+			pullStepModel.ID = types.StringPointerValue(pullStep.PullStepSetWorkingDirectory.ID)
+			pullStepModel.Directory = types.StringPointerValue(pullStep.PullStepSetWorkingDirectory.Directory)
+
+			// other common fields not used on this pull step type
+		}
+
+		// PullStepRunShellScript
+		if pullStep.PullStepRunShellScript != nil {
+			pullStepModel.Type = types.StringValue("run_shell_script")
+			pullStepModel.Script = types.StringPointerValue(pullStep.PullStepRunShellScript.Script)
+			pullStepModel.Directory = types.StringPointerValue(pullStep.PullStepRunShellScript.Directory)
+			pullStepModel.StreamOutput = types.BoolPointerValue(pullStep.PullStepRunShellScript.StreamOutput)
+			pullStepModel.ExpandEnvVars = types.BoolPointerValue(pullStep.PullStepRunShellScript.ExpandEnvVars)
+
+			if pullStep.PullStepRunShellScript.Env != nil {
+				env, envDiags := types.MapValueFrom(ctx, types.StringType, pullStep.PullStepRunShellScript.Env)
+				diags.Append(envDiags...)
+				pullStepModel.Env = env
+			}
+
+			// common fields
+			pullStepModel.ID = types.StringPointerValue(pullStep.PullStepRunShellScript.ID)
+			pullStepModel.Credentials = types.StringPointerValue(pullStep.PullStepRunShellScript.Credentials)
+			pullStepModel.Requires = types.StringPointerValue(pullStep.PullStepRunShellScript.Requires)
+		}
+
+		// PullStepPipInstallRequirements
+		if pullStep.PullStepPipInstallRequirements != nil {
+			pullStepModel.Type = types.StringValue("pip_install_requirements")
+			pullStepModel.RequirementsFile = types.StringPointerValue(pullStep.PullStepPipInstallRequirements.RequirementsFile)
+			pullStepModel.Directory = types.StringPointerValue(pullStep.PullStepPipInstallRequirements.Directory)
+			pullStepModel.StreamOutput = types.BoolPointerValue(pullStep.PullStepPipInstallRequirements.StreamOutput)
 
-			// common fields not used on this pull step type
+			// common fields
+			pullStepModel.ID = types.StringPointerValue(pullStep.PullStepPipInstallRequirements.ID)
+			pullStepModel.Credentials = types.StringPointerValue(pullStep.PullStepPipInstallRequirements.Credentials)
+			pullStepModel.Requires = types.StringPointerValue(pullStep.PullStepPipInstallRequirements.Requires)
 		}
 
 		// PullStepPullFromAzureBlobStorage
@@ -520,6 +797,7 @@ func mapPullStepsAPIToTerraform(pullSteps []api.PullStep) ([]PullStepModel, diag
 			pullStepModel.Folder = types.StringPointerValue(pullStep.PullStepPullFromAzureBlobStorage.Folder)
 
 			// common fields
+			pullStepModel.ID = types.StringPointerValue(pullStep.PullStepPullFromAzureBlobStorage.ID)
 			pullStepModel.Credentials = types.StringPointerValue(pullStep.PullStepPullFromAzureBlobStorage.Credentials)
 			pullStepModel.Requires = types.StringPointerValue(pullStep.PullStepPullFromAzureBlobStorage.Requires)
 		}
@@ -531,6 +809,7 @@ func mapPullStepsAPIToTerraform(pullSteps []api.PullStep) ([]PullStepModel, diag
 			pullStepModel.Folder = types.StringPointerValue(pullStep.PullStepPullFromGCS.Folder)
 
 			// common fields
+			pullStepModel.ID = types.StringPointerValue(pullStep.PullStepPullFromGCS.ID)
 			pullStepModel.Credentials = types.StringPointerValue(pullStep.PullStepPullFromGCS.Credentials)
 			pullStepModel.Requires = types.StringPointerValue(pullStep.PullStepPullFromGCS.Requires)
 		}
@@ -542,10 +821,29 @@ func mapPullStepsAPIToTerraform(pullSteps []api.PullStep) ([]PullStepModel, diag
 			pullStepModel.Folder = types.StringPointerValue(pullStep.PullStepPullFromS3.Folder)
 
 			// common fields
+			pullStepModel.ID = types.StringPointerValue(pullStep.PullStepPullFromS3.ID)
 			pullStepModel.Credentials = types.StringPointerValue(pullStep.PullStepPullFromS3.Credentials)
 			pullStepModel.Requires = types.StringPointerValue(pullStep.PullStepPullFromS3.Requires)
 		}
 
+		// PullStepCustom
+		if pullStep.PullStepCustom != nil {
+			pullStepModel.Type = types.StringValue("custom")
+			pullStepModel.Function = types.StringValue(pullStep.PullStepCustom.Function)
+
+			priorArgs := jsontypes.NewNormalizedNull()
+			if i < len(prior) {
+				priorArgs = prior[i].Args
+			}
+
+			args, err := helpers.MarshalOptional(pullStep.PullStepCustom.Args, priorArgs)
+			if err != nil {
+				diags.Append(helpers.SerializeDataErrorDiagnostic("pull_steps", "Pull step arguments", err))
+			}
+
+			pullStepModel.Args = args
+		}
+
 		tfPullStepsModel = append(tfPullStepsModel, pullStepModel)
 	}
 
@@ -590,7 +888,7 @@ func CopyDeploymentToModel(ctx context.Context, deployment *api.Deployment, mode
 		}
 	}
 
-	pullSteps, diags := mapPullStepsAPIToTerraform(deployment.PullSteps)
+	pullSteps, diags := mapPullStepsAPIToTerraform(ctx, deployment.PullSteps, model.PullSteps)
 	diags.Append(diags...)
 	if diags.HasError() {
 		return diags
@@ -667,7 +965,7 @@ func (r *DeploymentResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	pullSteps, diags := mapPullStepsTerraformToAPI(plan.PullSteps)
+	pullSteps, diags := mapPullStepsTerraformToAPI(ctx, plan.PullSteps)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -715,7 +1013,28 @@ func (r *DeploymentResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	if plan.Schedules != nil {
+		plan.Schedules, diags = r.reconcileSchedules(ctx, &plan, deployment.ID, nil)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if plan.Triggers != nil {
+		plan.Triggers, diags = r.reconcileTriggers(ctx, &plan, deployment, nil)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IDIdentityModel{
+		AccountID:   plan.AccountID,
+		WorkspaceID: plan.WorkspaceID,
+		ID:          plan.ID,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -759,6 +1078,12 @@ func (r *DeploymentResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			"Error refreshing deployment state",
 			fmt.Sprintf("Could not read Deployment, unexpected error: %s", err.Error()),
@@ -772,7 +1097,50 @@ func (r *DeploymentResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	model.setDefaults()
+
+	// Schedules are only read when they are managed by the resource.
+	if model.Schedules != nil {
+		scheduleClient, err := r.client.DeploymentSchedule(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+		if err != nil {
+			resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Deployment Schedule", err))
+
+			return
+		}
+
+		schedules, diags := readDeploymentSchedules(ctx, scheduleClient, deployment.ID, model.Schedules)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		model.Schedules = schedules
+	}
+
+	// Triggers are only read when they are managed by the resource.
+	if model.Triggers != nil {
+		automationClient, err := r.client.Automations(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+		if err != nil {
+			resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Automation", err))
+
+			return
+		}
+
+		triggers, diags := readDeploymentTriggers(ctx, automationClient, deployment.ID, model.Triggers)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		model.Triggers = triggers
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IDIdentityModel{
+		AccountID:   model.AccountID,
+		WorkspaceID: model.WorkspaceID,
+		ID:          model.ID,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -882,12 +1250,79 @@ func (r *DeploymentResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	// Schedules that are no longer managed by the resource are left as they are.
+	if model.Schedules != nil {
+		var prior []DeploymentInlineScheduleModel
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("schedules"), &prior)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		model.Schedules, diags = r.reconcileSchedules(ctx, &model, deploymentID, prior)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Likewise, automations that are no longer managed by the resource are
+	// left as they are.
+	if model.Triggers != nil {
+		var prior []DeploymentTriggerModel
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("triggers"), &prior)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		model.Triggers, diags = r.reconcileTriggers(ctx, &model, deployment, prior)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IDIdentityModel{
+		AccountID:   model.AccountID,
+		WorkspaceID: model.WorkspaceID,
+		ID:          model.ID,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 }
 
+// reconcileSchedules makes the schedules of the deployment match the planned ones.
+func (r *DeploymentResource) reconcileSchedules(
+	ctx context.Context,
+	plan *DeploymentResourceModel,
+	deploymentID uuid.UUID,
+	prior []DeploymentInlineScheduleModel,
+) ([]DeploymentInlineScheduleModel, diag.Diagnostics) {
+	client, err := r.client.DeploymentSchedule(plan.AccountID.ValueUUID(), plan.WorkspaceID.ValueUUID())
+	if err != nil {
+		return nil, diag.Diagnostics{helpers.CreateClientErrorDiagnostic("Deployment Schedule", err)}
+	}
+
+	return reconcileDeploymentSchedules(ctx, client, deploymentID, plan.Schedules, prior)
+}
+
+// reconcileTriggers makes the automations owned by the deployment match the
+// planned triggers.
+func (r *DeploymentResource) reconcileTriggers(
+	ctx context.Context,
+	plan *DeploymentResourceModel,
+	deployment *api.Deployment,
+	prior []DeploymentTriggerModel,
+) ([]DeploymentTriggerModel, diag.Diagnostics) {
+	client, err := r.client.Automations(plan.AccountID.ValueUUID(), plan.WorkspaceID.ValueUUID())
+	if err != nil {
+		return nil, diag.Diagnostics{helpers.CreateClientErrorDiagnostic("Automation", err)}
+	}
+
+	return reconcileDeploymentTriggers(ctx, client, deployment, plan.Triggers, prior)
+}
+
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *DeploymentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var state DeploymentResourceModel
@@ -897,6 +1332,12 @@ func (r *DeploymentResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	shouldDelete, diags := state.shouldDelete(ctx, "Deployment")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || !shouldDelete {
+		return
+	}
+
 	client, err := r.client.Deployments(state.AccountID.ValueUUID(), state.WorkspaceID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -916,6 +1357,24 @@ func (r *DeploymentResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	// Automations owned by the deployment are deleted first, as they would
+	// otherwise be left behind, running a deployment that no longer exists.
+	if state.Triggers != nil {
+		automationClient, err := r.client.Automations(state.AccountID.ValueUUID(), state.WorkspaceID.ValueUUID())
+		if err != nil {
+			resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Automation", err))
+
+			return
+		}
+
+		err = automationClient.DeleteOwnedBy(ctx, deploymentOwnerResource(deploymentID))
+		if err != nil && !errors.Is(err, api.ErrNotFound) {
+			resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Automation", "delete", err))
+
+			return
+		}
+	}
+
 	err = client.Delete(ctx, deploymentID)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -933,8 +1392,50 @@ func (r *DeploymentResource) Delete(ctx context.Context, req resource.DeleteRequ
 }
 
 // ImportState imports the resource into Terraform state.
+//
+// Allows input values in the form of:
+// - "id,workspace_id" or "id"
+// - a link to the deployment in the Prefect UI
+// - "workspace_handle/flow_name/deployment_name" or "flow_name/deployment_name"
+//
+// or the identity set in an import block.
 func (r *DeploymentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStateByID(ctx, req, resp)
+	switch {
+	case helpers.IsUIURL(req.ID):
+		helpers.ImportStateFromUIURL(ctx, r.client, req, resp, helpers.UIObjectDeployment, "id")
+	case isImportPath(req.ID):
+		r.importStateByPath(ctx, req, resp)
+	default:
+		helpers.ImportStateByID(ctx, req, resp)
+	}
+}
+
+// importStateByPath imports the deployment with the flow and deployment
+// names of the import path, in the workspace of its handle, if any.
+func (r *DeploymentResource) importStateByPath(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	workspaceID, names, diags := splitImportPath(ctx, r.client, req, "flow_name/deployment_name")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.Deployments(uuid.Nil, workspaceID)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Deployment", err))
+
+		return
+	}
+
+	deployment, err := client.GetByName(ctx, names[0], names[1])
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Deployment", "get", err))
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), deployment.ID.String())...)
+
+	setImportPathWorkspaceID(ctx, r.client, resp, workspaceID)
 }
 
 // pathExpressionsForAttributes provides a list of path expressions
@@ -984,6 +1485,8 @@ var (
 		"branch",
 		"access_token",
 		"include_submodules",
+		"commit_sha",
+		"directories",
 	}
 
 	pullFromAttributes = []string{
@@ -991,7 +1494,33 @@ var (
 		"folder",
 	}
 
-	nonDirectoryAttributes = append(gitCloneAttributes, pullFromAttributes...)
-	nonGitCloneAttributes  = append(directoryAttributes, pullFromAttributes...)
-	nonPullFromAttributes  = append(directoryAttributes, gitCloneAttributes...)
+	runShellScriptAttributes = []string{
+		"script",
+		"env",
+		"expand_env_vars",
+	}
+
+	pipInstallRequirementsAttributes = []string{
+		"requirements_file",
+	}
+
+	// streamOutputAttributes are shared by run_shell_script and pip_install_requirements.
+	streamOutputAttributes = []string{
+		"stream_output",
+	}
+
+	// customAttributes conflict with all other attributes, as custom steps
+	// set them in their arguments instead.
+	customAttributes = []string{
+		"function",
+		"args",
+	}
+
+	nonDirectoryAttributes              = slices.Concat(gitCloneAttributes, pullFromAttributes, customAttributes)
+	nonStreamOutputAttributes           = slices.Concat(gitCloneAttributes, pullFromAttributes, customAttributes)
+	nonGitCloneAttributes               = slices.Concat(directoryAttributes, streamOutputAttributes, pullFromAttributes, runShellScriptAttributes, pipInstallRequirementsAttributes, customAttributes)
+	nonPullFromAttributes               = slices.Concat(directoryAttributes, streamOutputAttributes, gitCloneAttributes, runShellScriptAttributes, pipInstallRequirementsAttributes, customAttributes)
+	nonRunShellScriptAttributes         = slices.Concat(gitCloneAttributes, pullFromAttributes, pipInstallRequirementsAttributes, customAttributes)
+	nonPipInstallRequirementsAttributes = slices.Concat(gitCloneAttributes, pullFromAttributes, runShellScriptAttributes, customAttributes)
+	nonCustomAttributes                 = slices.Concat([]string{"id", "credentials", "requires"}, directoryAttributes, streamOutputAttributes, gitCloneAttributes, pullFromAttributes, runShellScriptAttributes, pipInstallRequirementsAttributes)
 )
@@ -2,9 +2,11 @@ package resources
 
 import (
 	"context"
+	"errors"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -14,7 +16,12 @@ import (
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
 )
 
-var _ = resource.ResourceWithConfigure(&DeploymentAccessResource{})
+var (
+	_ = resource.ResourceWithConfigure(&DeploymentAccessResource{})
+	_ = resource.ResourceWithModifyPlan(&DeploymentAccessResource{})
+	_ = resource.ResourceWithImportState(&DeploymentAccessResource{})
+	_ = resource.ResourceWithIdentity(&DeploymentAccessResource{})
+)
 
 type DeploymentAccessResource struct {
 	client api.PrefectClient
@@ -34,6 +41,13 @@ type DeploymentAccessResourceModel struct {
 	ViewTeamIDs    types.List `tfsdk:"view_team_ids"`
 }
 
+// DeploymentAccessResourceIdentityModel defines the Terraform resource identity model.
+type DeploymentAccessResourceIdentityModel struct {
+	AccountID    customtypes.UUIDValue `tfsdk:"account_id"`
+	WorkspaceID  customtypes.UUIDValue `tfsdk:"workspace_id"`
+	DeploymentID customtypes.UUIDValue `tfsdk:"deployment_id"`
+}
+
 // NewDeploymentAccessResource returns a new DeploymentAccessResource.
 //
 //nolint:ireturn // required by Terraform API
@@ -44,6 +58,7 @@ func NewDeploymentAccessResource() resource.Resource {
 // Metadata returns the resource type name.
 func (r *DeploymentAccessResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_deployment_access"
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 // Configure initializes runtime state for the resource.
@@ -62,6 +77,16 @@ func (r *DeploymentAccessResource) Configure(_ context.Context, req resource.Con
 	r.client = client
 }
 
+// ModifyPlan checks that the server supports the resource when it is created.
+// See helpers.CheckServerCapability.
+func (r *DeploymentAccessResource) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || !helpers.IsCreate(req) {
+		return
+	}
+
+	resp.Diagnostics.Append(helpers.CheckServerCapability(r.client.ServerInfo(), "The prefect_deployment_access resource", "", helpers.PlanPrefectCloudPro, helpers.PlanPrefectCloudEnterprise)...)
+}
+
 func (r *DeploymentAccessResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	defaultEmptyList, _ := basetypes.NewListValue(types.StringType, []attr.Value{})
 
@@ -137,6 +162,13 @@ func (r *DeploymentAccessResource) Schema(_ context.Context, _ resource.SchemaRe
 	}
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (r *DeploymentAccessResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = workspaceIdentitySchema(map[string]identityschema.Attribute{
+		"deployment_id": uuidIdentityAttribute("Deployment ID (UUID)"),
+	})
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *DeploymentAccessResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan DeploymentAccessResourceModel
@@ -207,6 +239,11 @@ func (r *DeploymentAccessResource) Create(ctx context.Context, req resource.Crea
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, DeploymentAccessResourceIdentityModel{
+		AccountID:    plan.AccountID,
+		WorkspaceID:  plan.WorkspaceID,
+		DeploymentID: plan.DeploymentID,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -229,6 +266,12 @@ func (r *DeploymentAccessResource) Read(ctx context.Context, req resource.ReadRe
 
 	_, err = client.Read(ctx, state.DeploymentID.ValueUUID())
 	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Deployment Access", "read", err))
 
 		return
@@ -239,6 +282,11 @@ func (r *DeploymentAccessResource) Read(ctx context.Context, req resource.ReadRe
 	// This is something to be revisited in the future.
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, DeploymentAccessResourceIdentityModel{
+		AccountID:    state.AccountID,
+		WorkspaceID:  state.WorkspaceID,
+		DeploymentID: state.DeploymentID,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -312,6 +360,11 @@ func (r *DeploymentAccessResource) Update(ctx context.Context, req resource.Upda
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, DeploymentAccessResourceIdentityModel{
+		AccountID:    plan.AccountID,
+		WorkspaceID:  plan.WorkspaceID,
+		DeploymentID: plan.DeploymentID,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -352,3 +405,9 @@ func (r *DeploymentAccessResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 }
+
+// ImportState imports the resource into Terraform state from the identity
+// set in an import block.
+func (r *DeploymentAccessResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	helpers.ImportStateByIdentity(ctx, req, resp)
+}
@@ -0,0 +1,480 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+// DeploymentInlineScheduleModel represents a schedule set in the `schedules`
+// attribute of a deployment.
+type DeploymentInlineScheduleModel struct {
+	ID   customtypes.UUIDValue `tfsdk:"id"`
+	Slug types.String          `tfsdk:"slug"`
+
+	Active           types.Bool           `tfsdk:"active"`
+	Parameters       jsontypes.Normalized `tfsdk:"parameters"`
+	MaxScheduledRuns types.Float32        `tfsdk:"max_scheduled_runs"`
+
+	// Cloud-only
+	MaxActiveRuns types.Float32 `tfsdk:"max_active_runs"`
+	Catchup       types.Bool    `tfsdk:"catchup"`
+
+	// All schedule kinds specify a timezone.
+	Timezone types.String `tfsdk:"timezone"`
+
+	// Schedule kind: interval
+	Interval   types.Float32 `tfsdk:"interval"`
+	AnchorDate types.String  `tfsdk:"anchor_date"`
+
+	// Schedule kind: cron
+	Cron  types.String `tfsdk:"cron"`
+	DayOr types.Bool   `tfsdk:"day_or"`
+
+	// Schedule kind: rrule
+	RRule types.String `tfsdk:"rrule"`
+}
+
+// scheduleKindAttributes are the attributes that define the kind of a schedule.
+var scheduleKindAttributes = []string{"interval", "cron", "rrule"}
+
+// deploymentInlineSchedulesAttribute returns the schema of the `schedules`
+// attribute of the deployment resource.
+func deploymentInlineSchedulesAttribute() schema.ListNestedAttribute {
+	kindExpressions := pathExpressionsForAttributes(scheduleKindAttributes)
+
+	return schema.ListNestedAttribute{
+		Description: "The complete set of schedules of the deployment. When set, schedules that are not listed, " +
+			"such as ones added in the UI, are deleted on apply, and detected as drift on refresh. " +
+			"Existing schedules are matched to the listed ones by `slug`, or else by position. " +
+			"Leave unset to manage schedules with `prefect_deployment_schedule` resources instead, which cannot be combined with this attribute.",
+		Optional: true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					Computed:    true,
+					CustomType:  customtypes.UUIDType{},
+					Description: "Deployment Schedule ID (UUID)",
+				},
+				"slug": schema.StringAttribute{
+					Optional:    true,
+					Description: "A slug identifying the schedule within the deployment, used to match it to an existing schedule.",
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+				},
+				"active": schema.BoolAttribute{
+					Optional:    true,
+					Computed:    true,
+					Default:     booldefault.StaticBool(true),
+					Description: "Whether or not the schedule is active.",
+				},
+				"parameters": schema.StringAttribute{
+					Optional:    true,
+					CustomType:  jsontypes.NormalizedType{},
+					Description: "Parameters for the flow runs of the schedule, as a JSON object. Overrides the parameters of the deployment.",
+				},
+				"max_scheduled_runs": schema.Float32Attribute{
+					Optional:    true,
+					Description: "The maximum number of scheduled runs for the schedule.",
+					Validators: []validator.Float32{
+						float32validator.AtLeast(1),
+					},
+				},
+				"max_active_runs": schema.Float32Attribute{
+					Optional:    true,
+					Description: "(Cloud only) The maximum number of active runs for the schedule.",
+					Validators: []validator.Float32{
+						float32validator.AtLeast(1),
+					},
+				},
+				"catchup": schema.BoolAttribute{
+					Optional:    true,
+					Computed:    true,
+					Default:     booldefault.StaticBool(false),
+					Description: "(Cloud only) Whether or not a worker should catch up on Late runs for the schedule.",
+				},
+				"timezone": schema.StringAttribute{
+					Optional:    true,
+					Computed:    true,
+					Description: "The timezone of the schedule, as an IANA timezone name, such as `America/New_York`.",
+					Validators: []validator.String{
+						timezoneValidator(),
+					},
+				},
+				"interval": schema.Float32Attribute{
+					Optional:    true,
+					Description: "The interval of the schedule, in seconds.",
+					Validators: []validator.Float32{
+						float32validator.ExactlyOneOf(kindExpressions...),
+					},
+				},
+				"anchor_date": schema.StringAttribute{
+					Optional:    true,
+					Computed:    true,
+					Description: "The anchor date of an interval schedule, as an ISO 8601 timestamp, such as `2024-01-01T00:00:00Z`.",
+					Validators: []validator.String{
+						anchorDateValidator(),
+					},
+				},
+				"cron": schema.StringAttribute{
+					Optional:    true,
+					Description: "The cron expression of the schedule.",
+					Validators: []validator.String{
+						cronValidator(),
+					},
+				},
+				"day_or": schema.BoolAttribute{
+					Optional:    true,
+					Computed:    true,
+					Description: "Control croniter behavior for handling day and day_of_week entries of a cron schedule.",
+				},
+				"rrule": schema.StringAttribute{
+					Optional:    true,
+					Description: "The rrule expression of the schedule.",
+					Validators: []validator.String{
+						rruleValidator(),
+					},
+				},
+			},
+		},
+	}
+}
+
+// schedulePayload returns the API payload for a schedule. Parameters are always
+// set, so that removing them from the configuration clears them on update.
+func (m *DeploymentInlineScheduleModel) schedulePayload() (api.DeploymentSchedulePayload, diag.Diagnostics) {
+	parameters, diags := helpers.UnmarshalOptional(m.Parameters)
+
+	return api.DeploymentSchedulePayload{
+		Active:           m.Active.ValueBoolPointer(),
+		Slug:             m.Slug.ValueStringPointer(),
+		Parameters:       parameters,
+		Catchup:          m.Catchup.ValueBool(),
+		MaxActiveRuns:    m.MaxActiveRuns.ValueFloat32(),
+		MaxScheduledRuns: m.MaxScheduledRuns.ValueFloat32(),
+		Schedule: api.Schedule{
+			AnchorDate: m.AnchorDate.ValueString(),
+			Cron:       m.Cron.ValueString(),
+			DayOr:      m.DayOr.ValueBool(),
+			Interval:   m.Interval.ValueFloat32(),
+			RRule:      m.RRule.ValueString(),
+			Timezone:   m.Timezone.ValueString(),
+		},
+	}, diags
+}
+
+// copyScheduleToInlineModel copies an api.DeploymentSchedule to a
+// DeploymentInlineScheduleModel. Unset values are mapped to null, as the
+// optional attributes of the model have no default. Empty parameters are
+// mapped to the form of the prior parameters; see helpers.MarshalOptional.
+func copyScheduleToInlineModel(schedule *api.DeploymentSchedule, priorParameters jsontypes.Normalized) (DeploymentInlineScheduleModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	model := DeploymentInlineScheduleModel{
+		ID:               customtypes.NewUUIDValue(schedule.ID),
+		Slug:             types.StringNull(),
+		Active:           types.BoolValue(schedule.Active == nil || *schedule.Active),
+		MaxScheduledRuns: float32OrNull(schedule.MaxScheduledRuns),
+		MaxActiveRuns:    float32OrNull(schedule.MaxActiveRuns),
+		Catchup:          types.BoolValue(schedule.Catchup),
+		Timezone:         stringOrNull(schedule.Schedule.Timezone),
+		Interval:         float32OrNull(schedule.Schedule.Interval),
+		AnchorDate:       stringOrNull(schedule.Schedule.AnchorDate),
+		Cron:             stringOrNull(schedule.Schedule.Cron),
+		DayOr:            types.BoolValue(schedule.Schedule.DayOr),
+		RRule:            stringOrNull(schedule.Schedule.RRule),
+	}
+
+	if schedule.Slug != nil && *schedule.Slug != "" {
+		model.Slug = types.StringValue(*schedule.Slug)
+	}
+
+	parameters, err := helpers.MarshalOptional(schedule.Parameters, priorParameters)
+	if err != nil {
+		diags.Append(helpers.SerializeDataErrorDiagnostic("schedules", "Deployment Schedule parameters", err))
+
+		return model, diags
+	}
+
+	model.Parameters = parameters
+
+	return model, diags
+}
+
+// reconcileDeploymentSchedules makes the schedules of the deployment match the
+// planned ones, updating the existing schedules that match a planned one, and
+// creating and deleting the others. A planned schedule matches the existing
+// schedule with the same slug, or else the one at the same position in the
+// prior state, or else any remaining schedule without a slug, in order.
+//
+// It returns the resulting schedules, in the order of the planned ones.
+func reconcileDeploymentSchedules(
+	ctx context.Context,
+	client api.DeploymentScheduleClient,
+	deploymentID uuid.UUID,
+	planned, prior []DeploymentInlineScheduleModel,
+) ([]DeploymentInlineScheduleModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	existing, err := client.Read(ctx, deploymentID)
+	if err != nil {
+		diags.Append(helpers.ResourceClientErrorDiagnostic("Deployment Schedule", "read", err))
+
+		return nil, diags
+	}
+
+	matches := matchDeploymentSchedules(planned, prior, existing)
+
+	matched := map[uuid.UUID]bool{}
+	for _, schedule := range matches {
+		if schedule != nil {
+			matched[schedule.ID] = true
+		}
+	}
+
+	// Delete the schedules that are not planned first, so that their slugs
+	// can be reused by the schedules that are created.
+	for _, schedule := range existing {
+		if matched[schedule.ID] {
+			continue
+		}
+
+		if err := client.Delete(ctx, deploymentID, schedule.ID); err != nil {
+			diags.Append(helpers.ResourceClientErrorDiagnostic("Deployment Schedule", "delete", err))
+
+			return nil, diags
+		}
+	}
+
+	scheduleIDs := make([]uuid.UUID, len(planned))
+
+	var createPayloads []api.DeploymentSchedulePayload
+	var createIndexes []int
+
+	for i := range planned {
+		payload, payloadDiags := planned[i].schedulePayload()
+		diags.Append(payloadDiags...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		if matches[i] == nil {
+			createPayloads = append(createPayloads, payload)
+			createIndexes = append(createIndexes, i)
+
+			continue
+		}
+
+		if err := client.Update(ctx, deploymentID, matches[i].ID, payload); err != nil {
+			diags.Append(helpers.ResourceClientErrorDiagnostic("Deployment Schedule", "update", err))
+
+			return nil, diags
+		}
+
+		scheduleIDs[i] = matches[i].ID
+	}
+
+	if len(createPayloads) > 0 {
+		created, err := client.Create(ctx, deploymentID, createPayloads)
+		if err != nil {
+			diags.Append(helpers.ResourceClientErrorDiagnostic("Deployment Schedule", "create", err))
+
+			return nil, diags
+		}
+
+		if len(created) != len(createPayloads) {
+			diags.AddError(
+				"Unexpected number of schedules created",
+				fmt.Sprintf("Expected %d schedules to be created, got %d.", len(createPayloads), len(created)),
+			)
+
+			return nil, diags
+		}
+
+		// Schedules are created in the order of the payloads.
+		for i, schedule := range created {
+			scheduleIDs[createIndexes[i]] = schedule.ID
+		}
+	}
+
+	current, err := client.Read(ctx, deploymentID)
+	if err != nil {
+		diags.Append(helpers.ResourceClientErrorDiagnostic("Deployment Schedule", "read after update", err))
+
+		return nil, diags
+	}
+
+	byID := map[uuid.UUID]*api.DeploymentSchedule{}
+	for _, schedule := range current {
+		byID[schedule.ID] = schedule
+	}
+
+	schedules := make([]DeploymentInlineScheduleModel, 0, len(planned))
+
+	for i, id := range scheduleIDs {
+		schedule, ok := byID[id]
+		if !ok {
+			diags.AddAttributeError(
+				path.Root("schedules").AtListIndex(i),
+				"Schedule not found",
+				fmt.Sprintf("Schedule %s of the deployment was not found after it was saved.", id),
+			)
+
+			return nil, diags
+		}
+
+		model, modelDiags := copyScheduleToInlineModel(schedule, planned[i].Parameters)
+		diags.Append(modelDiags...)
+
+		schedules = append(schedules, model)
+	}
+
+	return schedules, diags
+}
+
+// matchDeploymentSchedules returns, for each planned schedule, the existing
+// schedule it matches, or nil if it is to be created.
+func matchDeploymentSchedules(planned, prior []DeploymentInlineScheduleModel, existing []*api.DeploymentSchedule) []*api.DeploymentSchedule {
+	matches := make([]*api.DeploymentSchedule, len(planned))
+	matched := map[uuid.UUID]bool{}
+
+	match := func(i int, schedule *api.DeploymentSchedule) {
+		matches[i] = schedule
+		matched[schedule.ID] = true
+	}
+
+	// Match by slug.
+	for i := range planned {
+		if planned[i].Slug.IsNull() {
+			continue
+		}
+
+		for _, schedule := range existing {
+			if !matched[schedule.ID] && schedule.Slug != nil && *schedule.Slug == planned[i].Slug.ValueString() {
+				match(i, schedule)
+
+				break
+			}
+		}
+	}
+
+	// Match by position in the prior state.
+	for i := range planned {
+		if matches[i] != nil || !planned[i].Slug.IsNull() || i >= len(prior) {
+			continue
+		}
+
+		for _, schedule := range existing {
+			if !matched[schedule.ID] && schedule.ID == prior[i].ID.ValueUUID() {
+				match(i, schedule)
+
+				break
+			}
+		}
+	}
+
+	// Match the remaining schedules without a slug in order, such as when the
+	// schedules of a deployment are first managed with this attribute.
+	for i := range planned {
+		if matches[i] != nil || !planned[i].Slug.IsNull() {
+			continue
+		}
+
+		for _, schedule := range existing {
+			if !matched[schedule.ID] && (schedule.Slug == nil || *schedule.Slug == "") {
+				match(i, schedule)
+
+				break
+			}
+		}
+	}
+
+	return matches
+}
+
+// readDeploymentSchedules returns the schedules of the deployment, keeping
+// the order of the schedules in the state. Schedules that are not in the
+// state, such as ones added in the UI, are added at the end.
+func readDeploymentSchedules(
+	ctx context.Context,
+	client api.DeploymentScheduleClient,
+	deploymentID uuid.UUID,
+	state []DeploymentInlineScheduleModel,
+) ([]DeploymentInlineScheduleModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	existing, err := client.Read(ctx, deploymentID)
+	if err != nil {
+		diags.Append(helpers.ResourceClientErrorDiagnostic("Deployment Schedule", "read", err))
+
+		return nil, diags
+	}
+
+	position := map[uuid.UUID]int{}
+	for i := range state {
+		position[state[i].ID.ValueUUID()] = i
+	}
+
+	ordered := make([]*api.DeploymentSchedule, 0, len(existing))
+	var added []*api.DeploymentSchedule
+
+	for _, schedule := range existing {
+		if _, ok := position[schedule.ID]; ok {
+			ordered = append(ordered, schedule)
+		} else {
+			added = append(added, schedule)
+		}
+	}
+
+	slices.SortStableFunc(ordered, func(a, b *api.DeploymentSchedule) int {
+		return position[a.ID] - position[b.ID]
+	})
+
+	schedules := make([]DeploymentInlineScheduleModel, 0, len(existing))
+
+	for _, schedule := range append(ordered, added...) {
+		priorParameters := jsontypes.NewNormalizedNull()
+		if i, ok := position[schedule.ID]; ok {
+			priorParameters = state[i].Parameters
+		}
+
+		model, modelDiags := copyScheduleToInlineModel(schedule, priorParameters)
+		diags.Append(modelDiags...)
+
+		schedules = append(schedules, model)
+	}
+
+	return schedules, diags
+}
+
+func float32OrNull(value float32) types.Float32 {
+	if value == 0 {
+		return types.Float32Null()
+	}
+
+	return types.Float32Value(value)
+}
+
+func stringOrNull(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(value)
+}
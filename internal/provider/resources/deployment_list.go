@@ -0,0 +1,95 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var _ = list.ListResourceWithConfigure(&DeploymentResource{})
+
+// DeploymentListModel defines the configuration of the Deployment list resource.
+type DeploymentListModel struct {
+	WorkspaceListModel
+
+	FlowID customtypes.UUIDValue `tfsdk:"flow_id"`
+}
+
+// NewDeploymentListResource returns a new DeploymentResource as a list resource.
+//
+//nolint:ireturn // required by Terraform API
+func NewDeploymentListResource() list.ListResource {
+	return &DeploymentResource{}
+}
+
+// ListResourceConfigSchema defines the schema of the list resource configuration.
+func (r *DeploymentResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = workspaceListSchema("Lists the Deployments of a workspace, optionally only those of a Flow.", map[string]listschema.Attribute{
+		"flow_id": listschema.StringAttribute{
+			CustomType:  customtypes.UUIDType{},
+			Optional:    true,
+			Description: "Only list the Deployments of the Flow with this ID (UUID)",
+		},
+	})
+}
+
+// List streams the Deployments of a workspace.
+func (r *DeploymentResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config DeploymentListModel
+
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	client, err := r.client.Deployments(config.AccountID.ValueUUID(), config.WorkspaceID.ValueUUID())
+	if err != nil {
+		diags.Append(helpers.CreateClientErrorDiagnostic("Deployment", err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	var flowIDs []uuid.UUID
+	if !config.FlowID.IsNull() {
+		flowIDs = []uuid.UUID{config.FlowID.ValueUUID()}
+	}
+
+	deployments := client.Iterate(ctx, flowIDs, listOptions(req))
+
+	streamListResults(ctx, req, stream, "Deployment", deployments, func(deployment *api.Deployment, result *list.ListResult) {
+		result.DisplayName = deployment.Name
+
+		result.Diagnostics.Append(result.Identity.Set(ctx, IDIdentityModel{
+			AccountID:   config.AccountID,
+			WorkspaceID: config.WorkspaceID,
+			ID:          types.StringValue(deployment.ID.String()),
+		})...)
+		if result.Diagnostics.HasError() || !req.IncludeResource {
+			return
+		}
+
+		var model DeploymentResourceModel
+		result.Diagnostics.Append(getNullResource(ctx, result.Resource, &model)...)
+		if result.Diagnostics.HasError() {
+			return
+		}
+
+		model.AccountID = config.AccountID
+		model.WorkspaceID = config.WorkspaceID
+
+		result.Diagnostics.Append(CopyDeploymentToModel(ctx, deployment, &model)...)
+		model.setDefaults()
+
+		result.Diagnostics.Append(result.Resource.Set(ctx, model)...)
+	})
+}
@@ -2,20 +2,35 @@ package resources
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/prefecthq/terraform-provider-prefect/internal/api"
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
 )
 
-var _ = resource.ResourceWithConfigure(&DeploymentScheduleResource{})
+var (
+	_ = resource.ResourceWithConfigure(&DeploymentScheduleResource{})
+	_ = resource.ResourceWithImportState(&DeploymentScheduleResource{})
+	_ = resource.ResourceWithIdentity(&DeploymentScheduleResource{})
+	_ = resource.ResourceWithModifyPlan(&DeploymentScheduleResource{})
+)
+
+// scheduleNextRunsCount is the number of upcoming runs in `next_runs`.
+const scheduleNextRunsCount = 10
 
 type DeploymentScheduleResource struct {
 	client api.PrefectClient
@@ -33,8 +48,10 @@ type DeploymentScheduleResourceModel struct {
 
 	DeploymentID customtypes.UUIDValue `tfsdk:"deployment_id"`
 
-	Active           types.Bool    `tfsdk:"active"`
-	MaxScheduledRuns types.Float32 `tfsdk:"max_scheduled_runs"`
+	Active           types.Bool           `tfsdk:"active"`
+	MaxScheduledRuns types.Float32        `tfsdk:"max_scheduled_runs"`
+	Slug             types.String         `tfsdk:"slug"`
+	Parameters       jsontypes.Normalized `tfsdk:"parameters"`
 
 	// Cloud-only
 	MaxActiveRuns types.Float32 `tfsdk:"max_active_runs"`
@@ -53,6 +70,17 @@ type DeploymentScheduleResourceModel struct {
 
 	// Schedule kind: rrule
 	RRule types.String `tfsdk:"rrule"`
+
+	// NextRuns are computed locally from the schedule definition.
+	NextRuns types.List `tfsdk:"next_runs"`
+}
+
+// DeploymentScheduleResourceIdentityModel defines the Terraform resource identity model.
+type DeploymentScheduleResourceIdentityModel struct {
+	AccountID    customtypes.UUIDValue `tfsdk:"account_id"`
+	WorkspaceID  customtypes.UUIDValue `tfsdk:"workspace_id"`
+	DeploymentID customtypes.UUIDValue `tfsdk:"deployment_id"`
+	ID           customtypes.UUIDValue `tfsdk:"id"`
 }
 
 // NewDeploymentScheduleResource returns a new DeploymentScheduleResource.
@@ -65,6 +93,7 @@ func NewDeploymentScheduleResource() resource.Resource {
 // Metadata returns the resource type name.
 func (r *DeploymentScheduleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_deployment_schedule"
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 // Configure initializes runtime state for the resource.
@@ -138,6 +167,18 @@ For more information, see [schedule flow runs](https://docs.prefect.io/v3/automa
 				Optional:    true,
 				Computed:    true,
 			},
+			"slug": schema.StringAttribute{
+				Description: "A slug identifying the schedule within the deployment.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"parameters": schema.StringAttribute{
+				Description: "Parameters for the flow runs of the schedule, as a JSON object. Overrides the parameters of the deployment, so that it can run with different parameters on different schedules.",
+				Optional:    true,
+				CustomType:  jsontypes.NormalizedType{},
+			},
 			"max_active_runs": schema.Float32Attribute{
 				Description: "(Cloud only) The maximum number of active runs for the schedule.",
 				Optional:    true,
@@ -150,9 +191,12 @@ For more information, see [schedule flow runs](https://docs.prefect.io/v3/automa
 			},
 			// Timezone is a common field for all schedule kinds.
 			"timezone": schema.StringAttribute{
-				Description: "The timezone of the schedule.",
+				Description: "The timezone of the schedule, as an IANA timezone name, such as `America/New_York`.",
 				Optional:    true,
 				Computed:    true,
+				Validators: []validator.String{
+					timezoneValidator(),
+				},
 			},
 			// Schedule kind: interval
 			"interval": schema.Float32Attribute{
@@ -161,15 +205,21 @@ For more information, see [schedule flow runs](https://docs.prefect.io/v3/automa
 				Computed:    true,
 			},
 			"anchor_date": schema.StringAttribute{
-				Description: "The anchor date of the schedule.",
+				Description: "The anchor date of the schedule, as an ISO 8601 timestamp, such as `2024-01-01T00:00:00Z`.",
 				Optional:    true,
 				Computed:    true,
+				Validators: []validator.String{
+					anchorDateValidator(),
+				},
 			},
 			// Schedule kind: cron
 			"cron": schema.StringAttribute{
 				Description: "The cron expression of the schedule.",
 				Optional:    true,
 				Computed:    true,
+				Validators: []validator.String{
+					cronValidator(),
+				},
 			},
 			"day_or": schema.BoolAttribute{
 				Description: "Control croniter behavior for handling day and day_of_week entries.",
@@ -181,11 +231,29 @@ For more information, see [schedule flow runs](https://docs.prefect.io/v3/automa
 				Description: "The rrule expression of the schedule.",
 				Optional:    true,
 				Computed:    true,
+				Validators: []validator.String{
+					rruleValidator(),
+				},
+			},
+			"next_runs": schema.ListAttribute{
+				Description: fmt.Sprintf("The next %d times at which the schedule fires (RFC3339), in its timezone. ", scheduleNextRunsCount) +
+					"They are computed locally from the schedule definition, when planning changes to it and on refresh, " +
+					"regardless of whether the schedule is active or the deployment is paused.",
+				Computed:    true,
+				ElementType: types.StringType,
 			},
 		},
 	}
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (r *DeploymentScheduleResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = workspaceIdentitySchema(map[string]identityschema.Attribute{
+		"deployment_id": uuidIdentityAttribute("Deployment ID (UUID)"),
+		"id":            uuidIdentityAttribute("Deployment schedule ID (UUID)"),
+	})
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *DeploymentScheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan DeploymentScheduleResourceModel
@@ -203,9 +271,17 @@ func (r *DeploymentScheduleResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
+	parameters, diags := helpers.UnmarshalOptional(plan.Parameters)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	cfgCreate := []api.DeploymentSchedulePayload{
 		{
 			Active:           plan.Active.ValueBoolPointer(),
+			Slug:             plan.Slug.ValueStringPointer(),
+			Parameters:       parameters,
 			Catchup:          plan.Catchup.ValueBool(),
 			MaxActiveRuns:    plan.MaxActiveRuns.ValueFloat32(),
 			MaxScheduledRuns: plan.MaxScheduledRuns.ValueFloat32(),
@@ -238,9 +314,19 @@ func (r *DeploymentScheduleResource) Create(ctx context.Context, req resource.Cr
 	//
 	// Additionally, we couldn't use getResourceByID here because of a race condition:
 	// we'd need an ID in the state to compare against, which doesn't exist yet.
-	copyScheduleModelToResourceModel(schedules[0], &plan)
+	resp.Diagnostics.Append(copyScheduleModelToResourceModel(schedules[0], &plan)...)
+	resp.Diagnostics.Append(plan.setNextRuns(ctx, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, DeploymentScheduleResourceIdentityModel{
+		AccountID:    plan.AccountID,
+		WorkspaceID:  plan.WorkspaceID,
+		DeploymentID: plan.DeploymentID,
+		ID:           plan.ID,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -263,6 +349,12 @@ func (r *DeploymentScheduleResource) Read(ctx context.Context, req resource.Read
 
 	schedules, err := client.Read(ctx, state.DeploymentID.ValueUUID())
 	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Deployment Schedule", "read", err))
 
 		return
@@ -278,9 +370,19 @@ func (r *DeploymentScheduleResource) Read(ctx context.Context, req resource.Read
 		resp.Diagnostics.AddError("Unable to get schedule by ID", err.Error())
 	}
 
-	copyScheduleModelToResourceModel(schedule, &state)
+	resp.Diagnostics.Append(copyScheduleModelToResourceModel(schedule, &state)...)
+	resp.Diagnostics.Append(state.setNextRuns(ctx, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, DeploymentScheduleResourceIdentityModel{
+		AccountID:    state.AccountID,
+		WorkspaceID:  state.WorkspaceID,
+		DeploymentID: state.DeploymentID,
+		ID:           state.ID,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -301,8 +403,17 @@ func (r *DeploymentScheduleResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
+	// Parameters are always set, so that removing them clears them.
+	parameters, diags := helpers.UnmarshalOptional(plan.Parameters)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	cfgUpdate := api.DeploymentSchedulePayload{
 		Active:           plan.Active.ValueBoolPointer(),
+		Slug:             plan.Slug.ValueStringPointer(),
+		Parameters:       parameters,
 		Catchup:          plan.Catchup.ValueBool(),
 		MaxActiveRuns:    plan.MaxActiveRuns.ValueFloat32(),
 		MaxScheduledRuns: plan.MaxScheduledRuns.ValueFloat32(),
@@ -340,9 +451,19 @@ func (r *DeploymentScheduleResource) Update(ctx context.Context, req resource.Up
 		resp.Diagnostics.AddError("Unable to get schedule by ID", err.Error())
 	}
 
-	copyScheduleModelToResourceModel(schedule, &plan)
+	resp.Diagnostics.Append(copyScheduleModelToResourceModel(schedule, &plan)...)
+	resp.Diagnostics.Append(plan.setNextRuns(ctx, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, DeploymentScheduleResourceIdentityModel{
+		AccountID:    plan.AccountID,
+		WorkspaceID:  plan.WorkspaceID,
+		DeploymentID: plan.DeploymentID,
+		ID:           plan.ID,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -375,7 +496,9 @@ func (r *DeploymentScheduleResource) Delete(ctx context.Context, req resource.De
 	}
 }
 
-func copyScheduleModelToResourceModel(schedule *api.DeploymentSchedule, model *DeploymentScheduleResourceModel) {
+func copyScheduleModelToResourceModel(schedule *api.DeploymentSchedule, model *DeploymentScheduleResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
 	model.ID = customtypes.NewUUIDValue(schedule.ID)
 	model.Created = customtypes.NewTimestampPointerValue(schedule.Created)
 	model.Updated = customtypes.NewTimestampPointerValue(schedule.Updated)
@@ -394,6 +517,159 @@ func copyScheduleModelToResourceModel(schedule *api.DeploymentSchedule, model *D
 	model.Cron = types.StringValue(schedule.Schedule.Cron)
 	model.DayOr = types.BoolValue(schedule.Schedule.DayOr)
 	model.RRule = types.StringValue(schedule.Schedule.RRule)
+
+	model.Slug = types.StringNull()
+	if schedule.Slug != nil && *schedule.Slug != "" {
+		model.Slug = types.StringValue(*schedule.Slug)
+	}
+
+	// Schedules without parameters have an empty object, which is mapped
+	// to the form of the parameters of the model; see helpers.MarshalOptional.
+	parameters, err := helpers.MarshalOptional(schedule.Parameters, model.Parameters)
+	if err != nil {
+		diags.Append(helpers.SerializeDataErrorDiagnostic("parameters", "Deployment Schedule parameters", err))
+
+		return diags
+	}
+
+	model.Parameters = parameters
+
+	return diags
+}
+
+// ModifyPlan previews the next runs of the schedule when its definition
+// changes, and keeps the ones in the state otherwise.
+func (r *DeploymentScheduleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to compute when the resource is destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan DeploymentScheduleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state DeploymentScheduleResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if plan.hasSameDefinition(state) && !state.NextRuns.IsNull() {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("next_runs"), state.NextRuns)...)
+
+			return
+		}
+	}
+
+	plan.NextRuns = types.ListUnknown(types.StringType)
+	resp.Diagnostics.Append(plan.setNextRuns(ctx, true)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("next_runs"), plan.NextRuns)...)
+}
+
+// hasSameDefinition reports whether two models define the same schedule.
+func (m *DeploymentScheduleResourceModel) hasSameDefinition(other DeploymentScheduleResourceModel) bool {
+	return m.Timezone.Equal(other.Timezone) &&
+		m.Interval.Equal(other.Interval) &&
+		m.AnchorDate.Equal(other.AnchorDate) &&
+		m.Cron.Equal(other.Cron) &&
+		m.DayOr.Equal(other.DayOr) &&
+		m.RRule.Equal(other.RRule)
+}
+
+// setNextRuns computes the next runs of the schedule from now, unless they
+// are already known and recompute is false, such as when they were previewed
+// in the plan. They are left unknown when the definition is not known yet.
+func (m *DeploymentScheduleResourceModel) setNextRuns(ctx context.Context, recompute bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !recompute && !m.NextRuns.IsUnknown() && !m.NextRuns.IsNull() {
+		return diags
+	}
+
+	runs, known, err := m.nextRuns(time.Now())
+	if err != nil {
+		diags.AddWarning("Could not compute the next runs of the schedule", err.Error())
+
+		m.NextRuns = types.ListNull(types.StringType)
+
+		return diags
+	}
+
+	if !known {
+		m.NextRuns = types.ListUnknown(types.StringType)
+
+		return diags
+	}
+
+	timestamps := make([]string, 0, len(runs))
+	for _, run := range runs {
+		timestamps = append(timestamps, run.Format(time.RFC3339))
+	}
+
+	m.NextRuns, diags = types.ListValueFrom(ctx, types.StringType, timestamps)
+
+	return diags
+}
+
+// nextRuns returns the next runs of the schedule after the given time,
+// and whether the attributes they depend on are known.
+func (m *DeploymentScheduleResourceModel) nextRuns(after time.Time) ([]time.Time, bool, error) {
+	if m.Timezone.IsUnknown() {
+		return nil, false, nil
+	}
+
+	location, err := helpers.LoadTimezone(m.Timezone.ValueString())
+	if err != nil {
+		return nil, true, err
+	}
+
+	switch {
+	case !m.Cron.IsUnknown() && m.Cron.ValueString() != "":
+		if m.DayOr.IsUnknown() {
+			return nil, false, nil
+		}
+
+		// Prefect defaults day_or to true for cron schedules.
+		dayOr := m.DayOr.IsNull() || m.DayOr.ValueBool()
+
+		runs, err := helpers.CronNextRuns(m.Cron.ValueString(), dayOr, location, after, scheduleNextRunsCount)
+
+		return runs, true, err
+
+	case !m.RRule.IsUnknown() && m.RRule.ValueString() != "":
+		runs, err := helpers.RRuleNextRuns(m.RRule.ValueString(), location, after, scheduleNextRunsCount)
+
+		return runs, true, err
+
+	case !m.Interval.IsUnknown() && m.Interval.ValueFloat32() > 0:
+		if m.AnchorDate.IsUnknown() {
+			return nil, false, nil
+		}
+
+		// Prefect sets the anchor date when it is not set, so this only
+		// happens when previewing a schedule that is not created yet.
+		anchor := after
+		if m.AnchorDate.ValueString() != "" {
+			anchor, err = helpers.ParseAnchorDate(m.AnchorDate.ValueString(), location)
+			if err != nil {
+				return nil, true, err
+			}
+		}
+
+		interval := time.Duration(float64(m.Interval.ValueFloat32()) * float64(time.Second))
+		runs, err := helpers.IntervalNextRuns(interval, anchor, location, after, scheduleNextRunsCount)
+
+		return runs, true, err
+
+	case m.Cron.IsUnknown() || m.RRule.IsUnknown() || m.Interval.IsUnknown():
+		return nil, false, nil
+	}
+
+	return []time.Time{}, true, nil
 }
 
 // validateSchedules ensures that the list of schedules is not empty.
@@ -421,3 +697,9 @@ func getScheduleByID(model DeploymentScheduleResourceModel, schedules []*api.Dep
 
 	return nil, fmt.Errorf("schedule with ID %s not found", model.ID.ValueUUID())
 }
+
+// ImportState imports the resource into Terraform state from the identity
+// set in an import block.
+func (r *DeploymentScheduleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	helpers.ImportStateByIdentity(ctx, req, resp)
+}
@@ -1,6 +1,8 @@
 package resources_test
 
 import (
+	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -171,6 +173,69 @@ resource "prefect_deployment_schedule" "test_rrule" {
 	return testutils.RenderTemplate(tmpl, cfg)
 }
 
+func fixtureAccDeploymentScheduleParameters(cfg fixtureConfig, parameters string) string {
+	tmpl := fmt.Sprintf(`
+{{.WorkspaceResource}}
+
+resource "prefect_flow" "test" {
+	name = "my-flow"
+	workspace_id = {{.WorkspaceResourceName}}.id
+	tags = ["test"]
+}
+
+resource "prefect_deployment" "test" {
+	name = "my-deployment"
+	workspace_id = {{.WorkspaceResourceName}}.id
+	flow_id = prefect_flow.test.id
+	parameters = jsonencode({ "mode" : "incremental" })
+}
+
+resource "prefect_deployment_schedule" "test" {
+	workspace_id = prefect_workspace.test.id
+	deployment_id = prefect_deployment.test.id
+
+	cron = "0 2 * * *"
+	timezone = "America/New_York"
+	slug = "nightly"
+	parameters = jsonencode(%s)
+}
+`, parameters)
+
+	return testutils.RenderTemplate(tmpl, cfg)
+}
+
+func fixtureAccDeploymentScheduleInvalid(cfg fixtureConfig, cron, timezone string) string {
+	tmpl := `
+{{.WorkspaceResource}}
+
+resource "prefect_flow" "test" {
+	name = "my-flow"
+	workspace_id = {{.WorkspaceResourceName}}.id
+	tags = ["test"]
+}
+
+resource "prefect_deployment" "test" {
+	name = "my-deployment"
+	workspace_id = {{.WorkspaceResourceName}}.id
+	flow_id = prefect_flow.test.id
+}
+
+resource "prefect_deployment_schedule" "test" {
+	workspace_id = prefect_workspace.test.id
+	deployment_id = prefect_deployment.test.id
+
+	cron = "{{.Cron}}"
+	timezone = "{{.Timezone}}"
+}
+`
+
+	return testutils.RenderTemplate(tmpl, struct {
+		fixtureConfig
+		Cron     string
+		Timezone string
+	}{cfg, cron, timezone})
+}
+
 //nolint:paralleltest // we use the resource.ParallelTest helper instead
 func TestAccResource_deployment_schedule(t *testing.T) {
 	workspace := testutils.NewEphemeralWorkspace()
@@ -233,6 +298,35 @@ func TestAccResource_deployment_schedule(t *testing.T) {
 					testutils.ExpectKnownValue(resourceName, "rrule", "FREQ=DAILY;BYHOUR=10;BYMINUTE=30"),
 				},
 			},
+			{
+				// Test schedule slug and parameters
+				Config: fixtureAccDeploymentScheduleParameters(fixtureCfg, `{ "mode" : "full" }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentExists("prefect_deployment.test", &api.Deployment{}),
+					resource.TestCheckResourceAttr(resourceName, "next_runs.#", "10"),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					testutils.ExpectKnownValue(resourceName, "slug", "nightly"),
+					testutils.ExpectKnownValue(resourceName, "parameters", `{"mode":"full"}`),
+				},
+			},
+			{
+				// Test that empty parameters are kept rather than read back as null
+				Config: fixtureAccDeploymentScheduleParameters(fixtureCfg, `{}`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					testutils.ExpectKnownValue(resourceName, "parameters", "{}"),
+				},
+			},
+			{
+				// Test that an invalid cron expression fails the plan
+				Config:      fixtureAccDeploymentScheduleInvalid(fixtureCfg, "0 25 * * *", "UTC"),
+				ExpectError: regexp.MustCompile(`Invalid schedule`),
+			},
+			{
+				// Test that an unknown timezone fails the plan
+				Config:      fixtureAccDeploymentScheduleInvalid(fixtureCfg, "0 2 * * *", "Mars/Olympus_Mons"),
+				ExpectError: regexp.MustCompile(`Invalid schedule`),
+			},
 			{
 				// Test multiple schedules for one deployment
 				Config: fixtureAccDeploymentScheduleMultiple(fixtureCfg),
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"regexp"
 	"testing"
 
 	"github.com/google/uuid"
@@ -117,6 +118,12 @@ resource "prefect_deployment" "{{.DeploymentName}}" {
 			{{-   if .IncludeSubmodules }}
 			include_submodules = {{.IncludeSubmodules}}
 			{{-   end }}
+			{{-   if .CommitSha }}
+			commit_sha = "{{.CommitSha}}"
+			{{-   end }}
+			{{-   if .Directories }}
+			directories = [{{ range $i, $directory := .Directories }}{{ if $i }}, {{ end }}"{{ $directory }}"{{ end }}]
+			{{-   end }}
 			{{-   if .Credentials }}
 			credentials = "{{.Credentials}}"
 			{{-   end }}
@@ -125,6 +132,53 @@ resource "prefect_deployment" "{{.DeploymentName}}" {
 			{{-   end }}
 			{{- end }}
 
+			{{- with .PullStepRunShellScript }}
+			type = "run_shell_script"
+			{{-   if .ID }}
+			id = "{{.ID}}"
+			{{-   end }}
+			script = "{{.Script}}"
+			{{-   if .Directory }}
+			directory = "{{.Directory}}"
+			{{-   end }}
+			{{-   if .Env }}
+			env = {
+			{{-     range $key, $value := .Env }}
+				{{ $key }} = "{{ $value }}"
+			{{-     end }}
+			}
+			{{-   end }}
+			{{-   if .StreamOutput }}
+			stream_output = {{.StreamOutput}}
+			{{-   end }}
+			{{-   if .ExpandEnvVars }}
+			expand_env_vars = {{.ExpandEnvVars}}
+			{{-   end }}
+			{{- end }}
+
+			{{- with .PullStepPipInstallRequirements }}
+			type = "pip_install_requirements"
+			{{-   if .RequirementsFile }}
+			requirements_file = "{{.RequirementsFile}}"
+			{{-   end }}
+			{{-   if .Directory }}
+			directory = "{{.Directory}}"
+			{{-   end }}
+			{{-   if .StreamOutput }}
+			stream_output = {{.StreamOutput}}
+			{{-   end }}
+			{{- end }}
+
+			{{- with .PullStepCustom }}
+			type = "custom"
+			function = "{{.Function}}"
+			args = jsonencode({
+			{{-   range $key, $value := .Args }}
+				{{ $key }} = "{{ $value }}"
+			{{-   end }}
+			})
+			{{- end }}
+
 			{{- with .PullStepPullFromAzureBlobStorage }}
 			type = "pull_from_azure_blob_storage"
 			{{-   if .Bucket }}
@@ -281,6 +335,39 @@ func TestAccResource_deployment(t *testing.T) {
 					Branch:            ptr.To("main"),
 					AccessToken:       ptr.To("123abc"),
 					IncludeSubmodules: ptr.To(true),
+					CommitSha:         ptr.To("4f2e1c0"),
+					Directories:       []string{"flows", "shared"},
+				},
+			},
+			{
+				PullStepRunShellScript: &api.PullStepRunShellScript{
+					PullStepCommon: api.PullStepCommon{
+						ID: ptr.To("build"),
+					},
+					Script:        ptr.To("make build"),
+					Directory:     ptr.To("/some/other/directory"),
+					Env:           map[string]string{"STAGE": "test"},
+					StreamOutput:  ptr.To(true),
+					ExpandEnvVars: ptr.To(true),
+				},
+			},
+			{
+				PullStepPipInstallRequirements: &api.PullStepPipInstallRequirements{
+					RequirementsFile: ptr.To("requirements-dev.txt"),
+					StreamOutput:     ptr.To(true),
+				},
+			},
+			{
+				PullStepCustom: &api.PullStepCustom{
+					Function: "my_collection.deployments.steps.pull_from_artifactory",
+					Args:     map[string]any{"repository": "flows", "version": "1.2.3"},
+				},
+			},
+			{
+				// A built-in step with an argument that git_clone has no attribute for.
+				PullStepCustom: &api.PullStepCustom{
+					Function: api.PullStepFunctionGitClone,
+					Args:     map[string]any{"repository": "https://github.com/prefecthq/prefect", "depth": "1"},
 				},
 			},
 			{
@@ -373,10 +460,485 @@ func TestAccResource_deployment(t *testing.T) {
 				ResourceName:      cfgCreate.DeploymentResourceName,
 				ImportStateVerify: true,
 			},
+			{
+				// Import State checks - import by link to the Prefect UI
+				ImportState:       true,
+				ImportStateIdFunc: testutils.GetResourceUIURLImportStateID(cfgCreate.DeploymentResourceName, "deployments/deployment"),
+				ResourceName:      cfgCreate.DeploymentResourceName,
+				ImportStateVerify: true,
+			},
+			{
+				// Import State checks - import by workspace_handle/flow_name/deployment_name
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s/%s/%s", workspace.Name, flowName, deploymentName),
+				ResourceName:      cfgCreate.DeploymentResourceName,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func fixtureAccDeploymentValidation(workspace, name, parameters, jobVariables, additionalProperties string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "prefect_work_pool" "test" {
+	name = "%[2]s"
+	type = "process"
+	workspace_id = prefect_workspace.test.id
+}
+
+resource "prefect_flow" "test" {
+	name = "%[2]s"
+	workspace_id = prefect_workspace.test.id
+}
+
+resource "prefect_deployment" "test" {
+	name = "%[2]s"
+	flow_id = prefect_flow.test.id
+	work_pool_name = prefect_work_pool.test.name
+	enforce_parameter_schema = true
+	parameter_openapi_schema = jsonencode({
+		"type": "object",
+		"properties": {
+			"name": {"type": "string"},
+			"retries": {"type": "integer"}
+		},
+		"additionalProperties": %[5]s
+	})
+	parameters = jsonencode(%[3]s)
+	job_variables = jsonencode(%[4]s)
+	workspace_id = prefect_workspace.test.id
+}
+`, workspace, name, parameters, jobVariables, additionalProperties)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_deployment_plan_validation(t *testing.T) {
+	workspace := testutils.NewEphemeralWorkspace()
+	randomName := testutils.NewRandomPrefixedString()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fixtureAccDeploymentValidation(workspace.Resource, randomName, `{"name": "test", "retries": 3}`, `{"command": "python flow.py"}`, "null"),
+			},
+			{
+				// Check that a misspelled parameter fails the plan
+				Config:      fixtureAccDeploymentValidation(workspace.Resource, randomName, `{"nmae": "test"}`, `{}`, "null"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Parameter `nmae` is not a parameter of the flow"),
+			},
+			{
+				// Check that other parameters are accepted when the schema allows them
+				Config:             fixtureAccDeploymentValidation(workspace.Resource, randomName, `{"nmae": "test"}`, `{}`, "true"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// Check that other parameters are reported by the schema validation when the schema forbids them
+				Config:      fixtureAccDeploymentValidation(workspace.Resource, randomName, `{"nmae": "test"}`, `{}`, "false"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Parameter `nmae` does not conform to the parameter schema"),
+			},
+			{
+				// Check that a parameter of the wrong type fails the plan
+				Config:      fixtureAccDeploymentValidation(workspace.Resource, randomName, `{"retries": "three"}`, `{}`, "null"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Parameter `retries` does not conform to the parameter schema of the flow"),
+			},
+			{
+				// Check that a job variable of the wrong type fails the plan
+				Config:      fixtureAccDeploymentValidation(workspace.Resource, randomName, `{}`, `{"command": 1}`, "null"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Job variable `command` does not conform"),
+			},
+		},
+	})
+}
+
+func fixtureAccDeploymentEmptyPullStepObjects(workspace, name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "prefect_flow" "test" {
+	name = "%[2]s"
+	workspace_id = prefect_workspace.test.id
+}
+
+resource "prefect_deployment" "test" {
+	name = "%[2]s"
+	flow_id = prefect_flow.test.id
+	workspace_id = prefect_workspace.test.id
+	pull_steps = [
+		{
+			type = "run_shell_script"
+			script = "make build"
+			env = {}
+		},
+		{
+			type = "custom"
+			function = "my_collection.deployments.steps.pull_from_artifactory"
+			args = jsonencode({})
+		},
+	]
+}
+`, workspace, name)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_deployment_empty_pull_step_objects(t *testing.T) {
+	workspace := testutils.NewEphemeralWorkspace()
+	randomName := testutils.NewRandomPrefixedString()
+	resourceName := "prefect_deployment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// Check that an empty env and args are kept rather than read back as null
+				Config: fixtureAccDeploymentEmptyPullStepObjects(workspace.Resource, randomName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					testutils.ExpectKnownValueMap(resourceName, "pull_steps.0.env", map[string]string{}),
+					testutils.ExpectKnownValue(resourceName, "pull_steps.1.args", "{}"),
+				},
+			},
+		},
+	})
+}
+
+func fixtureAccDeploymentInlineSchedules(workspace, name, schedules string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "prefect_flow" "test" {
+	name = "%[2]s"
+	workspace_id = prefect_workspace.test.id
+}
+
+resource "prefect_deployment" "test" {
+	name = "%[2]s"
+	flow_id = prefect_flow.test.id
+	workspace_id = prefect_workspace.test.id
+	schedules = %[3]s
+}
+`, workspace, name, schedules)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_deployment_inline_schedules(t *testing.T) {
+	workspace := testutils.NewEphemeralWorkspace()
+	randomName := testutils.NewRandomPrefixedString()
+	resourceName := "prefect_deployment.test"
+
+	createSchedules := `[
+		{
+			slug = "hourly"
+			interval = 3600
+			parameters = jsonencode({"mode": "incremental"})
+		},
+		{
+			cron = "0 0 * * *"
+			parameters = jsonencode({"mode": "full"})
+		},
+	]`
+
+	// Update the hourly schedule, remove the nightly one, and add a weekly one
+	// with empty parameters.
+	updateSchedules := `[
+		{
+			rrule = "FREQ=WEEKLY;BYDAY=MO"
+			slug = "weekly"
+			parameters = jsonencode({})
+		},
+		{
+			slug = "hourly"
+			interval = 1800
+			active = false
+		},
+	]`
+
+	var deploymentID, workspaceID uuid.UUID
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fixtureAccDeploymentInlineSchedules(workspace.Resource, randomName, createSchedules),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentScheduleCount(resourceName, 2),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					testutils.ExpectKnownValueListSize(resourceName, "schedules", 2),
+					testutils.ExpectKnownValue(resourceName, "schedules.0.slug", "hourly"),
+					testutils.ExpectKnownValueNumber(resourceName, "schedules.0.interval", 3600),
+					testutils.ExpectKnownValue(resourceName, "schedules.0.parameters", `{"mode":"incremental"}`),
+					testutils.ExpectKnownValueBool(resourceName, "schedules.0.active", true),
+					testutils.ExpectKnownValue(resourceName, "schedules.1.cron", "0 0 * * *"),
+					testutils.ExpectKnownValue(resourceName, "schedules.1.parameters", `{"mode":"full"}`),
+				},
+			},
+			{
+				Config: fixtureAccDeploymentInlineSchedules(workspace.Resource, randomName, updateSchedules),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentScheduleCount(resourceName, 2),
+					func(s *terraform.State) error {
+						var err error
+						if deploymentID, err = testutils.GetResourceIDFromState(s, resourceName); err != nil {
+							return err
+						}
+
+						workspaceID, err = testutils.GetResourceIDFromState(s, testutils.WorkspaceResourceName)
+
+						return err
+					},
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					testutils.ExpectKnownValueListSize(resourceName, "schedules", 2),
+					testutils.ExpectKnownValue(resourceName, "schedules.0.rrule", "FREQ=WEEKLY;BYDAY=MO"),
+					testutils.ExpectKnownValue(resourceName, "schedules.0.parameters", "{}"),
+					testutils.ExpectKnownValue(resourceName, "schedules.1.slug", "hourly"),
+					testutils.ExpectKnownValueNumber(resourceName, "schedules.1.interval", 1800),
+					testutils.ExpectKnownValueBool(resourceName, "schedules.1.active", false),
+					testutils.ExpectKnownValueNull(resourceName, "schedules.1.parameters"),
+				},
+			},
+			{
+				// Check that a schedule added outside of Terraform is deleted.
+				PreConfig: func() {
+					c, _ := testutils.NewTestClient()
+					schedulesClient, _ := c.DeploymentSchedule(uuid.Nil, workspaceID)
+
+					_, err := schedulesClient.Create(context.Background(), deploymentID, []api.DeploymentSchedulePayload{
+						{Schedule: api.Schedule{Interval: 60}},
+					})
+					if err != nil {
+						t.Fatalf("error creating deployment schedule: %s", err)
+					}
+				},
+				Config: fixtureAccDeploymentInlineSchedules(workspace.Resource, randomName, updateSchedules),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentScheduleCount(resourceName, 2),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					testutils.ExpectKnownValueListSize(resourceName, "schedules", 2),
+				},
+			},
 		},
 	})
 }
 
+func fixtureAccDeploymentTriggers(workspace, name, triggers string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "prefect_flow" "test" {
+	name = "%[2]s"
+	workspace_id = prefect_workspace.test.id
+}
+
+resource "prefect_deployment" "test" {
+	name = "%[2]s"
+	flow_id = prefect_flow.test.id
+	workspace_id = prefect_workspace.test.id
+	triggers = %[3]s
+}
+`, workspace, name, triggers)
+}
+
+func fixtureAccDeploymentTriggersDestroyed(workspace, name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "prefect_flow" "test" {
+	name = "%[2]s"
+	workspace_id = prefect_workspace.test.id
+}
+`, workspace, name)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_deployment_triggers(t *testing.T) {
+	workspace := testutils.NewEphemeralWorkspace()
+	randomName := testutils.NewRandomPrefixedString()
+	resourceName := "prefect_deployment.test"
+
+	createTriggers := `[
+		{
+			name = "on-completed"
+			event = {
+				posture = "Reactive"
+				expect = ["prefect.flow-run.Completed"]
+				match = jsonencode({"prefect.resource.id": "prefect.flow-run.*"})
+				threshold = 1
+				within = 0
+			}
+			parameters = jsonencode({"mode": "incremental"})
+		},
+		{
+			compound = {
+				require = "all"
+				within = 300
+				triggers = [
+					{
+						event = {
+							posture = "Reactive"
+							expect = ["external.data.ready"]
+							threshold = 1
+							within = 0
+						}
+					},
+					{
+						event = {
+							posture = "Reactive"
+							expect = ["external.model.ready"]
+							threshold = 1
+							within = 0
+						}
+					},
+				]
+			}
+			job_variables = jsonencode({})
+		},
+	]`
+
+	// Remove the first trigger, and disable the remaining one.
+	updateTriggers := `[
+		{
+			enabled = false
+			metric = {
+				metric = {
+					name = "duration"
+					operator = ">"
+					threshold = 60
+					range = 300
+					firing_for = 60
+				}
+			}
+		},
+	]`
+
+	var deploymentID, workspaceID uuid.UUID
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fixtureAccDeploymentTriggers(workspace.Resource, randomName, createTriggers),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					func(s *terraform.State) error {
+						var err error
+						if deploymentID, err = testutils.GetResourceIDFromState(s, resourceName); err != nil {
+							return err
+						}
+
+						workspaceID, err = testutils.GetResourceIDFromState(s, testutils.WorkspaceResourceName)
+						if err != nil {
+							return err
+						}
+
+						return testAccCheckDeploymentTriggerCount(deploymentID, workspaceID, 2)
+					},
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					testutils.ExpectKnownValueListSize(resourceName, "triggers", 2),
+					testutils.ExpectKnownValue(resourceName, "triggers.0.name", "on-completed"),
+					testutils.ExpectKnownValueBool(resourceName, "triggers.0.enabled", true),
+					testutils.ExpectKnownValue(resourceName, "triggers.0.parameters", `{"mode":"incremental"}`),
+					testutils.ExpectKnownValue(resourceName, "triggers.1.name", randomName+"__automation_2"),
+					testutils.ExpectKnownValue(resourceName, "triggers.1.compound.require", "all"),
+					testutils.ExpectKnownValueListSize(resourceName, "triggers.1.compound.triggers", 2),
+					testutils.ExpectKnownValue(resourceName, "triggers.1.job_variables", "{}"),
+				},
+			},
+			{
+				Config: fixtureAccDeploymentTriggers(workspace.Resource, randomName, updateTriggers),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					func(_ *terraform.State) error {
+						return testAccCheckDeploymentTriggerCount(deploymentID, workspaceID, 1)
+					},
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					testutils.ExpectKnownValueListSize(resourceName, "triggers", 1),
+					testutils.ExpectKnownValue(resourceName, "triggers.0.name", randomName+"__automation_1"),
+					testutils.ExpectKnownValueBool(resourceName, "triggers.0.enabled", false),
+					testutils.ExpectKnownValue(resourceName, "triggers.0.metric.metric.name", "duration"),
+					testutils.ExpectKnownValueNull(resourceName, "triggers.0.parameters"),
+				},
+			},
+			{
+				// Check that the automations are deleted with the deployment.
+				Config: fixtureAccDeploymentTriggersDestroyed(workspace.Resource, randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					func(_ *terraform.State) error {
+						return testAccCheckDeploymentTriggerCount(deploymentID, workspaceID, 0)
+					},
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckDeploymentTriggerCount verifies the number of automations owned by a deployment.
+func testAccCheckDeploymentTriggerCount(deploymentID, workspaceID uuid.UUID, count int) error {
+	c, _ := testutils.NewTestClient()
+	automationsClient, _ := c.Automations(uuid.Nil, workspaceID)
+
+	owner := "prefect.deployment." + deploymentID.String()
+
+	automations, err := automationsClient.ListRelatedTo(context.Background(), owner)
+	if err != nil {
+		return fmt.Errorf("error fetching automations related to the deployment: %w", err)
+	}
+
+	owned := 0
+	for _, automation := range automations {
+		if automation.OwnerResource != nil && *automation.OwnerResource == owner {
+			owned++
+		}
+	}
+
+	if owned != count {
+		return fmt.Errorf("expected %d automations owned by the deployment, got %d", count, owned)
+	}
+
+	return nil
+}
+
+// testAccCheckDeploymentScheduleCount verifies the number of schedules of a deployment.
+func testAccCheckDeploymentScheduleCount(deploymentResourceName string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		deploymentID, err := testutils.GetResourceIDFromState(s, deploymentResourceName)
+		if err != nil {
+			return fmt.Errorf("error fetching deployment ID: %w", err)
+		}
+
+		workspaceID, err := testutils.GetResourceIDFromState(s, testutils.WorkspaceResourceName)
+		if err != nil {
+			return fmt.Errorf("error fetching workspace ID: %w", err)
+		}
+
+		c, _ := testutils.NewTestClient()
+		schedulesClient, _ := c.DeploymentSchedule(uuid.Nil, workspaceID)
+
+		schedules, err := schedulesClient.Read(context.Background(), deploymentID)
+		if err != nil {
+			return fmt.Errorf("error fetching deployment schedules: %w", err)
+		}
+
+		if len(schedules) != count {
+			return fmt.Errorf("expected %d deployment schedules, got %d", count, len(schedules))
+		}
+
+		return nil
+	}
+}
+
 // testAccCheckDeploymentExists is a Custom Check Function that
 // verifies that the API object was created correctly.
 func testAccCheckDeploymentExists(deploymentResourceName string, deployment *api.Deployment) resource.TestCheckFunc {
@@ -0,0 +1,491 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"math/big"
+	"regexp"
+	"slices"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+	"github.com/prefecthq/terraform-provider-prefect/internal/utils"
+)
+
+// DeploymentTriggerModel represents a trigger set in the `triggers`
+// attribute of a deployment. Each trigger is an automation owned by the
+// deployment, which runs it when the trigger fires.
+type DeploymentTriggerModel struct {
+	ID          customtypes.UUIDValue `tfsdk:"id"`
+	Name        types.String          `tfsdk:"name"`
+	Description types.String          `tfsdk:"description"`
+	Enabled     types.Bool            `tfsdk:"enabled"`
+
+	// Parameters and job variables of the flow runs created by the trigger.
+	Parameters   jsontypes.Normalized `tfsdk:"parameters"`
+	JobVariables jsontypes.Normalized `tfsdk:"job_variables"`
+
+	// The event, metric, compound or sequence trigger of the automation.
+	ResourceTriggerModel
+	Compound *DeploymentCompoundTriggerModel `tfsdk:"compound"`
+	Sequence *SequenceTriggerAttributesModel `tfsdk:"sequence"`
+}
+
+// DeploymentCompoundTriggerModel represents a compound trigger of a deployment.
+// Unlike on automations, `require` is a string, as dynamic attributes are not
+// supported in lists by the framework.
+type DeploymentCompoundTriggerModel struct {
+	Triggers []ResourceTriggerModel `tfsdk:"triggers"`
+	Within   types.Float64          `tfsdk:"within"`
+	Require  types.String           `tfsdk:"require"`
+}
+
+// deploymentTriggersAttribute returns the schema of the `triggers` attribute
+// of the deployment resource. The triggers use the trigger schema of the
+// automation resource.
+func deploymentTriggersAttribute() schema.ListNestedAttribute {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			CustomType:  customtypes.UUIDType{},
+			Description: "Automation ID (UUID)",
+		},
+		"name": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Name of the automation. Defaults to `<deployment name>__automation_<position>`, as with `prefect deploy`.",
+		},
+		"description": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     stringdefault.StaticString(""),
+			Description: "Description of the automation",
+		},
+		"enabled": schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(true),
+			Description: "Whether the automation is enabled",
+		},
+		"parameters": schema.StringAttribute{
+			Optional:    true,
+			CustomType:  jsontypes.NormalizedType{},
+			Description: "(JSON) Parameters of the flow runs created by the trigger, which override the parameters of the deployment. Use `jsonencode()`.",
+		},
+		"job_variables": schema.StringAttribute{
+			Optional:    true,
+			CustomType:  jsontypes.NormalizedType{},
+			Description: "(JSON) Job variables of the flow runs created by the trigger. Use `jsonencode()`.",
+		},
+	}
+
+	maps.Copy(attributes, TriggerSchema().Attributes)
+
+	// Dynamic attributes cannot be nested in lists, so `require` is a string.
+	compoundAttributes := CompositeTriggerSchemaAttributes()
+	compoundAttributes["require"] = schema.StringAttribute{
+		Required:    true,
+		Description: "How many triggers must fire ('any', 'all', or a number, such as '2')",
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(regexp.MustCompile(`^(any|all|[1-9][0-9]*)$`), "must be 'any', 'all', or a positive number"),
+		},
+	}
+
+	attributes[utils.TriggerTypeCompound] = schema.SingleNestedAttribute{
+		Optional:    true,
+		Description: "A composite trigger that requires some number of triggers to have fired within the given time period",
+		Attributes:  compoundAttributes,
+	}
+
+	return schema.ListNestedAttribute{
+		Optional: true,
+		Description: "The triggers of the deployment, as with `triggers` in `prefect.yaml`. Each trigger is an automation " +
+			"owned by the deployment, which runs the deployment when the trigger fires, and which is deleted with it. " +
+			"When set, automations owned by the deployment that are not listed are deleted on apply, and detected as drift on refresh. " +
+			"Leave unset to manage automations with `prefect_automation` resources instead.",
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: attributes,
+			Validators: []validator.Object{
+				objectvalidator.ExactlyOneOf(
+					path.MatchRelative().AtName(utils.TriggerTypeEvent),
+					path.MatchRelative().AtName(utils.TriggerTypeMetric),
+					path.MatchRelative().AtName(utils.TriggerTypeCompound),
+					path.MatchRelative().AtName(utils.TriggerTypeSequence),
+				),
+			},
+		},
+	}
+}
+
+// deploymentOwnerResource returns the resource ID of a deployment, which
+// identifies the automations owned by it.
+func deploymentOwnerResource(deploymentID uuid.UUID) string {
+	return "prefect.deployment." + deploymentID.String()
+}
+
+// defaultDeploymentTriggerName returns the name of the automation of a
+// trigger without a name, as set by `prefect deploy`.
+func defaultDeploymentTriggerName(deploymentName string, index int) string {
+	return fmt.Sprintf("%s__automation_%d", deploymentName, index+1)
+}
+
+// automationPayload returns the API payload for the automation of a trigger,
+// with a single action running the deployment.
+func (m *DeploymentTriggerModel) automationPayload(ctx context.Context, deployment *api.Deployment, index int) (api.AutomationUpsert, diag.Diagnostics) {
+	name := m.Name
+	if name.IsUnknown() || name.IsNull() {
+		name = types.StringValue(defaultDeploymentTriggerName(deployment.Name, index))
+	}
+
+	automation := AutomationResourceModel{
+		Name:        name,
+		Description: m.Description,
+		Enabled:     m.Enabled,
+		Trigger:     m.triggerModel(),
+		Actions: []ActionModel{
+			{
+				Type:         types.StringValue("run-deployment"),
+				Source:       types.StringValue("selected"),
+				DeploymentID: customtypes.NewUUIDValue(deployment.ID),
+				Parameters:   m.Parameters,
+				JobVariables: m.JobVariables,
+			},
+		},
+	}
+
+	var payload api.AutomationUpsert
+	diags := mapAutomationTerraformToAPI(ctx, &payload, &automation)
+
+	owner := deploymentOwnerResource(deployment.ID)
+	payload.OwnerResource = &owner
+
+	return payload, diags
+}
+
+// triggerModel returns the trigger of the automation of a trigger.
+func (m *DeploymentTriggerModel) triggerModel() TriggerModel {
+	trigger := TriggerModel{
+		ResourceTriggerModel: m.ResourceTriggerModel,
+		Sequence:             m.Sequence,
+	}
+
+	if m.Compound != nil {
+		require := types.DynamicValue(m.Compound.Require)
+		if count, err := strconv.ParseInt(m.Compound.Require.ValueString(), 10, 64); err == nil {
+			require = types.DynamicValue(types.NumberValue(new(big.Float).SetInt64(count)))
+		}
+
+		trigger.Compound = &CompoundTriggerAttributesModel{
+			Triggers: m.Compound.Triggers,
+			Within:   m.Compound.Within,
+			Require:  require,
+		}
+	}
+
+	return trigger
+}
+
+// copyAutomationToTriggerModel copies an api.Automation owned by a deployment
+// to a DeploymentTriggerModel. Empty parameters and job variables are mapped
+// to the form of those of the prior trigger, if any; see helpers.MarshalOptional.
+func copyAutomationToTriggerModel(ctx context.Context, automation *api.Automation, prior DeploymentTriggerModel) (DeploymentTriggerModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Composite triggers are mapped into existing models.
+	mapped := AutomationResourceModel{}
+	switch automation.Trigger.Type {
+	case utils.TriggerTypeCompound:
+		mapped.Trigger.Compound = &CompoundTriggerAttributesModel{}
+	case utils.TriggerTypeSequence:
+		mapped.Trigger.Sequence = &SequenceTriggerAttributesModel{}
+	}
+
+	diags.Append(mapAutomationAPIToTerraform(ctx, automation, &mapped)...)
+	if diags.HasError() {
+		return DeploymentTriggerModel{}, diags
+	}
+
+	model := DeploymentTriggerModel{
+		ID:                   customtypes.NewUUIDValue(automation.ID),
+		Name:                 mapped.Name,
+		Description:          mapped.Description,
+		Enabled:              mapped.Enabled,
+		Parameters:           jsontypes.NewNormalizedNull(),
+		JobVariables:         jsontypes.NewNormalizedNull(),
+		ResourceTriggerModel: mapped.Trigger.ResourceTriggerModel,
+		Sequence:             mapped.Trigger.Sequence,
+	}
+
+	if mapped.Trigger.Compound != nil {
+		model.Compound = &DeploymentCompoundTriggerModel{
+			Triggers: mapped.Trigger.Compound.Triggers,
+			Within:   mapped.Trigger.Compound.Within,
+			Require:  requireString(automation.Trigger.Require),
+		}
+	}
+
+	for _, action := range automation.Actions {
+		if action.Type != "run-deployment" {
+			continue
+		}
+
+		parameters, err := helpers.MarshalOptional(action.Parameters, prior.Parameters)
+		if err != nil {
+			diags.Append(helpers.SerializeDataErrorDiagnostic("triggers", "Deployment trigger parameters", err))
+
+			return model, diags
+		}
+
+		jobVariables, err := helpers.MarshalOptional(action.JobVariables, prior.JobVariables)
+		if err != nil {
+			diags.Append(helpers.SerializeDataErrorDiagnostic("triggers", "Deployment trigger job variables", err))
+
+			return model, diags
+		}
+
+		model.Parameters = parameters
+		model.JobVariables = jobVariables
+
+		break
+	}
+
+	return model, diags
+}
+
+// requireString maps the `require` value of a compound trigger, which is
+// 'any', 'all', or a number, to a string.
+func requireString(require *interface{}) types.String {
+	if require == nil {
+		return types.StringNull()
+	}
+
+	switch value := (*require).(type) {
+	case string:
+		return types.StringValue(value)
+	case float64:
+		return types.StringValue(strconv.FormatFloat(value, 'f', -1, 64))
+	}
+
+	return types.StringNull()
+}
+
+// reconcileDeploymentTriggers makes the automations owned by the deployment
+// match the planned triggers, updating the automation of each trigger in the
+// prior state, and creating and deleting the others. Automations that are not
+// in the prior state, such as ones created by `prefect deploy`, are matched to
+// the remaining triggers in order.
+//
+// It returns the resulting triggers, in the order of the planned ones.
+func reconcileDeploymentTriggers(
+	ctx context.Context,
+	client api.AutomationsClient,
+	deployment *api.Deployment,
+	planned, prior []DeploymentTriggerModel,
+) ([]DeploymentTriggerModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	existing, err := listOwnedAutomations(ctx, client, deployment.ID)
+	if err != nil {
+		diags.Append(helpers.ResourceClientErrorDiagnostic("Automation", "list", err))
+
+		return nil, diags
+	}
+
+	matches := matchDeploymentTriggers(planned, prior, existing)
+
+	matched := map[uuid.UUID]bool{}
+	for _, automation := range matches {
+		if automation != nil {
+			matched[automation.ID] = true
+		}
+	}
+
+	for _, automation := range existing {
+		if matched[automation.ID] {
+			continue
+		}
+
+		if err := client.Delete(ctx, automation.ID); err != nil {
+			diags.Append(helpers.ResourceClientErrorDiagnostic("Automation", "delete", err))
+
+			return nil, diags
+		}
+	}
+
+	triggers := make([]DeploymentTriggerModel, 0, len(planned))
+
+	for i := range planned {
+		payload, payloadDiags := planned[i].automationPayload(ctx, deployment, i)
+		diags.Append(payloadDiags...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var automation *api.Automation
+
+		if matches[i] == nil {
+			automation, err = client.Create(ctx, payload)
+			if err != nil {
+				diags.Append(helpers.ResourceClientErrorDiagnostic("Automation", "create", err))
+
+				return nil, diags
+			}
+		} else {
+			if err := client.Update(ctx, matches[i].ID, payload); err != nil {
+				diags.Append(helpers.ResourceClientErrorDiagnostic("Automation", "update", err))
+
+				return nil, diags
+			}
+
+			automation, err = client.Get(ctx, matches[i].ID)
+			if err != nil {
+				diags.Append(helpers.ResourceClientErrorDiagnostic("Automation", "get", err))
+
+				return nil, diags
+			}
+		}
+
+		model, modelDiags := copyAutomationToTriggerModel(ctx, automation, planned[i])
+		diags.Append(modelDiags...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		triggers = append(triggers, model)
+	}
+
+	return triggers, diags
+}
+
+// matchDeploymentTriggers returns, for each planned trigger, the existing
+// automation it matches, or nil if it is to be created.
+func matchDeploymentTriggers(planned, prior []DeploymentTriggerModel, existing []*api.Automation) []*api.Automation {
+	matches := make([]*api.Automation, len(planned))
+	matched := map[uuid.UUID]bool{}
+
+	inPrior := map[uuid.UUID]bool{}
+	for i := range prior {
+		inPrior[prior[i].ID.ValueUUID()] = true
+	}
+
+	// Match by position in the prior state.
+	for i := range min(len(planned), len(prior)) {
+		for _, automation := range existing {
+			if !matched[automation.ID] && automation.ID == prior[i].ID.ValueUUID() {
+				matches[i] = automation
+				matched[automation.ID] = true
+
+				break
+			}
+		}
+	}
+
+	// Match the remaining automations that are not in the prior state in
+	// order, such as when the triggers of a deployment are first managed
+	// with this attribute.
+	for i := range planned {
+		if matches[i] != nil {
+			continue
+		}
+
+		for _, automation := range existing {
+			if !matched[automation.ID] && !inPrior[automation.ID] {
+				matches[i] = automation
+				matched[automation.ID] = true
+
+				break
+			}
+		}
+	}
+
+	return matches
+}
+
+// readDeploymentTriggers returns the triggers of the deployment, keeping the
+// order of the triggers in the state. Automations owned by the deployment that
+// are not in the state are added at the end.
+func readDeploymentTriggers(
+	ctx context.Context,
+	client api.AutomationsClient,
+	deploymentID uuid.UUID,
+	state []DeploymentTriggerModel,
+) ([]DeploymentTriggerModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	existing, err := listOwnedAutomations(ctx, client, deploymentID)
+	if err != nil {
+		diags.Append(helpers.ResourceClientErrorDiagnostic("Automation", "list", err))
+
+		return nil, diags
+	}
+
+	position := map[uuid.UUID]int{}
+	for i := range state {
+		position[state[i].ID.ValueUUID()] = i
+	}
+
+	ordered := make([]*api.Automation, 0, len(existing))
+	var added []*api.Automation
+
+	for _, automation := range existing {
+		if _, ok := position[automation.ID]; ok {
+			ordered = append(ordered, automation)
+		} else {
+			added = append(added, automation)
+		}
+	}
+
+	slices.SortStableFunc(ordered, func(a, b *api.Automation) int {
+		return position[a.ID] - position[b.ID]
+	})
+
+	triggers := make([]DeploymentTriggerModel, 0, len(existing))
+
+	for _, automation := range append(ordered, added...) {
+		var prior DeploymentTriggerModel
+		if i, ok := position[automation.ID]; ok {
+			prior = state[i]
+		}
+
+		model, modelDiags := copyAutomationToTriggerModel(ctx, automation, prior)
+		diags.Append(modelDiags...)
+
+		triggers = append(triggers, model)
+	}
+
+	return triggers, diags
+}
+
+// listOwnedAutomations returns the automations owned by a deployment.
+func listOwnedAutomations(ctx context.Context, client api.AutomationsClient, deploymentID uuid.UUID) ([]*api.Automation, error) {
+	owner := deploymentOwnerResource(deploymentID)
+
+	related, err := client.ListRelatedTo(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	owned := make([]*api.Automation, 0, len(related))
+	for _, automation := range related {
+		if automation.OwnerResource != nil && *automation.OwnerResource == owner {
+			owned = append(owned, automation)
+		}
+	}
+
+	return owned, nil
+}
@@ -0,0 +1,201 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+// ModifyPlan validates the parameters and job variables of the deployment,
+// so that invalid values fail the plan, rather than the flow runs scheduled
+// by the deployment later on. Plans destroying or replacing the deployment
+// fail while its deletion protection is enabled.
+func (r *DeploymentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	checkDeletionProtection(ctx, req, resp, "Deployment", "pull_steps")
+
+	// Nothing to validate when the resource is destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateDeploymentParameters(ctx, req.Plan)...)
+	resp.Diagnostics.Append(r.validateDeploymentJobVariables(ctx, req.Plan)...)
+}
+
+// validateDeploymentParameters validates the parameters against the parameter
+// schema of the flow, when the deployment enforces it. Unknown parameters are
+// reported, as flows do not accept them, unless the schema allows them with
+// additionalProperties.
+func validateDeploymentParameters(ctx context.Context, plan tfsdk.Plan) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var enforceParameterSchema types.Bool
+	var parameters, parameterSchema jsontypes.Normalized
+
+	diags.Append(plan.GetAttribute(ctx, path.Root("enforce_parameter_schema"), &enforceParameterSchema)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root("parameters"), &parameters)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root("parameter_openapi_schema"), &parameterSchema)...)
+
+	if diags.HasError() || !enforceParameterSchema.ValueBool() || !isKnownJSON(parameters) || !isKnownJSON(parameterSchema) {
+		return diags
+	}
+
+	var schema map[string]any
+	if err := json.Unmarshal([]byte(parameterSchema.ValueString()), &schema); err != nil {
+		diags.AddAttributeError(
+			path.Root("parameter_openapi_schema"),
+			"Invalid parameter schema",
+			fmt.Sprintf("Could not parse the parameter schema as a JSON object: %s", err),
+		)
+
+		return diags
+	}
+
+	var values any
+	if err := json.Unmarshal([]byte(parameters.ValueString()), &values); err != nil {
+		diags.AddAttributeError(path.Root("parameters"), "Invalid deployment parameters", fmt.Sprintf("Could not parse the parameters as JSON: %s", err))
+
+		return diags
+	}
+
+	// Unknown parameters are reported with the names of the flow's parameters,
+	// unless the schema sets additionalProperties, which is then left to the
+	// schema validation below.
+	if properties, ok := schema["properties"].(map[string]any); ok && schema["additionalProperties"] == nil {
+		if values, ok := values.(map[string]any); ok {
+			for _, name := range slices.Sorted(maps.Keys(values)) {
+				if _, ok := properties[name]; !ok {
+					diags.AddAttributeError(path.Root("parameters"), "Invalid deployment parameter", unknownParameterDetail(name, properties))
+				}
+			}
+		}
+	}
+
+	for _, violation := range helpers.ValidateJSONSchema(schema, values) {
+		subject := "Parameters"
+		if violation.Path != "" {
+			subject = fmt.Sprintf("Parameter `%s`", violation.Path)
+		}
+
+		diags.AddAttributeError(
+			path.Root("parameters"),
+			"Invalid deployment parameter",
+			fmt.Sprintf("%s does not conform to the parameter schema of the flow: %s.", subject, violation.Message),
+		)
+	}
+
+	return diags
+}
+
+// validateDeploymentJobVariables validates the job variables against the
+// `variables` schema of the base job template of the deployment's work pool.
+// Work pools that do not exist yet, such as ones created in the same apply,
+// are not checked.
+func (r *DeploymentResource) validateDeploymentJobVariables(ctx context.Context, plan tfsdk.Plan) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// The client is not configured when the provider configuration is unknown.
+	if r.client == nil {
+		return diags
+	}
+
+	var accountID, workspaceID customtypes.UUIDValue
+	var workPoolName types.String
+	var jobVariables jsontypes.Normalized
+
+	diags.Append(plan.GetAttribute(ctx, path.Root("account_id"), &accountID)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root("workspace_id"), &workspaceID)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root("work_pool_name"), &workPoolName)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root("job_variables"), &jobVariables)...)
+
+	if diags.HasError() || accountID.IsUnknown() || workspaceID.IsUnknown() || workPoolName.IsUnknown() || workPoolName.ValueString() == "" || !isKnownJSON(jobVariables) {
+		return diags
+	}
+
+	var values any
+	if err := json.Unmarshal([]byte(jobVariables.ValueString()), &values); err != nil {
+		diags.AddAttributeError(path.Root("job_variables"), "Invalid deployment job variables", fmt.Sprintf("Could not parse the job variables as JSON: %s", err))
+
+		return diags
+	}
+
+	// Skip fetching the work pool when there is nothing to validate.
+	if values, ok := values.(map[string]any); ok && len(values) == 0 {
+		return diags
+	}
+
+	client, err := r.client.WorkPools(accountID.ValueUUID(), workspaceID.ValueUUID())
+	if err != nil {
+		diags.Append(helpers.CreateClientErrorDiagnostic("Work Pool", err))
+
+		return diags
+	}
+
+	// With the read cache enabled, the work pool is only fetched once for all
+	// of the deployments using it.
+	pool, err := client.Get(ctx, workPoolName.ValueString())
+	if errors.Is(err, api.ErrNotFound) {
+		return diags
+	}
+
+	if err != nil {
+		diags.AddAttributeWarning(
+			path.Root("job_variables"),
+			"Could not validate job variables",
+			fmt.Sprintf("Could not get the %q work pool to validate the job variables against its base job template: %s", workPoolName.ValueString(), err),
+		)
+
+		return diags
+	}
+
+	schema, ok := pool.BaseJobTemplate["variables"].(map[string]any)
+	if !ok {
+		return diags
+	}
+
+	for _, violation := range helpers.ValidateJSONSchema(schema, values) {
+		subject := "Job variables"
+		if violation.Path != "" {
+			subject = fmt.Sprintf("Job variable `%s`", violation.Path)
+		}
+
+		diags.AddAttributeError(
+			path.Root("job_variables"),
+			"Invalid deployment job variable",
+			fmt.Sprintf("%s does not conform to the `variables` schema of the base job template of the %q work pool: %s.", subject, workPoolName.ValueString(), violation.Message),
+		)
+	}
+
+	return diags
+}
+
+// isKnownJSON reports whether a JSON attribute has a value to validate.
+func isKnownJSON(value jsontypes.Normalized) bool {
+	return !value.IsNull() && !value.IsUnknown() && value.ValueString() != ""
+}
+
+// unknownParameterDetail describes a parameter that the flow does not accept.
+func unknownParameterDetail(name string, properties map[string]any) string {
+	if len(properties) == 0 {
+		return fmt.Sprintf("Parameter `%s` is not a parameter of the flow, which has no parameters.", name)
+	}
+
+	names := slices.Sorted(maps.Keys(properties))
+
+	return fmt.Sprintf("Parameter `%s` is not a parameter of the flow. Expected one of: `%s`.", name, strings.Join(names, "`, `"))
+}
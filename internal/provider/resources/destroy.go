@@ -0,0 +1,155 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Values of the `on_destroy` attribute.
+const (
+	onDestroyDelete  = "delete"
+	onDestroyAbandon = "abandon"
+)
+
+// DestroyModel defines the attributes controlling what happens to the remote
+// object when a resource with a high blast radius is destroyed.
+type DestroyModel struct {
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
+	OnDestroy          types.String `tfsdk:"on_destroy"`
+}
+
+// deletionProtectionAttribute returns the schema of the `deletion_protection`
+// attribute. The impact completes the sentence "deleting it ...".
+func deletionProtectionAttribute(objectName, impact string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Description: fmt.Sprintf("Whether to prevent the %s from being deleted, as deleting it %s. "+
+			"While `true`, plans that destroy or replace the resource fail, unless `on_destroy` is `abandon`. "+
+			"Set it to `false` and apply the change before destroying the resource. Defaults to `false`.", objectName, impact),
+		Optional: true,
+		Computed: true,
+		Default:  booldefault.StaticBool(false),
+	}
+}
+
+// onDestroyAttribute returns the schema of the `on_destroy` attribute.
+func onDestroyAttribute(objectName string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: fmt.Sprintf("What happens to the %s when the resource is destroyed: `delete` deletes it, while `abandon` only removes it from the Terraform state, "+
+			"such as when moving its ownership to another Terraform configuration. Defaults to `delete`.", objectName),
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString(onDestroyDelete),
+		Validators: []validator.String{
+			stringvalidator.OneOf(onDestroyDelete, onDestroyAbandon),
+		},
+	}
+}
+
+// setDefaults sets the default values of attributes that are not read from
+// the API, such as after an import, so that they do not show up as changes.
+func (m *DestroyModel) setDefaults() {
+	if m.DeletionProtection.IsNull() {
+		m.DeletionProtection = types.BoolValue(false)
+	}
+
+	if m.OnDestroy.IsNull() {
+		m.OnDestroy = types.StringValue(onDestroyDelete)
+	}
+}
+
+// shouldDelete reports whether Delete should delete the remote object.
+// Abandoned objects are only removed from the Terraform state, while deleting
+// objects with deletion protection fails with an error diagnostic.
+func (m DestroyModel) shouldDelete(ctx context.Context, resourceName string) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if m.OnDestroy.ValueString() == onDestroyAbandon {
+		tflog.Info(ctx, fmt.Sprintf("Abandoning %s, which is removed from the Terraform state without being deleted", resourceName))
+
+		return false, diags
+	}
+
+	if m.DeletionProtection.ValueBool() {
+		diags.Append(deletionProtectionDiagnostic(resourceName))
+
+		return false, diags
+	}
+
+	return true, diags
+}
+
+// checkDeletionProtection fails destroy plans, and plans replacing the
+// resource because one of the replaceAttributes changes, when the state has
+// `deletion_protection` enabled and `on_destroy` is not `abandon`. This
+// reports the error at plan time, while shouldDelete remains as a backstop
+// in Delete.
+func checkDeletionProtection(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, resourceName string, replaceAttributes ...string) {
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	if !req.Plan.Raw.IsNull() && !plansReplacement(req, replaceAttributes) {
+		return
+	}
+
+	var state DestroyModel
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("deletion_protection"), &state.DeletionProtection)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("on_destroy"), &state.OnDestroy)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.DeletionProtection.ValueBool() && state.OnDestroy.ValueString() != onDestroyAbandon {
+		resp.Diagnostics.Append(deletionProtectionDiagnostic(resourceName))
+	}
+}
+
+// plansReplacement reports whether the planned value of any of the attributes,
+// which require the resource to be replaced, differs from its state. The
+// resource's ModifyPlan does not see the replacements requested by attribute
+// plan modifiers, so they are detected here.
+func plansReplacement(req resource.ModifyPlanRequest, attributes []string) bool {
+	for _, name := range attributes {
+		attributePath := tftypes.NewAttributePath().WithAttributeName(name)
+
+		planned, _, planErr := tftypes.WalkAttributePath(req.Plan.Raw, attributePath)
+		prior, _, stateErr := tftypes.WalkAttributePath(req.State.Raw, attributePath)
+
+		if planErr != nil || stateErr != nil {
+			continue
+		}
+
+		plannedValue, plannedOK := planned.(tftypes.Value)
+		priorValue, priorOK := prior.(tftypes.Value)
+
+		if plannedOK && priorOK && !plannedValue.Equal(priorValue) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// deletionProtectionDiagnostic returns the error reported when deleting a
+// resource with deletion protection enabled.
+func deletionProtectionDiagnostic(resourceName string) diag.Diagnostic {
+	return diag.NewAttributeErrorDiagnostic(
+		path.Root("deletion_protection"),
+		"Deletion protection is enabled",
+		fmt.Sprintf("Cannot delete %s, as `deletion_protection` is enabled. "+
+			"Set `deletion_protection = false` and apply the change before destroying or replacing it, or set `on_destroy = \"abandon\"` to only remove it from the Terraform state.", resourceName),
+	)
+}
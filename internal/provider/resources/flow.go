@@ -2,6 +2,7 @@ package resources
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/google/uuid"
@@ -24,6 +25,8 @@ import (
 var (
 	_ = resource.ResourceWithConfigure(&FlowResource{})
 	_ = resource.ResourceWithImportState(&FlowResource{})
+	_ = resource.ResourceWithIdentity(&FlowResource{})
+	_ = resource.ResourceWithModifyPlan(&FlowResource{})
 )
 
 // FlowResource contains state for the resource.
@@ -34,6 +37,7 @@ type FlowResource struct {
 // FlowResourceModel defines the Terraform resource model.
 type FlowResourceModel struct {
 	BaseModel
+	DestroyModel
 
 	WorkspaceID customtypes.UUIDValue `tfsdk:"workspace_id"`
 	AccountID   customtypes.UUIDValue `tfsdk:"account_id"`
@@ -73,6 +77,12 @@ func (r *FlowResource) Configure(_ context.Context, req resource.ConfigureReques
 	r.client = client
 }
 
+// ModifyPlan fails plans destroying the flow while its deletion protection
+// is enabled.
+func (r *FlowResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	checkDeletionProtection(ctx, req, resp, "Flow")
+}
+
 // Schema defines the schema for the resource.
 func (r *FlowResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	defaultEmptyTagList, _ := basetypes.NewListValue(types.StringType, []attr.Value{})
@@ -129,10 +139,17 @@ func (r *FlowResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Computed:    true,
 				Default:     listdefault.StaticValue(defaultEmptyTagList),
 			},
+			"deletion_protection": deletionProtectionAttribute("flow", "also deletes its deployments"),
+			"on_destroy":          onDestroyAttribute("flow"),
 		},
 	}
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (r *FlowResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("Flow ID (UUID)")
+}
+
 // copyFlowToModel copies an api.Flow to a FlowResourceModel.
 func copyFlowToModel(ctx context.Context, flow *api.Flow, model *FlowResourceModel) diag.Diagnostics {
 	model.ID = types.StringValue(flow.ID.String())
@@ -188,6 +205,11 @@ func (r *FlowResource) Create(ctx context.Context, req resource.CreateRequest, r
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IDIdentityModel{
+		AccountID:   plan.AccountID,
+		WorkspaceID: plan.WorkspaceID,
+		ID:          plan.ID,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -230,6 +252,12 @@ func (r *FlowResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			"Error refreshing flow state",
 			fmt.Sprintf("Could not read Flow, unexpected error: %s", err.Error()),
@@ -243,7 +271,14 @@ func (r *FlowResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	model.setDefaults()
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IDIdentityModel{
+		AccountID:   model.AccountID,
+		WorkspaceID: model.WorkspaceID,
+		ID:          model.ID,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -304,6 +339,11 @@ func (r *FlowResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IDIdentityModel{
+		AccountID:   plan.AccountID,
+		WorkspaceID: plan.WorkspaceID,
+		ID:          plan.ID,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -318,6 +358,12 @@ func (r *FlowResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
+	shouldDelete, diags := state.shouldDelete(ctx, "Flow")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || !shouldDelete {
+		return
+	}
+
 	client, err := r.client.Flows(state.AccountID.ValueUUID(), state.WorkspaceID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Flow", err))
@@ -350,6 +396,18 @@ func (r *FlowResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 }
 
 // ImportState imports the resource into Terraform state.
+//
+// Allows input values in the form of:
+// - "id,workspace_id" or "id"
+// - a link to the flow in the Prefect UI
+//
+// or the identity set in an import block.
 func (r *FlowResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if helpers.IsUIURL(req.ID) {
+		helpers.ImportStateFromUIURL(ctx, r.client, req, resp, helpers.UIObjectFlow, "id")
+
+		return
+	}
+
 	helpers.ImportStateByID(ctx, req, resp)
 }
@@ -2,6 +2,8 @@ package resources
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
@@ -23,6 +25,7 @@ import (
 var (
 	_ = resource.ResourceWithConfigure(&GlobalConcurrencyLimitResource{})
 	_ = resource.ResourceWithImportState(&GlobalConcurrencyLimitResource{})
+	_ = resource.ResourceWithIdentity(&GlobalConcurrencyLimitResource{})
 )
 
 // GlobalConcurrencyLimitResource contains state for the resource.
@@ -43,6 +46,7 @@ type GlobalConcurrencyLimitResourceModel struct {
 
 	ActiveSlots        types.Int64   `tfsdk:"active_slots"`
 	SlotDecayPerSecond types.Float64 `tfsdk:"slot_decay_per_second"`
+	AdoptExisting      types.Bool    `tfsdk:"adopt_existing"`
 }
 
 // NewGlobalConcurrencyLimitResource returns a new GlobalConcurrencyLimitResource.
@@ -147,10 +151,16 @@ func (r *GlobalConcurrencyLimitResource) Schema(_ context.Context, _ resource.Sc
 					float64validator.AtLeast(0),
 				},
 			},
+			"adopt_existing": adoptExistingAttribute("global concurrency limit"),
 		},
 	}
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (r *GlobalConcurrencyLimitResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("Global concurrency limit ID (UUID)")
+}
+
 // Create creates a new global concurrency limit.
 func (r *GlobalConcurrencyLimitResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan GlobalConcurrencyLimitResourceModel
@@ -167,15 +177,20 @@ func (r *GlobalConcurrencyLimitResource) Create(ctx context.Context, req resourc
 		return
 	}
 
-	globalConcurrencyLimit, err := client.Create(ctx, api.GlobalConcurrencyLimitCreate{
+	payload := api.GlobalConcurrencyLimitCreate{
 		Name:               plan.Name.ValueString(),
 		Limit:              plan.Limit.ValueInt64(),
 		Active:             plan.Active.ValueBool(),
 		ActiveSlots:        plan.ActiveSlots.ValueInt64(),
 		SlotDecayPerSecond: plan.SlotDecayPerSecond.ValueFloat64(),
-	})
+	}
+
+	globalConcurrencyLimit, err := client.Create(ctx, payload)
+	if shouldAdopt(r.client, plan.AdoptExisting, err) {
+		globalConcurrencyLimit, err = adoptGlobalConcurrencyLimit(ctx, client, payload)
+	}
 	if err != nil {
-		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Global Concurrency Limit", "create", err))
+		resp.Diagnostics.Append(createErrorDiagnostic("Global Concurrency Limit", err))
 
 		return
 	}
@@ -183,11 +198,40 @@ func (r *GlobalConcurrencyLimitResource) Create(ctx context.Context, req resourc
 	copyGlobalConcurrencyLimitToModel(globalConcurrencyLimit, &plan)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IDIdentityModel{
+		AccountID:   plan.AccountID,
+		WorkspaceID: plan.WorkspaceID,
+		ID:          plan.ID,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 }
 
+// adoptGlobalConcurrencyLimit updates an existing global concurrency limit
+// with the same name to match the create payload, and returns it.
+func adoptGlobalConcurrencyLimit(ctx context.Context, client api.GlobalConcurrencyLimitsClient, payload api.GlobalConcurrencyLimitCreate) (*api.GlobalConcurrencyLimit, error) {
+	// The API looks up global concurrency limits by either ID or name.
+	globalConcurrencyLimit, err := client.Read(ctx, payload.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get existing global concurrency limit: %w", err)
+	}
+
+	globalConcurrencyLimitID := globalConcurrencyLimit.ID.String()
+
+	err = client.Update(ctx, globalConcurrencyLimitID, api.GlobalConcurrencyLimitUpdate(payload))
+	if err != nil {
+		return nil, fmt.Errorf("failed to update existing global concurrency limit: %w", err)
+	}
+
+	globalConcurrencyLimit, err = client.Read(ctx, globalConcurrencyLimitID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get existing global concurrency limit: %w", err)
+	}
+
+	return globalConcurrencyLimit, nil
+}
+
 func copyGlobalConcurrencyLimitToModel(globalConcurrencyLimit *api.GlobalConcurrencyLimit, model *GlobalConcurrencyLimitResourceModel) diag.Diagnostics {
 	model.ID = types.StringValue(globalConcurrencyLimit.ID.String())
 	model.Created = customtypes.NewTimestampValue(*globalConcurrencyLimit.Created)
@@ -247,6 +291,12 @@ func (r *GlobalConcurrencyLimitResource) Read(ctx context.Context, req resource.
 
 	globalConcurrencyLimit, err := client.Read(ctx, state.ID.ValueString())
 	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Global Concurrency Limit", "read", err))
 
 		return
@@ -255,6 +305,11 @@ func (r *GlobalConcurrencyLimitResource) Read(ctx context.Context, req resource.
 	copyGlobalConcurrencyLimitToModel(globalConcurrencyLimit, &state)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IDIdentityModel{
+		AccountID:   state.AccountID,
+		WorkspaceID: state.WorkspaceID,
+		ID:          state.ID,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -299,6 +354,11 @@ func (r *GlobalConcurrencyLimitResource) Update(ctx context.Context, req resourc
 	copyGlobalConcurrencyLimitToModel(globalConcurrencyLimit, &plan)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IDIdentityModel{
+		AccountID:   plan.AccountID,
+		WorkspaceID: plan.WorkspaceID,
+		ID:          plan.ID,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
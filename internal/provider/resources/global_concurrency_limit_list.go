@@ -0,0 +1,72 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var _ = list.ListResourceWithConfigure(&GlobalConcurrencyLimitResource{})
+
+// NewGlobalConcurrencyLimitListResource returns a new GlobalConcurrencyLimitResource as a list resource.
+//
+//nolint:ireturn // required by Terraform API
+func NewGlobalConcurrencyLimitListResource() list.ListResource {
+	return &GlobalConcurrencyLimitResource{}
+}
+
+// ListResourceConfigSchema defines the schema of the list resource configuration.
+func (r *GlobalConcurrencyLimitResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = workspaceListSchema("Lists the Global Concurrency Limits of a workspace.", nil)
+}
+
+// List streams the Global Concurrency Limits of a workspace.
+func (r *GlobalConcurrencyLimitResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config WorkspaceListModel
+
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	client, err := r.client.GlobalConcurrencyLimits(config.AccountID.ValueUUID(), config.WorkspaceID.ValueUUID())
+	if err != nil {
+		diags.Append(helpers.CreateClientErrorDiagnostic("Global Concurrency Limit", err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	limits := client.Iterate(ctx, listOptions(req))
+
+	streamListResults(ctx, req, stream, "Global Concurrency Limit", limits, func(limit *api.GlobalConcurrencyLimit, result *list.ListResult) {
+		result.DisplayName = limit.Name
+
+		result.Diagnostics.Append(result.Identity.Set(ctx, IDIdentityModel{
+			AccountID:   config.AccountID,
+			WorkspaceID: config.WorkspaceID,
+			ID:          types.StringValue(limit.ID.String()),
+		})...)
+		if result.Diagnostics.HasError() || !req.IncludeResource {
+			return
+		}
+
+		var model GlobalConcurrencyLimitResourceModel
+		result.Diagnostics.Append(getNullResource(ctx, result.Resource, &model)...)
+		if result.Diagnostics.HasError() {
+			return
+		}
+
+		model.AccountID = config.AccountID
+		model.WorkspaceID = config.WorkspaceID
+
+		result.Diagnostics.Append(copyGlobalConcurrencyLimitToModel(limit, &model)...)
+		result.Diagnostics.Append(result.Resource.Set(ctx, model)...)
+	})
+}
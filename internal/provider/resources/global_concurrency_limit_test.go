@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
 )
 
@@ -23,6 +24,31 @@ resource "prefect_global_concurrency_limit" "global_concurrency_limit" {
 `, workspace, name, limit, active, activeSlots, slotDecayPerSecond)
 }
 
+func fixtureAccGlobalConcurrencyLimitForget(workspace string) string {
+	return fmt.Sprintf(`
+%s
+removed {
+	from = prefect_global_concurrency_limit.global_concurrency_limit
+
+	lifecycle {
+		destroy = false
+	}
+}
+`, workspace)
+}
+
+func fixtureAccGlobalConcurrencyLimitAdopt(workspace, name string, limit int64) string {
+	return fmt.Sprintf(`
+%s
+resource "prefect_global_concurrency_limit" "adopted" {
+	workspace_id = prefect_workspace.test.id
+	name = "%s"
+	limit = %d
+	adopt_existing = true
+}
+`, workspace, name, limit)
+}
+
 //nolint:paralleltest // we use the resource.ParallelTest helper instead
 func TestAccResource_global_concurrency_limit(t *testing.T) {
 	resourceName := "prefect_global_concurrency_limit.global_concurrency_limit"
@@ -64,3 +90,35 @@ func TestAccResource_global_concurrency_limit(t *testing.T) {
 		},
 	})
 }
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_global_concurrency_limit_adopt_existing(t *testing.T) {
+	resourceName := "prefect_global_concurrency_limit.adopted"
+	workspace := testutils.NewEphemeralWorkspace()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_7_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fixtureAccGlobalConcurrencyLimitCreate(workspace.Resource, "test", 10, true, 0, 1.5),
+			},
+			{
+				// Remove the limit from state, leaving it in the workspace
+				Config: fixtureAccGlobalConcurrencyLimitForget(workspace.Resource),
+			},
+			{
+				// Check that the existing limit is adopted and updated, instead of failing
+				Config: fixtureAccGlobalConcurrencyLimitAdopt(workspace.Resource, "test", 5),
+				ConfigStateChecks: []statecheck.StateCheck{
+					testutils.ExpectKnownValue(resourceName, "name", "test"),
+					testutils.ExpectKnownValueNumber(resourceName, "limit", 5),
+					testutils.ExpectKnownValueFloat(resourceName, "slot_decay_per_second", 0),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,118 @@
+package resources
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+)
+
+// IDIdentityModel defines the resource identity of an object
+// identified by its ID within a workspace.
+type IDIdentityModel struct {
+	AccountID   customtypes.UUIDValue `tfsdk:"account_id"`
+	WorkspaceID customtypes.UUIDValue `tfsdk:"workspace_id"`
+	ID          types.String          `tfsdk:"id"`
+}
+
+// NameIdentityModel defines the resource identity of an object
+// identified by its name within a workspace.
+type NameIdentityModel struct {
+	AccountID   customtypes.UUIDValue `tfsdk:"account_id"`
+	WorkspaceID customtypes.UUIDValue `tfsdk:"workspace_id"`
+	Name        types.String          `tfsdk:"name"`
+}
+
+// AccountScopedIDIdentityModel defines the resource identity of an object
+// identified by its ID within an account.
+type AccountScopedIDIdentityModel struct {
+	AccountID customtypes.UUIDValue `tfsdk:"account_id"`
+	ID        types.String          `tfsdk:"id"`
+}
+
+// UnscopedIDIdentityModel defines the resource identity of an object
+// identified by its ID alone.
+type UnscopedIDIdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
+// accountIdentitySchema returns an identity schema made of the given
+// attributes, which identify an object within an account, and the optional
+// account_id attribute, which defaults to the provider's.
+func accountIdentitySchema(attributes map[string]identityschema.Attribute) identityschema.Schema {
+	attributes["account_id"] = identityschema.StringAttribute{
+		CustomType:        customtypes.UUIDType{},
+		OptionalForImport: true,
+		Description:       "Account ID (UUID), defaults to the account set in the provider",
+	}
+
+	return identityschema.Schema{Attributes: attributes}
+}
+
+// workspaceIdentitySchema returns an identity schema made of the given
+// attributes, which identify an object within a workspace, and the optional
+// account_id and workspace_id attributes, which default to the provider's.
+func workspaceIdentitySchema(attributes map[string]identityschema.Attribute) identityschema.Schema {
+	attributes["workspace_id"] = identityschema.StringAttribute{
+		CustomType:        customtypes.UUIDType{},
+		OptionalForImport: true,
+		Description:       "Workspace ID (UUID), defaults to the workspace set in the provider",
+	}
+
+	return accountIdentitySchema(attributes)
+}
+
+// idIdentitySchema returns the identity schema of an object identified by
+// its ID within a workspace.
+func idIdentitySchema(description string) identityschema.Schema {
+	return workspaceIdentitySchema(map[string]identityschema.Attribute{
+		"id": identityschema.StringAttribute{
+			RequiredForImport: true,
+			Description:       description,
+		},
+	})
+}
+
+// nameIdentitySchema returns the identity schema of an object identified
+// by its name within a workspace.
+func nameIdentitySchema(description string) identityschema.Schema {
+	return workspaceIdentitySchema(map[string]identityschema.Attribute{
+		"name": identityschema.StringAttribute{
+			RequiredForImport: true,
+			Description:       description,
+		},
+	})
+}
+
+// accountScopedIDIdentitySchema returns the identity schema of an object
+// identified by its ID within an account.
+func accountScopedIDIdentitySchema(description string) identityschema.Schema {
+	return accountIdentitySchema(map[string]identityschema.Attribute{
+		"id": identityschema.StringAttribute{
+			RequiredForImport: true,
+			Description:       description,
+		},
+	})
+}
+
+// unscopedIDIdentitySchema returns the identity schema of an object
+// identified by its ID alone.
+func unscopedIDIdentitySchema(description string) identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       description,
+			},
+		},
+	}
+}
+
+// uuidIdentityAttribute returns a required identity attribute holding a UUID.
+func uuidIdentityAttribute(description string) identityschema.StringAttribute {
+	return identityschema.StringAttribute{
+		CustomType:        customtypes.UUIDType{},
+		RequiredForImport: true,
+		Description:       description,
+	}
+}
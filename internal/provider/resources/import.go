@@ -0,0 +1,83 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+// isImportPath reports whether an import identifier is a human-readable
+// path of names, such as "workspace_handle/flow_name/deployment_name".
+func isImportPath(id string) bool {
+	return strings.Contains(id, "/") && !helpers.IsUIURL(id)
+}
+
+// splitImportPath splits a human-readable import path into the names of an
+// object, optionally preceded by the handle of its workspace. The handle is
+// resolved to the ID of the workspace, which is uuid.Nil when the path does
+// not start with a handle, so that the provider's workspace is used.
+func splitImportPath(ctx context.Context, client api.PrefectClient, req resource.ImportStateRequest, format string) (uuid.UUID, []string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	names := strings.Split(req.ID, "/")
+	nameCount := strings.Count(format, "/") + 1
+
+	if (len(names) != nameCount && len(names) != nameCount+1) || slices.Contains(names, "") {
+		diags.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected an import path in the form of `%s` or `workspace_handle/%s`. Got %q", format, format, req.ID),
+		)
+
+		return uuid.Nil, nil, diags
+	}
+
+	if len(names) == nameCount {
+		return uuid.Nil, names, diags
+	}
+
+	workspaces, err := client.Workspaces(uuid.Nil)
+	if err != nil {
+		diags.Append(helpers.CreateClientErrorDiagnostic("Workspace", err))
+
+		return uuid.Nil, nil, diags
+	}
+
+	matches, err := workspaces.List(ctx, []string{names[0]})
+	if err != nil {
+		diags.Append(helpers.ResourceClientErrorDiagnostic("Workspace", "list", err))
+
+		return uuid.Nil, nil, diags
+	}
+
+	if len(matches) != 1 {
+		diags.AddError(
+			"Workspace not found",
+			fmt.Sprintf("Could not find a workspace with the handle %q in the provider's account, to import %q", names[0], req.ID),
+		)
+
+		return uuid.Nil, nil, diags
+	}
+
+	return matches[0].ID, names[1:], diags
+}
+
+// setImportPathWorkspaceID sets the workspace_id of a resource imported by
+// path to the workspace of its handle. It is left unset when the path has no
+// handle, or when the handle is that of the provider's workspace, so that
+// configurations relying on the provider's workspace do not plan to remove it.
+func setImportPathWorkspaceID(ctx context.Context, client api.PrefectClient, resp *resource.ImportStateResponse, workspaceID uuid.UUID) {
+	if workspaceID == uuid.Nil || workspaceID == client.DefaultWorkspaceID() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace_id"), workspaceID.String())...)
+}
@@ -0,0 +1,124 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+// WorkspaceListModel defines the configuration of a list resource
+// that discovers the objects of a workspace.
+type WorkspaceListModel struct {
+	AccountID   customtypes.UUIDValue `tfsdk:"account_id"`
+	WorkspaceID customtypes.UUIDValue `tfsdk:"workspace_id"`
+}
+
+// workspaceListSchema returns a list resource configuration schema made of
+// the given attributes and the optional account_id and workspace_id
+// attributes, which default to the provider's.
+func workspaceListSchema(description string, attributes map[string]listschema.Attribute) listschema.Schema {
+	if attributes == nil {
+		attributes = map[string]listschema.Attribute{}
+	}
+
+	attributes["account_id"] = listschema.StringAttribute{
+		CustomType:  customtypes.UUIDType{},
+		Optional:    true,
+		Description: "Account ID (UUID), defaults to the account set in the provider",
+	}
+	attributes["workspace_id"] = listschema.StringAttribute{
+		CustomType:  customtypes.UUIDType{},
+		Optional:    true,
+		Description: "Workspace ID (UUID), defaults to the workspace set in the provider",
+	}
+
+	return listschema.Schema{
+		Description: description,
+		Attributes:  attributes,
+	}
+}
+
+// streamListResults streams the objects yielded by an Iterate method as list
+// results, filling each result with the given function. An error from the
+// API ends the stream with an error diagnostic.
+func streamListResults[T any](
+	ctx context.Context,
+	req list.ListRequest,
+	stream *list.ListResultsStream,
+	resourceName string,
+	objects iter.Seq2[T, error],
+	fill func(object T, result *list.ListResult),
+) {
+	stream.Results = func(push func(list.ListResult) bool) {
+		for object, err := range objects {
+			result := req.NewListResult(ctx)
+
+			if err != nil {
+				result.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic(resourceName, "list", err))
+				push(result)
+
+				return
+			}
+
+			fill(object, &result)
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+// listOptions returns the API list options for a list request.
+func listOptions(req list.ListRequest) api.ListOptions {
+	return api.ListOptions{Limit: req.Limit}
+}
+
+// setNullResource sets a resource to an object whose attributes are all
+// null, like the state of a resource being imported.
+func setNullResource(ctx context.Context, resource *tfsdk.Resource) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	objectType, ok := resource.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	if !ok {
+		diags.AddError(
+			"Unexpected resource schema type",
+			fmt.Sprintf("Expected an object type, got %T. This is a bug in the Terraform provider. Please report it to the maintainers.", resource.Schema.Type()),
+		)
+
+		return diags
+	}
+
+	attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+	}
+
+	resource.Raw = tftypes.NewValue(objectType, attributes)
+
+	return diags
+}
+
+// getNullResource populates a resource model from a resource whose
+// attributes are all null, so that the attributes an API object does
+// not cover hold typed null values.
+func getNullResource(ctx context.Context, resource *tfsdk.Resource, target any) diag.Diagnostics {
+	diags := setNullResource(ctx, resource)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(resource.Get(ctx, target)...)
+
+	return diags
+}
@@ -0,0 +1,128 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
+)
+
+func fixtureAccListResources(workspace, name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "prefect_work_pool" "test" {
+	name = "%s"
+	type = "kubernetes"
+	workspace_id = prefect_workspace.test.id
+}
+
+resource "prefect_work_queue" "test" {
+	name = "%s"
+	work_pool_name = prefect_work_pool.test.name
+	workspace_id = prefect_workspace.test.id
+}
+
+resource "prefect_variable" "test" {
+	name = "%s"
+	value = "hello"
+	workspace_id = prefect_workspace.test.id
+}
+
+resource "prefect_global_concurrency_limit" "test" {
+	name = "%s"
+	limit = 1
+	workspace_id = prefect_workspace.test.id
+}
+`, workspace, name, name, name, name)
+}
+
+func fixtureAccListResourcesQuery(name string) string {
+	return fmt.Sprintf(`
+variable "workspace_id" {
+	type = string
+}
+
+list "prefect_work_pool" "test" {
+	provider = prefect
+	include_resource = true
+
+	config {
+		workspace_id = var.workspace_id
+	}
+}
+
+list "prefect_work_queue" "test" {
+	provider = prefect
+
+	config {
+		workspace_id = var.workspace_id
+		work_pool_name = "%s"
+	}
+}
+
+list "prefect_variable" "test" {
+	provider = prefect
+
+	config {
+		workspace_id = var.workspace_id
+	}
+}
+
+list "prefect_global_concurrency_limit" "test" {
+	provider = prefect
+
+	config {
+		workspace_id = var.workspace_id
+	}
+}
+`, name)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccListResources(t *testing.T) {
+	workspace := testutils.NewEphemeralWorkspace()
+	name := testutils.NewRandomPrefixedString()
+
+	// List blocks cannot reference resources, so the ID of the workspace
+	// created by the first step is passed to the query as a variable.
+	workspaceID := &testutils.StateValueVariable{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fixtureAccListResources(workspace.Resource, name),
+				Check:  workspaceID.Capture(testutils.WorkspaceResourceName, "id"),
+			},
+			{
+				Query:  true,
+				Config: fixtureAccListResourcesQuery(name),
+				ConfigVariables: config.Variables{
+					"workspace_id": workspaceID,
+				},
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("prefect_work_pool.test", 1),
+					querycheck.ExpectIdentity("prefect_work_pool.test", map[string]knownvalue.Check{
+						"account_id":   knownvalue.Null(),
+						"workspace_id": knownvalue.NotNull(),
+						"name":         knownvalue.StringExact(name),
+					}),
+					// The default queue is created along with the work pool.
+					querycheck.ExpectLength("prefect_work_queue.test", 2),
+					querycheck.ExpectLength("prefect_variable.test", 1),
+					querycheck.ExpectLength("prefect_global_concurrency_limit.test", 1),
+				},
+			},
+		},
+	})
+}
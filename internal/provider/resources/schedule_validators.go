@@ -0,0 +1,81 @@
+package resources
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var _ = validator.String(scheduleStringValidator{})
+
+// scheduleStringValidator validates a string attribute of a schedule locally,
+// so that invalid schedules fail the plan rather than the apply.
+type scheduleStringValidator struct {
+	description string
+	validate    func(value string) error
+}
+
+// cronValidator validates cron expressions as accepted by Prefect's cron schedules.
+func cronValidator() validator.String {
+	return scheduleStringValidator{
+		description: "value must be a valid cron expression",
+		validate: func(value string) error {
+			err := helpers.ParseCron(value)
+
+			return err
+		},
+	}
+}
+
+// rruleValidator validates the rules of Prefect's RRule schedules.
+func rruleValidator() validator.String {
+	return scheduleStringValidator{
+		description: "value must be a valid RRule",
+		validate:    helpers.ParseRRule,
+	}
+}
+
+// timezoneValidator validates IANA timezone names.
+func timezoneValidator() validator.String {
+	return scheduleStringValidator{
+		description: "value must be an IANA timezone name",
+		validate: func(value string) error {
+			_, err := helpers.LoadTimezone(value)
+
+			return err
+		},
+	}
+}
+
+// anchorDateValidator validates the anchor dates of interval schedules.
+func anchorDateValidator() validator.String {
+	return scheduleStringValidator{
+		description: "value must be an ISO 8601 timestamp",
+		validate: func(value string) error {
+			_, err := helpers.ParseAnchorDate(value, time.UTC)
+
+			return err
+		},
+	}
+}
+
+func (v scheduleStringValidator) Description(_ context.Context) string {
+	return v.description
+}
+
+func (v scheduleStringValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v scheduleStringValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := v.validate(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid schedule", err.Error())
+	}
+}
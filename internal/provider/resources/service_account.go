@@ -2,6 +2,7 @@ package resources
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"maps"
 	"strings"
@@ -31,7 +32,9 @@ const (
 
 var (
 	_ = resource.ResourceWithConfigure(&ServiceAccountResource{})
+	_ = resource.ResourceWithModifyPlan(&ServiceAccountResource{})
 	_ = resource.ResourceWithImportState(&ServiceAccountResource{})
+	_ = resource.ResourceWithIdentity(&ServiceAccountResource{})
 )
 
 type ServiceAccountResource struct {
@@ -87,6 +90,16 @@ func (r *ServiceAccountResource) Configure(_ context.Context, req resource.Confi
 	r.client = client
 }
 
+// ModifyPlan checks that the server supports the resource when it is created.
+// See helpers.CheckServerCapability.
+func (r *ServiceAccountResource) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || !helpers.IsCreate(req) {
+		return
+	}
+
+	resp.Diagnostics.Append(helpers.CheckServerCapability(r.client.ServerInfo(), "The prefect_service_account resource", "", helpers.PlanPrefectCloudPro, helpers.PlanPrefectCloudEnterprise)...)
+}
+
 func (r *ServiceAccountResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: helpers.DescriptionWithPlans(
@@ -189,6 +202,11 @@ func (r *ServiceAccountResource) Schema(_ context.Context, _ resource.SchemaRequ
 	}
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (r *ServiceAccountResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = accountScopedIDIdentitySchema("Service account ID (UUID)")
+}
+
 // copyServiceAccountToModel maps an API response to a model that is saved in Terraform state.
 // A model can be a Terraform Plan, State, or Config object.
 func copyServiceAccountToModel(serviceAccount *api.ServiceAccount, tfModel *ServiceAccountResourceModel) {
@@ -280,6 +298,10 @@ func (r *ServiceAccountResource) Create(ctx context.Context, req resource.Create
 	plan.APIKey = types.StringValue(serviceAccount.APIKey.Key)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, AccountScopedIDIdentityModel{
+		AccountID: plan.AccountID,
+		ID:        plan.ID,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -344,6 +366,12 @@ func (r *ServiceAccountResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Service Account", operation, err))
 
 		return
@@ -352,6 +380,10 @@ func (r *ServiceAccountResource) Read(ctx context.Context, req resource.ReadRequ
 	copyServiceAccountToModel(serviceAccount, &state)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, AccountScopedIDIdentityModel{
+		AccountID: state.AccountID,
+		ID:        state.ID,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -474,6 +506,10 @@ func (r *ServiceAccountResource) Update(ctx context.Context, req resource.Update
 	plan.APIKey = types.StringValue(apiKey)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, AccountScopedIDIdentityModel{
+		AccountID: plan.AccountID,
+		ID:        plan.ID,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -510,6 +546,12 @@ func (r *ServiceAccountResource) Delete(ctx context.Context, req resource.Delete
 
 // ImportState imports the resource into Terraform state.
 func (r *ServiceAccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if helpers.IsImportByIdentity(req) {
+		helpers.ImportStateFromIdentity(ctx, req, resp)
+
+		return
+	}
+
 	if strings.HasPrefix(req.ID, "name/") {
 		name := strings.TrimPrefix(req.ID, "name/")
 		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
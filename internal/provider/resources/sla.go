@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -16,7 +17,12 @@ import (
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
 )
 
-var _ = resource.ResourceWithConfigure(&SLAResource{})
+var (
+	_ = resource.ResourceWithConfigure(&SLAResource{})
+	_ = resource.ResourceWithModifyPlan(&SLAResource{})
+	_ = resource.ResourceWithImportState(&SLAResource{})
+	_ = resource.ResourceWithIdentity(&SLAResource{})
+)
 
 type SLAResource struct {
 	client api.PrefectClient
@@ -42,6 +48,13 @@ type SLAModel struct {
 	ResourceMatch jsontypes.Normalized `tfsdk:"resource_match"`
 }
 
+// SLAResourceIdentityModel defines the Terraform resource identity model.
+type SLAResourceIdentityModel struct {
+	AccountID   customtypes.UUIDValue `tfsdk:"account_id"`
+	WorkspaceID customtypes.UUIDValue `tfsdk:"workspace_id"`
+	ResourceID  types.String          `tfsdk:"resource_id"`
+}
+
 // NewSLAResource returns a new SLAResource.
 //
 //nolint:ireturn // required by Terraform API
@@ -51,6 +64,7 @@ func NewSLAResource() resource.Resource {
 
 func (r *SLAResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_resource_sla"
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (r *SLAResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -71,6 +85,16 @@ func (r *SLAResource) Configure(_ context.Context, req resource.ConfigureRequest
 	r.client = client
 }
 
+// ModifyPlan checks that the server supports the resource when it is created.
+// See helpers.CheckServerCapability.
+func (r *SLAResource) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || !helpers.IsCreate(req) {
+		return
+	}
+
+	resp.Diagnostics.Append(helpers.CheckServerCapability(r.client.ServerInfo(), "The prefect_resource_sla resource", "", helpers.AllCloudPlans...)...)
+}
+
 func (r *SLAResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: helpers.DescriptionWithPlans(`
@@ -148,6 +172,16 @@ For more information, see documentation on setting up [Service Level Agreements]
 	}
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (r *SLAResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = workspaceIdentitySchema(map[string]identityschema.Attribute{
+		"resource_id": identityschema.StringAttribute{
+			RequiredForImport: true,
+			Description:       "ID of the resource the SLAs apply to, such as prefect.flow.<flow-id>",
+		},
+	})
+}
+
 // convertSLAModelToSLAUpsertPayload converts a SLAModel to an api.SLAUpsert payload.
 func convertSLAModelToSLAUpsertPayload(model SLAModel) (api.SLAUpsert, diag.Diagnostics) {
 	sla := api.SLAUpsert{
@@ -213,6 +247,11 @@ func (r *SLAResource) Create(ctx context.Context, req resource.CreateRequest, re
 
 	// Save the plan
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, SLAResourceIdentityModel{
+		AccountID:   plan.AccountID,
+		WorkspaceID: plan.WorkspaceID,
+		ResourceID:  plan.ResourceID,
+	})...)
 }
 
 func (r *SLAResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -225,6 +264,11 @@ func (r *SLAResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, SLAResourceIdentityModel{
+		AccountID:   state.AccountID,
+		WorkspaceID: state.WorkspaceID,
+		ResourceID:  state.ResourceID,
+	})...)
 }
 
 func (r *SLAResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -267,6 +311,11 @@ func (r *SLAResource) Update(ctx context.Context, req resource.UpdateRequest, re
 
 	// Save the plan
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, SLAResourceIdentityModel{
+		AccountID:   plan.AccountID,
+		WorkspaceID: plan.WorkspaceID,
+		ResourceID:  plan.ResourceID,
+	})...)
 }
 
 func (r *SLAResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -294,3 +343,9 @@ func (r *SLAResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 		return
 	}
 }
+
+// ImportState imports the resource into Terraform state from the identity
+// set in an import block.
+func (r *SLAResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	helpers.ImportStateByIdentity(ctx, req, resp)
+}
@@ -2,6 +2,7 @@ package resources
 
 import (
 	"context"
+	"errors"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -18,6 +19,7 @@ import (
 var (
 	_ = resource.ResourceWithConfigure(&TaskRunConcurrencyLimitResource{})
 	_ = resource.ResourceWithImportState(&TaskRunConcurrencyLimitResource{})
+	_ = resource.ResourceWithIdentity(&TaskRunConcurrencyLimitResource{})
 )
 
 // TaskRunConcurrencyLimitResource contains state for the resource.
@@ -125,6 +127,11 @@ func (r *TaskRunConcurrencyLimitResource) Schema(_ context.Context, _ resource.S
 	}
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (r *TaskRunConcurrencyLimitResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("Task run concurrency limit ID (UUID)")
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *TaskRunConcurrencyLimitResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan TaskRunConcurrencyLimitResourceModel
@@ -155,6 +162,11 @@ func (r *TaskRunConcurrencyLimitResource) Create(ctx context.Context, req resour
 	copyTaskRunConcurrencyLimitToModel(taskRunConcurrencyLimit, &plan)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IDIdentityModel{
+		AccountID:   plan.AccountID,
+		WorkspaceID: plan.WorkspaceID,
+		ID:          plan.ID,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -212,6 +224,12 @@ func (r *TaskRunConcurrencyLimitResource) Read(ctx context.Context, req resource
 
 	taskRunConcurrencyLimit, err := client.Read(ctx, state.ID.ValueString())
 	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Task Run Concurrency Limit", "get", err))
 
 		return
@@ -220,6 +238,11 @@ func (r *TaskRunConcurrencyLimitResource) Read(ctx context.Context, req resource
 	copyTaskRunConcurrencyLimitToModel(taskRunConcurrencyLimit, &state)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IDIdentityModel{
+		AccountID:   state.AccountID,
+		WorkspaceID: state.WorkspaceID,
+		ID:          state.ID,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
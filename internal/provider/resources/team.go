@@ -2,6 +2,7 @@ package resources
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -17,7 +18,9 @@ import (
 
 var (
 	_ = resource.ResourceWithConfigure(&TeamResource{})
+	_ = resource.ResourceWithModifyPlan(&TeamResource{})
 	_ = resource.ResourceWithImportState(&TeamResource{})
+	_ = resource.ResourceWithIdentity(&TeamResource{})
 )
 
 // TeamResource contains state for the resource.
@@ -66,6 +69,16 @@ func (r *TeamResource) Configure(_ context.Context, req resource.ConfigureReques
 	r.client = client
 }
 
+// ModifyPlan checks that the server supports the resource when it is created.
+// See helpers.CheckServerCapability.
+func (r *TeamResource) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || !helpers.IsCreate(req) {
+		return
+	}
+
+	resp.Diagnostics.Append(helpers.CheckServerCapability(r.client.ServerInfo(), "The prefect_team resource", "", helpers.PlanPrefectCloudEnterprise)...)
+}
+
 // Schema returns the resource schema.
 func (r *TeamResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
@@ -113,6 +126,11 @@ func (r *TeamResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 	}
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (r *TeamResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = accountScopedIDIdentitySchema("Team ID (UUID)")
+}
+
 // Create creates a new team.
 func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan TeamResourceModel
@@ -146,6 +164,10 @@ func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, AccountScopedIDIdentityModel{
+		AccountID: plan.AccountID,
+		ID:        plan.ID,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -168,6 +190,12 @@ func (r *TeamResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	team, err := teamClient.Read(ctx, plan.ID.ValueString())
 	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Team", "read", err))
 
 		return
@@ -180,6 +208,10 @@ func (r *TeamResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, AccountScopedIDIdentityModel{
+		AccountID: plan.AccountID,
+		ID:        plan.ID,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -217,6 +249,10 @@ func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, AccountScopedIDIdentityModel{
+		AccountID: plan.AccountID,
+		ID:        plan.ID,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -2,9 +2,11 @@ package resources
 
 import (
 	"context"
+	"errors"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -13,7 +15,11 @@ import (
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
 )
 
-var _ = resource.ResourceWithConfigure(&TeamAccessResource{})
+var (
+	_ = resource.ResourceWithConfigure(&TeamAccessResource{})
+	_ = resource.ResourceWithImportState(&TeamAccessResource{})
+	_ = resource.ResourceWithIdentity(&TeamAccessResource{})
+)
 
 // TeamAccessResource is a resource that manages the members of a team.
 type TeamAccessResource struct {
@@ -32,6 +38,14 @@ type TeamAccessResourceModel struct {
 	AccountID customtypes.UUIDValue `tfsdk:"account_id"`
 }
 
+// TeamAccessResourceIdentityModel defines the Terraform resource identity model.
+type TeamAccessResourceIdentityModel struct {
+	AccountID     customtypes.UUIDValue `tfsdk:"account_id"`
+	TeamID        customtypes.UUIDValue `tfsdk:"team_id"`
+	MemberID      customtypes.UUIDValue `tfsdk:"member_id"`
+	MemberActorID customtypes.UUIDValue `tfsdk:"member_actor_id"`
+}
+
 // NewTeamAccessResource returns a new TeamAccessResource.
 //
 //nolint:ireturn // required by Terraform API
@@ -42,6 +56,7 @@ func NewTeamAccessResource() resource.Resource {
 // Metadata returns the resource type name.
 func (r *TeamAccessResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_team_access"
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 // Configure initializes runtime state for the resource.
@@ -105,6 +120,15 @@ func (r *TeamAccessResource) Schema(_ context.Context, _ resource.SchemaRequest,
 	}
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (r *TeamAccessResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = accountIdentitySchema(map[string]identityschema.Attribute{
+		"team_id":         uuidIdentityAttribute("Team ID (UUID)"),
+		"member_id":       uuidIdentityAttribute("Member ID (UUID)"),
+		"member_actor_id": uuidIdentityAttribute("Member actor ID (UUID)"),
+	})
+}
+
 // Create creates a new team access.
 func (r *TeamAccessResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan TeamAccessResourceModel
@@ -137,6 +161,12 @@ func (r *TeamAccessResource) Create(ctx context.Context, req resource.CreateRequ
 	copyTeamAccessToModel(teamAccess, &plan)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, TeamAccessResourceIdentityModel{
+		AccountID:     plan.AccountID,
+		TeamID:        plan.TeamID,
+		MemberID:      plan.MemberID,
+		MemberActorID: plan.MemberActorID,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -160,6 +190,12 @@ func (r *TeamAccessResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	teamAccess, err := client.Read(ctx, plan.TeamID.ValueUUID(), plan.MemberID.ValueUUID(), plan.MemberActorID.ValueUUID())
 	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Team Access", "read", err))
 
 		return
@@ -168,6 +204,12 @@ func (r *TeamAccessResource) Read(ctx context.Context, req resource.ReadRequest,
 	copyTeamAccessToModel(teamAccess, &plan)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, TeamAccessResourceIdentityModel{
+		AccountID:     plan.AccountID,
+		TeamID:        plan.TeamID,
+		MemberID:      plan.MemberID,
+		MemberActorID: plan.MemberActorID,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -203,6 +245,12 @@ func (r *TeamAccessResource) Update(ctx context.Context, req resource.UpdateRequ
 	copyTeamAccessToModel(teamAccess, &plan)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, TeamAccessResourceIdentityModel{
+		AccountID:     plan.AccountID,
+		TeamID:        plan.TeamID,
+		MemberID:      plan.MemberID,
+		MemberActorID: plan.MemberActorID,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -244,3 +292,9 @@ func copyTeamAccessToModel(teamAccess *api.TeamAccess, plan *TeamAccessResourceM
 	plan.MemberActorID = customtypes.NewUUIDValue(teamAccess.MemberActorID)
 	plan.MemberType = types.StringValue(teamAccess.MemberType)
 }
+
+// ImportState imports the resource into Terraform state from the identity
+// set in an import block.
+func (r *TeamAccessResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	helpers.ImportStateByIdentity(ctx, req, resp)
+}
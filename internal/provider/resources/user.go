@@ -2,6 +2,7 @@ package resources
 
 import (
 	"context"
+	"errors"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -18,6 +19,7 @@ import (
 var (
 	_ = resource.ResourceWithConfigure(&UserResource{})
 	_ = resource.ResourceWithImportState(&UserResource{})
+	_ = resource.ResourceWithIdentity(&UserResource{})
 )
 
 // UserResource contains state for the user resource.
@@ -124,6 +126,11 @@ func (r *UserResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 	}
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (r *UserResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = unscopedIDIdentitySchema("User ID (UUID)")
+}
+
 // Create creates a new user.
 func (r *UserResource) Create(_ context.Context, _ resource.CreateRequest, resp *resource.CreateResponse) {
 	resp.Diagnostics.AddError("Not implemented", "Creating a user is not yet implemented")
@@ -156,6 +163,12 @@ func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	user, err := client.Read(ctx, state.ID.ValueString())
 	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("User", "read", err))
 
 		return
@@ -164,6 +177,9 @@ func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	copyUserToModel(user, &state)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, UnscopedIDIdentityModel{
+		ID: state.ID,
+	})...)
 }
 
 // Update updates a user.
@@ -204,6 +220,9 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	copyUserToModel(user, &plan)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, UnscopedIDIdentityModel{
+		ID: plan.ID,
+	})...)
 }
 
 // Delete deletes a user.
@@ -224,5 +243,11 @@ func copyUserToModel(user *api.User, state *UserResourceModel) {
 
 // ImportState imports a user.
 func (r *UserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if helpers.IsImportByIdentity(req) {
+		helpers.ImportStateFromIdentity(ctx, req, resp)
+
+		return
+	}
+
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
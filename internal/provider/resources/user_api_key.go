@@ -2,8 +2,10 @@ package resources
 
 import (
 	"context"
+	"errors"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -15,6 +17,9 @@ import (
 
 var (
 	_ = resource.ResourceWithConfigure(&UserAPIKeyResource{})
+	_ = resource.ResourceWithModifyPlan(&UserAPIKeyResource{})
+	_ = resource.ResourceWithImportState(&UserAPIKeyResource{})
+	_ = resource.ResourceWithIdentity(&UserAPIKeyResource{})
 )
 
 // UserAPIKeyResource contains state for the user API key resource.
@@ -33,6 +38,12 @@ type UserAPIKeyResourceModel struct {
 	Key        types.String               `tfsdk:"key"`
 }
 
+// UserAPIKeyResourceIdentityModel defines the Terraform resource identity model.
+type UserAPIKeyResourceIdentityModel struct {
+	UserID types.String `tfsdk:"user_id"`
+	ID     types.String `tfsdk:"id"`
+}
+
 // NewUserAPIKeyResource returns a new UserAPIKeyResource.
 //
 //nolint:ireturn // required by Terraform API
@@ -61,6 +72,16 @@ func (r *UserAPIKeyResource) Configure(_ context.Context, req resource.Configure
 	r.client = client
 }
 
+// ModifyPlan checks that the server supports the resource when it is created.
+// See helpers.CheckServerCapability.
+func (r *UserAPIKeyResource) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || !helpers.IsCreate(req) {
+		return
+	}
+
+	resp.Diagnostics.Append(helpers.CheckServerCapability(r.client.ServerInfo(), "The prefect_user_api_key resource", "", helpers.AllCloudPlans...)...)
+}
+
 // Schema returns the resource schema.
 func (r *UserAPIKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
@@ -127,6 +148,22 @@ func (r *UserAPIKeyResource) Schema(_ context.Context, _ resource.SchemaRequest,
 	}
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (r *UserAPIKeyResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"user_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "User ID (UUID)",
+			},
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "User API Key ID (UUID)",
+			},
+		},
+	}
+}
+
 // copyUserAPIKeyToModel copies the UserAPIKey resource data to the Terraform model.
 // Note: we do not copy the Key field to the model, as it is only returned on Create.
 // For all other lifecycle methods, we will persist the existing State value.
@@ -170,6 +207,10 @@ func (r *UserAPIKeyResource) Create(ctx context.Context, req resource.CreateRequ
 	plan.Key = types.StringValue(apiKey.Key)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, UserAPIKeyResourceIdentityModel{
+		UserID: plan.UserID,
+		ID:     plan.ID,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -203,6 +244,12 @@ func (r *UserAPIKeyResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	apiKey, err := userClient.ReadAPIKey(ctx, state.UserID.ValueString(), state.ID.ValueString())
 	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("User API Key", "read", err))
 
 		return
@@ -211,6 +258,10 @@ func (r *UserAPIKeyResource) Read(ctx context.Context, req resource.ReadRequest,
 	copyUserAPIKeyToModel(apiKey, &state)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, UserAPIKeyResourceIdentityModel{
+		UserID: state.UserID,
+		ID:     state.ID,
+	})...)
 }
 
 // Update is not supported for User API Keys.
@@ -249,3 +300,9 @@ func (r *UserAPIKeyResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 }
+
+// ImportState imports the resource into Terraform state from the identity
+// set in an import block.
+func (r *UserAPIKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	helpers.ImportStateByIdentity(ctx, req, resp)
+}
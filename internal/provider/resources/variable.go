@@ -3,11 +3,14 @@ package resources
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/dynamicvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -16,6 +19,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 
@@ -27,6 +32,7 @@ import (
 var (
 	_ = resource.ResourceWithConfigure(&VariableResource{})
 	_ = resource.ResourceWithImportState(&VariableResource{})
+	_ = resource.ResourceWithIdentity(&VariableResource{})
 	_ = resource.ResourceWithUpgradeState(&VariableResource{})
 )
 
@@ -53,16 +59,19 @@ type VariableResourceModelV0 struct {
 	Tags  types.List   `tfsdk:"tags"`
 }
 
-// V1: Value is types.Dynamic.
+// V1: Value is types.Dynamic, with an optional write-only ValueWO alternative.
 type VariableResourceModelV1 struct {
 	BaseModel
 
 	AccountID   customtypes.UUIDValue `tfsdk:"account_id"`
 	WorkspaceID customtypes.UUIDValue `tfsdk:"workspace_id"`
 
-	Name  types.String  `tfsdk:"name"`
-	Value types.Dynamic `tfsdk:"value"`
-	Tags  types.List    `tfsdk:"tags"`
+	Name           types.String  `tfsdk:"name"`
+	Value          types.Dynamic `tfsdk:"value"`
+	ValueWO        types.Dynamic `tfsdk:"value_wo"`
+	ValueWOVersion types.Int64   `tfsdk:"value_wo_version"`
+	Tags           types.List    `tfsdk:"tags"`
+	AdoptExisting  types.Bool    `tfsdk:"adopt_existing"`
 }
 
 var defaultEmptyTagList, _ = basetypes.NewListValue(types.StringType, []attr.Value{})
@@ -106,8 +115,23 @@ var VariableResourceSchemaAttributes = map[string]schema.Attribute{
 		Required:    true,
 	},
 	"value": schema.DynamicAttribute{
-		Description: "Value of the variable, supported Terraform value types: string, number, bool, tuple, object",
-		Required:    true,
+		Description: "Value of the variable, supported Terraform value types: string, number, bool, tuple, object. Exactly one of `value` or `value_wo` must be set.",
+		Optional:    true,
+		Validators: []validator.Dynamic{
+			dynamicvalidator.ExactlyOneOf(path.MatchRoot("value_wo")),
+		},
+	},
+	"value_wo": schema.DynamicAttribute{
+		Description: "Write-only alternative to `value`, which is sent to the API but never stored in Terraform state or plans. Requires Terraform 1.11 or later. As its value is not stored, changes to it are only applied when `value_wo_version` changes.",
+		Optional:    true,
+		WriteOnly:   true,
+	},
+	"value_wo_version": schema.Int64Attribute{
+		Description: "Version of the `value_wo` value. Increment this whenever `value_wo` changes, to update the variable with its new value.",
+		Optional:    true,
+		Validators: []validator.Int64{
+			int64validator.AlsoRequires(path.MatchRoot("value_wo")),
+		},
 	},
 	"tags": schema.ListAttribute{
 		Description: "Tags associated with the variable",
@@ -116,6 +140,7 @@ var VariableResourceSchemaAttributes = map[string]schema.Attribute{
 		Computed:    true,
 		Default:     listdefault.StaticValue(defaultEmptyTagList),
 	},
+	"adopt_existing": adoptExistingAttribute("variable"),
 }
 
 // NewVariableResource returns a new VariableResource.
@@ -151,6 +176,7 @@ func (r *VariableResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 	resp.Schema = schema.Schema{
 		Description: helpers.DescriptionWithPlans("The resource `variable` represents a Prefect Variable. "+
 			"Variables enable you to store and reuse non-sensitive information in your flows. "+
+			"To keep a value out of Terraform state, use the write-only `value_wo` attribute instead of `value` (Terraform 1.11 and later), and increment `value_wo_version` whenever it changes. "+
 			"For more information, see [set and get variables](https://docs.prefect.io/v3/develop/variables#set-and-get-variables).",
 			helpers.AllPlans...,
 		),
@@ -159,6 +185,11 @@ func (r *VariableResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 	}
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (r *VariableResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("Variable ID (UUID)")
+}
+
 // UpgradeState adds upgraders to the VariableResource.
 // This is needed when a resource schema change is made (eg. an attribute type).
 // The key/index in the return object is the source version (eg. 0 -> current).
@@ -240,13 +271,34 @@ func copyVariableToModel(ctx context.Context, variable *api.Variable, tfModel *V
 	return nil
 }
 
-// getUnderlyingValue converts the 'value' attribute from a DynamicValue to
-// a native Go type that can be sent to the Prefect API.
-func getUnderlyingValue(plan VariableResourceModelV1) (interface{}, diag.Diagnostics) {
+// variableValue returns the variable's value to send to the Prefect API, from
+// either the 'value' attribute in the plan or the write-only 'value_wo'
+// attribute, which is only available in the configuration.
+func variableValue(ctx context.Context, config tfsdk.Config, plan VariableResourceModelV1) (interface{}, diag.Diagnostics) {
+	if !plan.Value.IsNull() {
+		return getUnderlyingValue(plan.Value)
+	}
+
+	var valueWO types.Dynamic
+
+	diags := config.GetAttribute(ctx, path.Root("value_wo"), &valueWO)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	value, valueDiags := getUnderlyingValue(valueWO)
+	diags.Append(valueDiags...)
+
+	return value, diags
+}
+
+// getUnderlyingValue converts a DynamicValue to a native Go type that can be
+// sent to the Prefect API.
+func getUnderlyingValue(dynamicValue types.Dynamic) (interface{}, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	var value interface{}
 
-	switch underlyingValue := plan.Value.UnderlyingValue().(type) {
+	switch underlyingValue := dynamicValue.UnderlyingValue().(type) {
 	case types.String:
 		value = underlyingValue.ValueString()
 
@@ -302,8 +354,9 @@ func (r *VariableResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	value, diags := getUnderlyingValue(plan)
-	if diags.HasError() {
+	value, diags := variableValue(ctx, req.Config, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -320,14 +373,18 @@ func (r *VariableResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	variable, err := client.Create(ctx, api.VariableCreate{
+	payload := api.VariableCreate{
 		Name:  plan.Name.ValueString(),
 		Value: value,
 		Tags:  tags,
-	})
+	}
 
+	variable, err := client.Create(ctx, payload)
+	if shouldAdopt(r.client, plan.AdoptExisting, err) {
+		variable, err = adoptVariable(ctx, client, payload)
+	}
 	if err != nil {
-		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Variable", "create", err))
+		resp.Diagnostics.Append(createErrorDiagnostic("Variable", err))
 
 		return
 	}
@@ -338,11 +395,37 @@ func (r *VariableResource) Create(ctx context.Context, req resource.CreateReques
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IDIdentityModel{
+		AccountID:   plan.AccountID,
+		WorkspaceID: plan.WorkspaceID,
+		ID:          plan.ID,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 }
 
+// adoptVariable updates an existing variable with the same name
+// to match the create payload, and returns it.
+func adoptVariable(ctx context.Context, client api.VariablesClient, payload api.VariableCreate) (*api.Variable, error) {
+	variable, err := client.GetByName(ctx, payload.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get existing variable: %w", err)
+	}
+
+	err = client.Update(ctx, variable.ID, api.VariableUpdate(payload))
+	if err != nil {
+		return nil, fmt.Errorf("failed to update existing variable: %w", err)
+	}
+
+	variable, err = client.Get(ctx, variable.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get existing variable: %w", err)
+	}
+
+	return variable, nil
+}
+
 // Read refreshes the Terraform state with the latest data.
 func (r *VariableResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var state VariableResourceModelV1
@@ -387,6 +470,12 @@ func (r *VariableResource) Read(ctx context.Context, req resource.ReadRequest, r
 	}
 
 	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Variable", "get", err))
 
 		return
@@ -398,6 +487,11 @@ func (r *VariableResource) Read(ctx context.Context, req resource.ReadRequest, r
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IDIdentityModel{
+		AccountID:   state.AccountID,
+		WorkspaceID: state.WorkspaceID,
+		ID:          state.ID,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -419,8 +513,9 @@ func (r *VariableResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	value, diags := getUnderlyingValue(plan)
-	if diags.HasError() {
+	value, diags := variableValue(ctx, req.Config, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -461,6 +556,11 @@ func (r *VariableResource) Update(ctx context.Context, req resource.UpdateReques
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IDIdentityModel{
+		AccountID:   plan.AccountID,
+		WorkspaceID: plan.WorkspaceID,
+		ID:          plan.ID,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -509,6 +609,12 @@ func (r *VariableResource) Delete(ctx context.Context, req resource.DeleteReques
 // <variable_id>
 // <variable_id>,<workspace_id>.
 func (r *VariableResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if helpers.IsImportByIdentity(req) {
+		helpers.ImportStateFromIdentity(ctx, req, resp)
+
+		return
+	}
+
 	parts := strings.Split(req.ID, ",")
 
 	if len(parts) > 2 || len(parts) == 0 {
@@ -0,0 +1,72 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var _ = list.ListResourceWithConfigure(&VariableResource{})
+
+// NewVariableListResource returns a new VariableResource as a list resource.
+//
+//nolint:ireturn // required by Terraform API
+func NewVariableListResource() list.ListResource {
+	return &VariableResource{}
+}
+
+// ListResourceConfigSchema defines the schema of the list resource configuration.
+func (r *VariableResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = workspaceListSchema("Lists the Variables of a workspace.", nil)
+}
+
+// List streams the Variables of a workspace.
+func (r *VariableResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config WorkspaceListModel
+
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	client, err := r.client.Variables(config.AccountID.ValueUUID(), config.WorkspaceID.ValueUUID())
+	if err != nil {
+		diags.Append(helpers.CreateClientErrorDiagnostic("Variable", err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	variables := client.Iterate(ctx, api.VariableFilter{}, listOptions(req))
+
+	streamListResults(ctx, req, stream, "Variable", variables, func(variable api.Variable, result *list.ListResult) {
+		result.DisplayName = variable.Name
+
+		result.Diagnostics.Append(result.Identity.Set(ctx, IDIdentityModel{
+			AccountID:   config.AccountID,
+			WorkspaceID: config.WorkspaceID,
+			ID:          types.StringValue(variable.ID.String()),
+		})...)
+		if result.Diagnostics.HasError() || !req.IncludeResource {
+			return
+		}
+
+		var model VariableResourceModelV1
+		result.Diagnostics.Append(getNullResource(ctx, result.Resource, &model)...)
+		if result.Diagnostics.HasError() {
+			return
+		}
+
+		model.AccountID = config.AccountID
+		model.WorkspaceID = config.WorkspaceID
+
+		result.Diagnostics.Append(copyVariableToModel(ctx, &variable, &model)...)
+		result.Diagnostics.Append(result.Resource.Set(ctx, model)...)
+	})
+}
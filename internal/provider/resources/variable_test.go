@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/prefecthq/terraform-provider-prefect/internal/api"
 	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
 )
@@ -141,6 +142,132 @@ func TestAccResource_variable(t *testing.T) {
 	})
 }
 
+func fixtureAccVariableResourceWriteOnly(workspace, name string, value interface{}, version int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "prefect_variable" "test" {
+	name = "%s"
+	value_wo = %v
+	value_wo_version = %d
+	workspace_id = prefect_workspace.test.id
+	depends_on = [prefect_workspace.test]
+}
+	`, workspace, name, value, version)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_variable_write_only(t *testing.T) {
+	randomName := testutils.NewRandomPrefixedString()
+	resourceName := "prefect_variable.test"
+	workspace := testutils.NewEphemeralWorkspace()
+
+	var variable api.Variable
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				// Check that the write-only value is sent, but not stored in state
+				Config: fixtureAccVariableResourceWriteOnly(workspace.Resource, randomName, `"first"`, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVariableExists(resourceName, &variable),
+					testAccCheckVariableValues(&variable, &api.Variable{Name: randomName, Value: "first"}),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					testutils.ExpectKnownValueNull(resourceName, "value"),
+					testutils.ExpectKnownValueNull(resourceName, "value_wo"),
+					testutils.ExpectKnownValueNumber(resourceName, "value_wo_version", 1),
+				},
+			},
+			{
+				// Check that incrementing the version applies the new value
+				Config: fixtureAccVariableResourceWriteOnly(workspace.Resource, randomName, `"second"`, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVariableExists(resourceName, &variable),
+					testAccCheckVariableValues(&variable, &api.Variable{Name: randomName, Value: "second"}),
+				),
+			},
+		},
+	})
+}
+
+func fixtureAccVariableForget(workspace string) string {
+	return fmt.Sprintf(`
+%s
+
+removed {
+	from = prefect_variable.test
+
+	lifecycle {
+		destroy = false
+	}
+}
+`, workspace)
+}
+
+func fixtureAccVariableAdopt(workspace, name string, value interface{}) string {
+	return fmt.Sprintf(`
+%s
+
+resource "prefect_variable" "adopted" {
+	name = "%s"
+	value = %v
+	workspace_id = prefect_workspace.test.id
+	adopt_existing = true
+}
+`, workspace, name, value)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_variable_adopt_existing(t *testing.T) {
+	workspace := testutils.NewEphemeralWorkspace()
+	randomName := testutils.NewRandomPrefixedString()
+	resourceName := "prefect_variable.adopted"
+
+	var created, adopted api.Variable
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_7_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fixtureAccVariableResource(workspace.Resource, randomName, `"before"`),
+				Check:  testAccCheckVariableExists("prefect_variable.test", &created),
+			},
+			{
+				// Remove the variable from state, leaving it in the workspace
+				Config: fixtureAccVariableForget(workspace.Resource),
+			},
+			{
+				// Check that the existing variable is adopted and updated, instead of failing
+				Config: fixtureAccVariableAdopt(workspace.Resource, randomName, `"after"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVariableExists(resourceName, &adopted),
+					testAccCheckVariableValues(&adopted, &api.Variable{Name: randomName, Value: "after"}),
+					resource.TestCheckResourceAttrWith(resourceName, "id", func(id string) error {
+						if id != created.ID.String() {
+							return fmt.Errorf("expected the existing variable %s to be adopted, got %s", created.ID, id)
+						}
+
+						return nil
+					}),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					testutils.ExpectKnownValue(resourceName, "name", randomName),
+				},
+			},
+		},
+	})
+}
+
 func testAccCheckVariableExists(variableResourceName string, variable *api.Variable) resource.TestCheckFunc {
 	return func(state *terraform.State) error {
 		variableResourceID, err := testutils.GetResourceIDFromState(state, variableResourceName)
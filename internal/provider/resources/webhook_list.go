@@ -0,0 +1,72 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var _ = list.ListResourceWithConfigure(&WebhookResource{})
+
+// NewWebhookListResource returns a new WebhookResource as a list resource.
+//
+//nolint:ireturn // required by Terraform API
+func NewWebhookListResource() list.ListResource {
+	return &WebhookResource{}
+}
+
+// ListResourceConfigSchema defines the schema of the list resource configuration.
+func (r *WebhookResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = workspaceListSchema("Lists the Webhooks of a workspace.", nil)
+}
+
+// List streams the Webhooks of a workspace.
+func (r *WebhookResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config WorkspaceListModel
+
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	client, err := r.client.Webhooks(config.AccountID.ValueUUID(), config.WorkspaceID.ValueUUID())
+	if err != nil {
+		diags.Append(helpers.CreateClientErrorDiagnostic("Webhook", err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	webhooks := client.Iterate(ctx, nil, listOptions(req))
+
+	streamListResults(ctx, req, stream, "Webhook", webhooks, func(webhook *api.Webhook, result *list.ListResult) {
+		result.DisplayName = webhook.Name
+
+		result.Diagnostics.Append(result.Identity.Set(ctx, IDIdentityModel{
+			AccountID:   config.AccountID,
+			WorkspaceID: config.WorkspaceID,
+			ID:          types.StringValue(webhook.ID.String()),
+		})...)
+		if result.Diagnostics.HasError() || !req.IncludeResource {
+			return
+		}
+
+		var model WebhookResourceModel
+		result.Diagnostics.Append(getNullResource(ctx, result.Resource, &model)...)
+		if result.Diagnostics.HasError() {
+			return
+		}
+
+		model.AccountID = config.AccountID
+		model.WorkspaceID = config.WorkspaceID
+
+		copyWebhookResponseToModel(webhook, &model, r.client.GetEndpointHost())
+		result.Diagnostics.Append(result.Resource.Set(ctx, model)...)
+	})
+}
@@ -2,6 +2,7 @@ package resources
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
@@ -20,7 +21,9 @@ import (
 
 var (
 	_ = resource.ResourceWithConfigure(&WebhookResource{})
+	_ = resource.ResourceWithModifyPlan(&WebhookResource{})
 	_ = resource.ResourceWithImportState(&WebhookResource{})
+	_ = resource.ResourceWithIdentity(&WebhookResource{})
 )
 
 type WebhookResource struct {
@@ -67,6 +70,16 @@ func (r *WebhookResource) Configure(_ context.Context, req resource.ConfigureReq
 	r.client = client
 }
 
+// ModifyPlan checks that the server supports the resource when it is created.
+// See helpers.CheckServerCapability.
+func (r *WebhookResource) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || !helpers.IsCreate(req) {
+		return
+	}
+
+	resp.Diagnostics.Append(helpers.CheckServerCapability(r.client.ServerInfo(), "The prefect_webhook resource", "", helpers.AllCloudPlans...)...)
+}
+
 func (r *WebhookResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: helpers.DescriptionWithPlans("The resource `webhook` represents a Prefect Cloud Webhook. "+
@@ -148,6 +161,11 @@ func (r *WebhookResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 	}
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (r *WebhookResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("Webhook ID (UUID)")
+}
+
 // copyWebhookResponseToModel maps an API response to a model that is saved in Terraform state.
 func copyWebhookResponseToModel(webhook *api.Webhook, tfModel *WebhookResourceModel, endpointHost string) {
 	tfModel.ID = types.StringValue(webhook.ID.String())
@@ -199,6 +217,11 @@ func (r *WebhookResource) Create(ctx context.Context, req resource.CreateRequest
 	copyWebhookResponseToModel(webhook, &plan, r.client.GetEndpointHost())
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IDIdentityModel{
+		AccountID:   plan.AccountID,
+		WorkspaceID: plan.WorkspaceID,
+		ID:          plan.ID,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -242,6 +265,12 @@ func (r *WebhookResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 
 	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Webhook", "get", err))
 
 		return
@@ -250,6 +279,11 @@ func (r *WebhookResource) Read(ctx context.Context, req resource.ReadRequest, re
 	copyWebhookResponseToModel(webhook, &state, r.client.GetEndpointHost())
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IDIdentityModel{
+		AccountID:   state.AccountID,
+		WorkspaceID: state.WorkspaceID,
+		ID:          state.ID,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -300,6 +334,11 @@ func (r *WebhookResource) Update(ctx context.Context, req resource.UpdateRequest
 	copyWebhookResponseToModel(webhook, &plan, r.client.GetEndpointHost())
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IDIdentityModel{
+		AccountID:   plan.AccountID,
+		WorkspaceID: plan.WorkspaceID,
+		ID:          plan.ID,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
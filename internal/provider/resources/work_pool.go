@@ -3,7 +3,8 @@ package resources
 import (
 	"context"
 	"encoding/json"
-	"strings"
+	"errors"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -23,6 +24,8 @@ import (
 var (
 	_ = resource.ResourceWithConfigure(&WorkPoolResource{})
 	_ = resource.ResourceWithImportState(&WorkPoolResource{})
+	_ = resource.ResourceWithIdentity(&WorkPoolResource{})
+	_ = resource.ResourceWithModifyPlan(&WorkPoolResource{})
 )
 
 // WorkPoolResource contains state for the resource.
@@ -33,6 +36,7 @@ type WorkPoolResource struct {
 // WorkPoolResourceModel defines the Terraform resource model.
 type WorkPoolResourceModel struct {
 	BaseModel
+	DestroyModel
 
 	AccountID   customtypes.UUIDValue `tfsdk:"account_id"`
 	WorkspaceID customtypes.UUIDValue `tfsdk:"workspace_id"`
@@ -44,6 +48,7 @@ type WorkPoolResourceModel struct {
 	ConcurrencyLimit types.Int64           `tfsdk:"concurrency_limit"`
 	DefaultQueueID   customtypes.UUIDValue `tfsdk:"default_queue_id"`
 	BaseJobTemplate  jsontypes.Normalized  `tfsdk:"base_job_template"`
+	AdoptExisting    types.Bool            `tfsdk:"adopt_existing"`
 }
 
 // NewWorkPoolResource returns a new WorkPoolResource.
@@ -74,6 +79,12 @@ func (r *WorkPoolResource) Configure(_ context.Context, req resource.ConfigureRe
 	r.client = client
 }
 
+// ModifyPlan fails plans destroying or replacing the work pool while its deletion protection
+// is enabled.
+func (r *WorkPoolResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	checkDeletionProtection(ctx, req, resp, "Work Pool", "name", "type")
+}
+
 // Schema defines the schema for the resource.
 func (r *WorkPoolResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
@@ -170,10 +181,18 @@ func (r *WorkPoolResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Description: "The base job template for the work pool, as a JSON string",
 				Optional:    true,
 			},
+			"adopt_existing":      adoptExistingAttribute("work pool"),
+			"deletion_protection": deletionProtectionAttribute("work pool", "also deletes its work queues"),
+			"on_destroy":          onDestroyAttribute("work pool"),
 		},
 	}
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (r *WorkPoolResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = nameIdentitySchema("Work Pool name")
+}
+
 // copyWorkPoolToModel maps an API response to a model that is saved in Terraform state.
 // A model can be a Terraform Plan, State, or Config object.
 //
@@ -237,8 +256,11 @@ func (r *WorkPoolResource) Create(ctx context.Context, req resource.CreateReques
 	}
 
 	pool, err := client.Create(ctx, payload)
+	if shouldAdopt(r.client, plan.AdoptExisting, err) {
+		pool, err = adoptWorkPool(ctx, client, payload)
+	}
 	if err != nil {
-		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Work Pool", "create", err))
+		resp.Diagnostics.Append(createErrorDiagnostic("Work Pool", err))
 
 		return
 	}
@@ -249,11 +271,47 @@ func (r *WorkPoolResource) Create(ctx context.Context, req resource.CreateReques
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, NameIdentityModel{
+		AccountID:   plan.AccountID,
+		WorkspaceID: plan.WorkspaceID,
+		Name:        plan.Name,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 }
 
+// adoptWorkPool updates an existing work pool with the same name to match
+// the create payload, and returns it. Its type cannot be updated, so it
+// must already match.
+func adoptWorkPool(ctx context.Context, client api.WorkPoolsClient, payload api.WorkPoolCreate) (*api.WorkPool, error) {
+	pool, err := client.Get(ctx, payload.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get existing work pool: %w", err)
+	}
+
+	if pool.Type != payload.Type {
+		return nil, fmt.Errorf("existing work pool %q has type %q, which cannot be changed to %q", pool.Name, pool.Type, payload.Type)
+	}
+
+	err = client.Update(ctx, payload.Name, api.WorkPoolUpdate{
+		Description:      payload.Description,
+		IsPaused:         &payload.IsPaused,
+		ConcurrencyLimit: payload.ConcurrencyLimit,
+		BaseJobTemplate:  payload.BaseJobTemplate,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update existing work pool: %w", err)
+	}
+
+	pool, err = client.Get(ctx, payload.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get existing work pool: %w", err)
+	}
+
+	return pool, nil
+}
+
 // Read refreshes the Terraform state with the latest data.
 func (r *WorkPoolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var state WorkPoolResourceModel
@@ -276,10 +334,7 @@ func (r *WorkPoolResource) Read(ctx context.Context, req resource.ReadRequest, r
 		// If the remote object does not exist, we can remove it from TF state
 		// so that the framework can queue up a new Create.
 		// https://discuss.hashicorp.com/t/recreate-a-resource-in-a-case-of-manual-deletion/66375/3
-		//
-		// NOTE: as a workaround, we encode + check this status code string on the error object.
-		// See `checkRetryPolicy` in `internal/client/client.go` for more details.
-		if strings.Contains(err.Error(), "status_code=404") {
+		if errors.Is(err, api.ErrNotFound) {
 			resp.State.RemoveResource(ctx)
 
 			return
@@ -296,7 +351,14 @@ func (r *WorkPoolResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	state.setDefaults()
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, NameIdentityModel{
+		AccountID:   state.AccountID,
+		WorkspaceID: state.WorkspaceID,
+		Name:        state.Name,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -355,6 +417,11 @@ func (r *WorkPoolResource) Update(ctx context.Context, req resource.UpdateReques
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, NameIdentityModel{
+		AccountID:   plan.AccountID,
+		WorkspaceID: plan.WorkspaceID,
+		Name:        plan.Name,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -369,6 +436,12 @@ func (r *WorkPoolResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	shouldDelete, diags := state.shouldDelete(ctx, "Work Pool")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || !shouldDelete {
+		return
+	}
+
 	client, err := r.client.WorkPools(state.AccountID.ValueUUID(), state.WorkspaceID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Work Pool", err))
@@ -390,6 +463,18 @@ func (r *WorkPoolResource) Delete(ctx context.Context, req resource.DeleteReques
 }
 
 // ImportState imports the resource into Terraform state.
+//
+// Allows input values in the form of:
+// - "name,workspace_id" or "name"
+// - a link to the work pool in the Prefect UI
+//
+// or the identity set in an import block.
 func (r *WorkPoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if helpers.IsUIURL(req.ID) {
+		helpers.ImportStateFromUIURL(ctx, r.client, req, resp, helpers.UIObjectWorkPool, "name")
+
+		return
+	}
+
 	helpers.ImportStateByName(ctx, req, resp)
 }
@@ -2,9 +2,11 @@ package resources
 
 import (
 	"context"
+	"errors"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -14,7 +16,12 @@ import (
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
 )
 
-var _ = resource.ResourceWithConfigure(&WorkPoolAccessResource{})
+var (
+	_ = resource.ResourceWithConfigure(&WorkPoolAccessResource{})
+	_ = resource.ResourceWithModifyPlan(&WorkPoolAccessResource{})
+	_ = resource.ResourceWithImportState(&WorkPoolAccessResource{})
+	_ = resource.ResourceWithIdentity(&WorkPoolAccessResource{})
+)
 
 type WorkPoolAccessResource struct {
 	client api.PrefectClient
@@ -34,6 +41,13 @@ type WorkPoolAccessResourceModel struct {
 	ViewTeamIDs    types.List `tfsdk:"view_team_ids"`
 }
 
+// WorkPoolAccessResourceIdentityModel defines the Terraform resource identity model.
+type WorkPoolAccessResourceIdentityModel struct {
+	AccountID    customtypes.UUIDValue `tfsdk:"account_id"`
+	WorkspaceID  customtypes.UUIDValue `tfsdk:"workspace_id"`
+	WorkPoolName types.String          `tfsdk:"work_pool_name"`
+}
+
 // NewWorkPoolAccessResource returns a new WorkPoolAccessResource.
 //
 //nolint:ireturn // required by Terraform API
@@ -44,6 +58,7 @@ func NewWorkPoolAccessResource() resource.Resource {
 // Metadata returns the resource type name.
 func (r *WorkPoolAccessResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_work_pool_access"
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 // Configure initializes runtime state for the resource.
@@ -62,6 +77,16 @@ func (r *WorkPoolAccessResource) Configure(_ context.Context, req resource.Confi
 	r.client = client
 }
 
+// ModifyPlan checks that the server supports the resource when it is created.
+// See helpers.CheckServerCapability.
+func (r *WorkPoolAccessResource) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || !helpers.IsCreate(req) {
+		return
+	}
+
+	resp.Diagnostics.Append(helpers.CheckServerCapability(r.client.ServerInfo(), "The prefect_work_pool_access resource", "", helpers.PlanPrefectCloudPro, helpers.PlanPrefectCloudEnterprise)...)
+}
+
 // Schema returns the resource schema.
 func (r *WorkPoolAccessResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	defaultEmptyList, _ := basetypes.NewListValue(types.StringType, []attr.Value{})
@@ -137,6 +162,16 @@ func (r *WorkPoolAccessResource) Schema(_ context.Context, _ resource.SchemaRequ
 	}
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (r *WorkPoolAccessResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = workspaceIdentitySchema(map[string]identityschema.Attribute{
+		"work_pool_name": identityschema.StringAttribute{
+			RequiredForImport: true,
+			Description:       "Work Pool name",
+		},
+	})
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *WorkPoolAccessResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan WorkPoolAccessResourceModel
@@ -205,6 +240,11 @@ func (r *WorkPoolAccessResource) Create(ctx context.Context, req resource.Create
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, WorkPoolAccessResourceIdentityModel{
+		AccountID:    plan.AccountID,
+		WorkspaceID:  plan.WorkspaceID,
+		WorkPoolName: plan.WorkPoolName,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -227,6 +267,12 @@ func (r *WorkPoolAccessResource) Read(ctx context.Context, req resource.ReadRequ
 
 	_, err = client.Read(ctx, state.WorkPoolName.ValueString())
 	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Work Pool Access", "read", err))
 
 		return
@@ -237,6 +283,11 @@ func (r *WorkPoolAccessResource) Read(ctx context.Context, req resource.ReadRequ
 	// This is something to be revisited in the future.
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, WorkPoolAccessResourceIdentityModel{
+		AccountID:    state.AccountID,
+		WorkspaceID:  state.WorkspaceID,
+		WorkPoolName: state.WorkPoolName,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -310,6 +361,11 @@ func (r *WorkPoolAccessResource) Update(ctx context.Context, req resource.Update
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, WorkPoolAccessResourceIdentityModel{
+		AccountID:    plan.AccountID,
+		WorkspaceID:  plan.WorkspaceID,
+		WorkPoolName: plan.WorkPoolName,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -345,3 +401,9 @@ func (r *WorkPoolAccessResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 }
+
+// ImportState imports the resource into Terraform state from the identity
+// set in an import block.
+func (r *WorkPoolAccessResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	helpers.ImportStateByIdentity(ctx, req, resp)
+}
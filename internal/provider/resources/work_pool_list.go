@@ -0,0 +1,74 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var _ = list.ListResourceWithConfigure(&WorkPoolResource{})
+
+// NewWorkPoolListResource returns a new WorkPoolResource as a list resource.
+//
+//nolint:ireturn // required by Terraform API
+func NewWorkPoolListResource() list.ListResource {
+	return &WorkPoolResource{}
+}
+
+// ListResourceConfigSchema defines the schema of the list resource configuration.
+func (r *WorkPoolResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = workspaceListSchema("Lists the Work Pools of a workspace.", nil)
+}
+
+// List streams the Work Pools of a workspace.
+func (r *WorkPoolResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config WorkspaceListModel
+
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	client, err := r.client.WorkPools(config.AccountID.ValueUUID(), config.WorkspaceID.ValueUUID())
+	if err != nil {
+		diags.Append(helpers.CreateClientErrorDiagnostic("Work Pool", err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	pools := client.Iterate(ctx, api.WorkPoolFilter{}, listOptions(req))
+
+	streamListResults(ctx, req, stream, "Work Pool", pools, func(pool *api.WorkPool, result *list.ListResult) {
+		result.DisplayName = pool.Name
+
+		result.Diagnostics.Append(result.Identity.Set(ctx, NameIdentityModel{
+			AccountID:   config.AccountID,
+			WorkspaceID: config.WorkspaceID,
+			Name:        types.StringValue(pool.Name),
+		})...)
+		if result.Diagnostics.HasError() || !req.IncludeResource {
+			return
+		}
+
+		var model WorkPoolResourceModel
+		result.Diagnostics.Append(getNullResource(ctx, result.Resource, &model)...)
+		if result.Diagnostics.HasError() {
+			return
+		}
+
+		model.AccountID = config.AccountID
+		model.WorkspaceID = config.WorkspaceID
+
+		result.Diagnostics.Append(copyWorkPoolToModel(pool, &model))
+		model.setDefaults()
+
+		result.Diagnostics.Append(result.Resource.Set(ctx, model)...)
+	})
+}
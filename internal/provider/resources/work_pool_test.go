@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/prefecthq/terraform-provider-prefect/internal/api"
 	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
 )
@@ -137,6 +138,127 @@ func TestAccResource_work_pool(t *testing.T) {
 	})
 }
 
+func fixtureAccWorkPoolDeletionProtection(workspace, name, poolType string, deletionProtection bool) string {
+	return fmt.Sprintf(`
+%s
+
+resource "prefect_work_pool" "test" {
+	name = "%s"
+	type = "%s"
+	workspace_id = prefect_workspace.test.id
+	deletion_protection = %t
+}
+`, workspace, name, poolType, deletionProtection)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_work_pool_deletion_protection(t *testing.T) {
+	workspace := testutils.NewEphemeralWorkspace()
+	randomName := testutils.NewRandomPrefixedString()
+	resourceName := "prefect_work_pool.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fixtureAccWorkPoolDeletionProtection(workspace.Resource, randomName, "process", true),
+				ConfigStateChecks: []statecheck.StateCheck{
+					testutils.ExpectKnownValueBool(resourceName, "deletion_protection", true),
+					testutils.ExpectKnownValue(resourceName, "on_destroy", "delete"),
+				},
+			},
+			{
+				// Check that the protected work pool cannot be replaced
+				Config:      fixtureAccWorkPoolDeletionProtection(workspace.Resource, randomName, "kubernetes", true),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Deletion protection is enabled"),
+			},
+			{
+				// Check that the protected work pool cannot be destroyed
+				Config:      workspace.Resource,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Deletion protection is enabled"),
+			},
+			{
+				// Lift the protection, so that the work pool can be destroyed
+				Config: fixtureAccWorkPoolDeletionProtection(workspace.Resource, randomName, "process", false),
+				ConfigStateChecks: []statecheck.StateCheck{
+					testutils.ExpectKnownValueBool(resourceName, "deletion_protection", false),
+				},
+			},
+		},
+	})
+}
+
+func fixtureAccWorkPoolForget(workspace string) string {
+	return fmt.Sprintf(`
+%s
+
+removed {
+	from = prefect_work_pool.test
+
+	lifecycle {
+		destroy = false
+	}
+}
+`, workspace)
+}
+
+func fixtureAccWorkPoolAdopt(workspace, name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "prefect_work_pool" "adopted" {
+	name = "%s"
+	type = "process"
+	paused = true
+	workspace_id = prefect_workspace.test.id
+	adopt_existing = true
+}
+`, workspace, name)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_work_pool_adopt_existing(t *testing.T) {
+	workspace := testutils.NewEphemeralWorkspace()
+	randomName := testutils.NewRandomPrefixedString()
+	resourceName := "prefect_work_pool.adopted"
+
+	var workPool api.WorkPool
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_7_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fixtureAccWorkPoolDeletionProtection(workspace.Resource, randomName, "process", false),
+				Check:  testAccCheckWorkPoolExists("prefect_work_pool.test", &workPool),
+			},
+			{
+				// Remove the work pool from state, leaving it in the workspace
+				Config: fixtureAccWorkPoolForget(workspace.Resource),
+			},
+			{
+				// Check that the existing work pool is adopted and updated, instead of failing
+				Config: fixtureAccWorkPoolAdopt(workspace.Resource, randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIDAreEqual(resourceName, &workPool),
+					testAccCheckWorkPoolExists(resourceName, &workPool),
+					testAccCheckWorkPoolValues(&workPool, &api.WorkPool{Name: randomName, Type: "process", IsPaused: true}),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					testutils.ExpectKnownValue(resourceName, "name", randomName),
+					testutils.ExpectKnownValueBool(resourceName, "paused", true),
+				},
+			},
+		},
+	})
+}
+
 func testAccCheckWorkPoolExists(workPoolResourceName string, workPool *api.WorkPool) resource.TestCheckFunc {
 	return func(state *terraform.State) error {
 		workPoolName, err := testutils.GetResourceAttributeFromStateByAttribute(state, workPoolResourceName, "name")
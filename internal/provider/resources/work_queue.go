@@ -2,11 +2,14 @@ package resources
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
+	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -23,6 +26,7 @@ import (
 var (
 	_ = resource.ResourceWithConfigure(&WorkQueueResource{})
 	_ = resource.ResourceWithImportState(&WorkQueueResource{})
+	_ = resource.ResourceWithIdentity(&WorkQueueResource{})
 )
 
 // WorkQueueResource contains state for the resource.
@@ -45,6 +49,14 @@ type WorkQueueResourceModel struct {
 	WorkPoolName     types.String `tfsdk:"work_pool_name"`
 }
 
+// WorkQueueResourceIdentityModel defines the Terraform resource identity model.
+type WorkQueueResourceIdentityModel struct {
+	AccountID    customtypes.UUIDValue `tfsdk:"account_id"`
+	WorkspaceID  customtypes.UUIDValue `tfsdk:"workspace_id"`
+	WorkPoolName types.String          `tfsdk:"work_pool_name"`
+	Name         types.String          `tfsdk:"name"`
+}
+
 // NewWorkQueueResource returns a new WorkQueueResource.
 //
 //nolint:ireturn // required by Terraform API
@@ -114,6 +126,9 @@ func (r *WorkQueueResource) Schema(_ context.Context, _ resource.SchemaRequest,
 			"work_pool_name": schema.StringAttribute{
 				Description: "The name of the work pool associated with this work queue",
 				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"name": schema.StringAttribute{
 				Required:    true,
@@ -147,6 +162,20 @@ func (r *WorkQueueResource) Schema(_ context.Context, _ resource.SchemaRequest,
 	}
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (r *WorkQueueResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = workspaceIdentitySchema(map[string]identityschema.Attribute{
+		"work_pool_name": identityschema.StringAttribute{
+			RequiredForImport: true,
+			Description:       "Name of the Work Pool the Work Queue belongs to",
+		},
+		"name": identityschema.StringAttribute{
+			RequiredForImport: true,
+			Description:       "Work Queue name",
+		},
+	})
+}
+
 // copyWorkQueueToModel maps an API response to a model that is saved in Terraform state.
 func copyWorkQueueToModel(queue *api.WorkQueue, tfModel *WorkQueueResourceModel) {
 	tfModel.ID = types.StringValue(queue.ID.String())
@@ -203,6 +232,12 @@ func (r *WorkQueueResource) Create(ctx context.Context, req resource.CreateReque
 	copyWorkQueueToModel(queue, &plan)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, WorkQueueResourceIdentityModel{
+		AccountID:    plan.AccountID,
+		WorkspaceID:  plan.WorkspaceID,
+		WorkPoolName: plan.WorkPoolName,
+		Name:         plan.Name,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -230,10 +265,7 @@ func (r *WorkQueueResource) Read(ctx context.Context, req resource.ReadRequest,
 		// If the remote object does not exist, we can remove it from TF state
 		// so that the framework can queue up a new Create.
 		// https://discuss.hashicorp.com/t/recreate-a-resource-in-a-case-of-manual-deletion/66375/3
-		//
-		// NOTE: as a workaround, we encode + check this status code string on the error object.
-		// See `checkRetryPolicy` in `internal/client/client.go` for more details.
-		if strings.Contains(err.Error(), "status_code=404") {
+		if errors.Is(err, api.ErrNotFound) {
 			resp.State.RemoveResource(ctx)
 
 			return
@@ -248,6 +280,12 @@ func (r *WorkQueueResource) Read(ctx context.Context, req resource.ReadRequest,
 	copyWorkQueueToModel(queue, &state)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, WorkQueueResourceIdentityModel{
+		AccountID:    state.AccountID,
+		WorkspaceID:  state.WorkspaceID,
+		WorkPoolName: state.WorkPoolName,
+		Name:         state.Name,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -301,6 +339,12 @@ func (r *WorkQueueResource) Update(ctx context.Context, req resource.UpdateReque
 	copyWorkQueueToModel(queue, &plan)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, WorkQueueResourceIdentityModel{
+		AccountID:    plan.AccountID,
+		WorkspaceID:  plan.WorkspaceID,
+		WorkPoolName: plan.WorkPoolName,
+		Name:         plan.Name,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -336,9 +380,29 @@ func (r *WorkQueueResource) Delete(ctx context.Context, req resource.DeleteReque
 }
 
 // ImportState imports the resource into Terraform state.
+//
+// Allows input values in the form of:
+// - "work_pool_name,work_queue_name,workspace_id"
+// - a link to the work queue in the Prefect UI
+// - "workspace_handle/work_pool_name/work_queue_name" or "work_pool_name/work_queue_name"
+//
+// or the identity set in an import block.
 func (r *WorkQueueResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Allow input values in the form of:
-	// - "work_pool_name,work_queue_name,workspace_id"
+	switch {
+	case helpers.IsImportByIdentity(req):
+		helpers.ImportStateFromIdentity(ctx, req, resp)
+
+		return
+	case helpers.IsUIURL(req.ID):
+		helpers.ImportStateFromUIURL(ctx, r.client, req, resp, helpers.UIObjectWorkQueue, "work_pool_name", "name")
+
+		return
+	case isImportPath(req.ID):
+		r.importStateByPath(ctx, req, resp)
+
+		return
+	}
+
 	reqInputCount := 3
 	inputParts := strings.Split(req.ID, ",")
 
@@ -355,3 +419,34 @@ func (r *WorkQueueResource) ImportState(ctx context.Context, req resource.Import
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), inputParts[1])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace_id"), inputParts[2])...)
 }
+
+// importStateByPath imports the work queue with the work pool and work queue
+// names of the import path, in the workspace of its handle, if any.
+func (r *WorkQueueResource) importStateByPath(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	workspaceID, names, diags := splitImportPath(ctx, r.client, req, "work_pool_name/work_queue_name")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.WorkQueues(uuid.Nil, workspaceID, names[0])
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Work Queue", err))
+
+		return
+	}
+
+	// Check that the work queue exists, so that a typo fails the import
+	// rather than the refresh that follows it.
+	queue, err := client.Get(ctx, names[1])
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Work Queue", "get", err))
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("work_pool_name"), names[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), queue.Name)...)
+
+	setImportPathWorkspaceID(ctx, r.client, resp, workspaceID)
+}
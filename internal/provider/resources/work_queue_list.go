@@ -0,0 +1,86 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var _ = list.ListResourceWithConfigure(&WorkQueueResource{})
+
+// WorkQueueListModel defines the configuration of the Work Queue list resource.
+type WorkQueueListModel struct {
+	WorkspaceListModel
+
+	WorkPoolName types.String `tfsdk:"work_pool_name"`
+}
+
+// NewWorkQueueListResource returns a new WorkQueueResource as a list resource.
+//
+//nolint:ireturn // required by Terraform API
+func NewWorkQueueListResource() list.ListResource {
+	return &WorkQueueResource{}
+}
+
+// ListResourceConfigSchema defines the schema of the list resource configuration.
+func (r *WorkQueueResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = workspaceListSchema("Lists the Work Queues of a Work Pool.", map[string]listschema.Attribute{
+		"work_pool_name": listschema.StringAttribute{
+			Required:    true,
+			Description: "Name of the Work Pool whose Work Queues to list",
+		},
+	})
+}
+
+// List streams the Work Queues of a workspace.
+func (r *WorkQueueResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config WorkQueueListModel
+
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	client, err := r.client.WorkQueues(config.AccountID.ValueUUID(), config.WorkspaceID.ValueUUID(), config.WorkPoolName.ValueString())
+	if err != nil {
+		diags.Append(helpers.CreateClientErrorDiagnostic("Work Queue", err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	queues := client.Iterate(ctx, api.WorkQueueFilter{}, listOptions(req))
+
+	streamListResults(ctx, req, stream, "Work Queue", queues, func(queue *api.WorkQueue, result *list.ListResult) {
+		result.DisplayName = queue.Name
+
+		result.Diagnostics.Append(result.Identity.Set(ctx, WorkQueueResourceIdentityModel{
+			AccountID:    config.AccountID,
+			WorkspaceID:  config.WorkspaceID,
+			WorkPoolName: config.WorkPoolName,
+			Name:         types.StringValue(queue.Name),
+		})...)
+		if result.Diagnostics.HasError() || !req.IncludeResource {
+			return
+		}
+
+		var model WorkQueueResourceModel
+		result.Diagnostics.Append(getNullResource(ctx, result.Resource, &model)...)
+		if result.Diagnostics.HasError() {
+			return
+		}
+
+		model.AccountID = config.AccountID
+		model.WorkspaceID = config.WorkspaceID
+
+		copyWorkQueueToModel(queue, &model)
+		result.Diagnostics.Append(result.Resource.Set(ctx, model)...)
+	})
+}
@@ -195,6 +195,13 @@ func TestAccResource_work_queue(t *testing.T) {
 				ImportStateIdFunc: getWorkQueueImportStateID(workQueueResourceName2, workPoolName),
 				ImportStateVerify: true,
 			},
+			{
+				// Import by workspace_handle/work_pool_name/work_queue_name
+				ImportState:       true,
+				ResourceName:      workQueueResourceName2,
+				ImportStateId:     fmt.Sprintf("%s/%s/%s", workspace.Name, workPoolName, workQueueName2),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
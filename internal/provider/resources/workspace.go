@@ -2,6 +2,7 @@ package resources
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -21,7 +22,9 @@ import (
 
 var (
 	_ = resource.ResourceWithConfigure(&WorkspaceResource{})
+	_ = resource.ResourceWithModifyPlan(&WorkspaceResource{})
 	_ = resource.ResourceWithImportState(&WorkspaceResource{})
+	_ = resource.ResourceWithIdentity(&WorkspaceResource{})
 )
 
 // WorkspaceResource contains state for the resource.
@@ -32,6 +35,7 @@ type WorkspaceResource struct {
 // WorkspaceResourceModel defines the Terraform resource model.
 type WorkspaceResourceModel struct {
 	BaseModel
+	DestroyModel
 
 	AccountID customtypes.UUIDValue `tfsdk:"account_id"`
 
@@ -68,6 +72,19 @@ func (r *WorkspaceResource) Configure(_ context.Context, req resource.ConfigureR
 	r.client = client
 }
 
+// ModifyPlan fails plans destroying the workspace while its deletion protection
+// is enabled, and checks that the server supports the resource when it is
+// created. See helpers.CheckServerCapability.
+func (r *WorkspaceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	checkDeletionProtection(ctx, req, resp, "Workspace")
+
+	if r.client == nil || !helpers.IsCreate(req) {
+		return
+	}
+
+	resp.Diagnostics.Append(helpers.CheckServerCapability(r.client.ServerInfo(), "The prefect_workspace resource", "", helpers.AllCloudPlans...)...)
+}
+
 // Schema defines the schema for the resource.
 func (r *WorkspaceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
@@ -120,10 +137,17 @@ func (r *WorkspaceResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Optional:    true,
 				Computed:    true,
 			},
+			"deletion_protection": deletionProtectionAttribute("workspace", "also deletes every object in it, such as its deployments, work pools and flow run history"),
+			"on_destroy":          onDestroyAttribute("workspace"),
 		},
 	}
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (r *WorkspaceResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = accountScopedIDIdentitySchema("Workspace ID (UUID)")
+}
+
 // copyWorkspaceModel maps an API response to a model that is saved in Terraform state.
 // A model can be a Terraform Plan, State, or Config object.
 func copyWorkspaceToModel(_ context.Context, workspace *api.Workspace, tfModel *WorkspaceResourceModel) diag.Diagnostics {
@@ -175,6 +199,10 @@ func (r *WorkspaceResource) Create(ctx context.Context, req resource.CreateReque
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, AccountScopedIDIdentityModel{
+		AccountID: plan.AccountID,
+		ID:        plan.ID,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -239,6 +267,12 @@ func (r *WorkspaceResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			"Error refreshing Workspace state",
 			fmt.Sprintf("Could not read Workspace, unexpected error: %s", err.Error()),
@@ -253,7 +287,13 @@ func (r *WorkspaceResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	state.setDefaults()
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, AccountScopedIDIdentityModel{
+		AccountID: state.AccountID,
+		ID:        state.ID,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -308,6 +348,10 @@ func (r *WorkspaceResource) Update(ctx context.Context, req resource.UpdateReque
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, AccountScopedIDIdentityModel{
+		AccountID: plan.AccountID,
+		ID:        plan.ID,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -322,6 +366,12 @@ func (r *WorkspaceResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
+	shouldDelete, diags := state.shouldDelete(ctx, "Workspace")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || !shouldDelete {
+		return
+	}
+
 	client, err := r.client.Workspaces(state.AccountID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Workspace", err))
@@ -351,6 +401,12 @@ func (r *WorkspaceResource) Delete(ctx context.Context, req resource.DeleteReque
 
 // ImportState imports the resource into Terraform state.
 func (r *WorkspaceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if helpers.IsImportByIdentity(req) {
+		helpers.ImportStateFromIdentity(ctx, req, resp)
+
+		return
+	}
+
 	if strings.HasPrefix(req.ID, "handle/") {
 		handle := strings.TrimPrefix(req.ID, "handle/")
 		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("handle"), handle)...)
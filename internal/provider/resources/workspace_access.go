@@ -2,10 +2,12 @@ package resources
 
 import (
 	"context"
+	"errors"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -17,7 +19,12 @@ import (
 	"github.com/prefecthq/terraform-provider-prefect/internal/utils"
 )
 
-var _ = resource.ResourceWithConfigure(&WorkspaceAccessResource{})
+var (
+	_ = resource.ResourceWithConfigure(&WorkspaceAccessResource{})
+	_ = resource.ResourceWithModifyPlan(&WorkspaceAccessResource{})
+	_ = resource.ResourceWithImportState(&WorkspaceAccessResource{})
+	_ = resource.ResourceWithIdentity(&WorkspaceAccessResource{})
+)
 
 type WorkspaceAccessResource struct {
 	client api.PrefectClient
@@ -33,6 +40,14 @@ type WorkspaceAccessResourceModel struct {
 	AccountID   customtypes.UUIDValue `tfsdk:"account_id"`
 }
 
+// WorkspaceAccessResourceIdentityModel defines the Terraform resource identity model.
+type WorkspaceAccessResourceIdentityModel struct {
+	AccountID    customtypes.UUIDValue `tfsdk:"account_id"`
+	WorkspaceID  customtypes.UUIDValue `tfsdk:"workspace_id"`
+	AccessorType types.String          `tfsdk:"accessor_type"`
+	ID           types.String          `tfsdk:"id"`
+}
+
 // NewWorkspaceAccessResource returns a new WorkspaceAccessResource.
 //
 //nolint:ireturn // required by Terraform API
@@ -43,6 +58,7 @@ func NewWorkspaceAccessResource() resource.Resource {
 // Metadata returns the resource type name.
 func (r *WorkspaceAccessResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_workspace_access"
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 // Configure initializes runtime state for the resource.
@@ -61,6 +77,16 @@ func (r *WorkspaceAccessResource) Configure(_ context.Context, req resource.Conf
 	r.client = client
 }
 
+// ModifyPlan checks that the server supports the resource when it is created.
+// See helpers.CheckServerCapability.
+func (r *WorkspaceAccessResource) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || !helpers.IsCreate(req) {
+		return
+	}
+
+	resp.Diagnostics.Append(helpers.CheckServerCapability(r.client.ServerInfo(), "The prefect_workspace_access resource", "", helpers.PlanPrefectCloudPro, helpers.PlanPrefectCloudEnterprise)...)
+}
+
 // Schema returns the schema for the WorkspaceAccessResource.
 func (r *WorkspaceAccessResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
@@ -115,6 +141,20 @@ func (r *WorkspaceAccessResource) Schema(_ context.Context, _ resource.SchemaReq
 	}
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (r *WorkspaceAccessResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = workspaceIdentitySchema(map[string]identityschema.Attribute{
+		"accessor_type": identityschema.StringAttribute{
+			RequiredForImport: true,
+			Description:       "USER, SERVICE_ACCOUNT or TEAM",
+		},
+		"id": identityschema.StringAttribute{
+			RequiredForImport: true,
+			Description:       "Workspace access ID (UUID)",
+		},
+	})
+}
+
 // copyWorkspaceAccessToModel maps an API response to a model that is saved in Terraform state.
 // A model can be a Terraform Plan, State, or Config object.
 func copyWorkspaceAccessToModel(access *api.WorkspaceAccess, tfModel *WorkspaceAccessResourceModel) {
@@ -165,6 +205,12 @@ func (r *WorkspaceAccessResource) Create(ctx context.Context, req resource.Creat
 	copyWorkspaceAccessToModel(workspaceAccess, &plan)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, WorkspaceAccessResourceIdentityModel{
+		AccountID:    plan.AccountID,
+		WorkspaceID:  plan.WorkspaceID,
+		AccessorType: plan.AccessorType,
+		ID:           plan.ID,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -198,6 +244,12 @@ func (r *WorkspaceAccessResource) Read(ctx context.Context, req resource.ReadReq
 
 	workspaceAccess, err := client.Get(ctx, accessorType, accessID)
 	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Workspace Access", "read", err))
 
 		return
@@ -206,6 +258,12 @@ func (r *WorkspaceAccessResource) Read(ctx context.Context, req resource.ReadReq
 	copyWorkspaceAccessToModel(workspaceAccess, &state)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, WorkspaceAccessResourceIdentityModel{
+		AccountID:    state.AccountID,
+		WorkspaceID:  state.WorkspaceID,
+		AccessorType: state.AccessorType,
+		ID:           state.ID,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -239,6 +297,12 @@ func (r *WorkspaceAccessResource) Update(ctx context.Context, req resource.Updat
 	copyWorkspaceAccessToModel(workspaceAccess, &plan)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, WorkspaceAccessResourceIdentityModel{
+		AccountID:    plan.AccountID,
+		WorkspaceID:  plan.WorkspaceID,
+		AccessorType: plan.AccessorType,
+		ID:           plan.ID,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -281,3 +345,9 @@ func (r *WorkspaceAccessResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 }
+
+// ImportState imports the resource into Terraform state from the identity
+// set in an import block.
+func (r *WorkspaceAccessResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	helpers.ImportStateByIdentity(ctx, req, resp)
+}
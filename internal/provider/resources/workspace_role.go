@@ -2,6 +2,7 @@ package resources
 
 import (
 	"context"
+	"errors"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -19,7 +20,9 @@ import (
 
 var (
 	_ = resource.ResourceWithConfigure(&WorkspaceRoleResource{})
+	_ = resource.ResourceWithModifyPlan(&WorkspaceRoleResource{})
 	_ = resource.ResourceWithImportState(&WorkspaceRoleResource{})
+	_ = resource.ResourceWithIdentity(&WorkspaceRoleResource{})
 )
 
 // WorkspaceRoleResource contains state for the resource.
@@ -66,6 +69,16 @@ func (r *WorkspaceRoleResource) Configure(_ context.Context, req resource.Config
 	r.client = client
 }
 
+// ModifyPlan checks that the server supports the resource when it is created.
+// See helpers.CheckServerCapability.
+func (r *WorkspaceRoleResource) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || !helpers.IsCreate(req) {
+		return
+	}
+
+	resp.Diagnostics.Append(helpers.CheckServerCapability(r.client.ServerInfo(), "The prefect_workspace_role resource", "", helpers.PlanPrefectCloudPro, helpers.PlanPrefectCloudEnterprise)...)
+}
+
 func (r *WorkspaceRoleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: helpers.DescriptionWithPlans(
@@ -131,6 +144,11 @@ func (r *WorkspaceRoleResource) Schema(_ context.Context, _ resource.SchemaReque
 	}
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (r *WorkspaceRoleResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = accountScopedIDIdentitySchema("Workspace role ID (UUID)")
+}
+
 // copyWorkspaceRoleToModel maps an API response to a model that is saved in Terraform state.
 // A model can be a Terraform Plan, State, or Config object.
 func copyWorkspaceRoleToModel(_ context.Context, role *api.WorkspaceRole, tfModel *WorkspaceRoleResourceModel) diag.Diagnostics {
@@ -201,6 +219,10 @@ func (r *WorkspaceRoleResource) Create(ctx context.Context, req resource.CreateR
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, AccountScopedIDIdentityModel{
+		AccountID: plan.AccountID,
+		ID:        plan.ID,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -232,6 +254,12 @@ func (r *WorkspaceRoleResource) Read(ctx context.Context, req resource.ReadReque
 
 	role, err := client.Get(ctx, roleID)
 	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Workspace Role", "get", err))
 
 		return
@@ -243,6 +271,10 @@ func (r *WorkspaceRoleResource) Read(ctx context.Context, req resource.ReadReque
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, AccountScopedIDIdentityModel{
+		AccountID: state.AccountID,
+		ID:        state.ID,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -302,6 +334,10 @@ func (r *WorkspaceRoleResource) Update(ctx context.Context, req resource.UpdateR
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, AccountScopedIDIdentityModel{
+		AccountID: plan.AccountID,
+		ID:        plan.ID,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -345,6 +381,12 @@ func (r *WorkspaceRoleResource) Delete(ctx context.Context, req resource.DeleteR
 
 // ImportState allows Terraform to start managing a Workspace Role resource.
 func (r *WorkspaceRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if helpers.IsImportByIdentity(req) {
+		helpers.ImportStateFromIdentity(ctx, req, resp)
+
+		return
+	}
+
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// retryConfig holds the resolved retry settings for the API client.
+type retryConfig struct {
+	maxRetries int
+	waitMin    time.Duration
+	waitMax    time.Duration
+	timeout    time.Duration
+}
+
+// resolveRetryConfig resolves the retry settings from the provider
+// configuration, falling back to environment variables and then defaults.
+func resolveRetryConfig(config *PrefectProviderModel) (retryConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	retry := retryConfig{
+		maxRetries: defaultMaxRetries,
+		waitMin:    defaultRetryWaitMin,
+		waitMax:    defaultRetryWaitMax,
+	}
+
+	retry.maxRetries = int(resolveInt64(config.MaxRetries, "max_retries", envMaxRetries, int64(retry.maxRetries), &diags))
+
+	retry.waitMin = resolveDuration(config.RetryWaitMin, "retry_wait_min", envRetryWaitMin, retry.waitMin, &diags)
+	retry.waitMax = resolveDuration(config.RetryWaitMax, "retry_wait_max", envRetryWaitMax, retry.waitMax, &diags)
+	retry.timeout = resolveDuration(config.RetryTimeout, "retry_timeout", envRetryTimeout, retry.timeout, &diags)
+
+	if !diags.HasError() && retry.waitMax < retry.waitMin {
+		diags.AddAttributeError(
+			path.Root("retry_wait_max"),
+			"Invalid retry wait",
+			fmt.Sprintf("The maximum retry wait (%s) must not be less than the minimum retry wait (%s).", retry.waitMax, retry.waitMin),
+		)
+	}
+
+	return retry, diags
+}
+
+// resolveDuration reads a duration from a provider attribute or its matching
+// environment variable, returning the fallback if neither is set.
+func resolveDuration(attribute types.String, attributeName, envVar string, fallback time.Duration, diags *diag.Diagnostics) time.Duration {
+	var value, source string
+
+	switch {
+	case !attribute.IsNull():
+		value = attribute.ValueString()
+		source = fmt.Sprintf("The %s attribute", attributeName)
+	default:
+		envValue, ok := os.LookupEnv(envVar)
+		if !ok {
+			return fallback
+		}
+
+		value = envValue
+		source = fmt.Sprintf("The %s environment variable", envVar)
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration < 0 {
+		diags.AddAttributeError(
+			path.Root(attributeName),
+			"Invalid duration",
+			fmt.Sprintf("%s value %q is not a valid non-negative duration, such as \"500ms\" or \"30s\".", source, value),
+		)
+
+		return fallback
+	}
+
+	return duration
+}
+
+// resolveInt64 reads a non-negative integer from a provider attribute or its
+// matching environment variable, returning the fallback if neither is set.
+func resolveInt64(attribute types.Int64, attributeName, envVar string, fallback int64, diags *diag.Diagnostics) int64 {
+	if !attribute.IsNull() {
+		return attribute.ValueInt64()
+	}
+
+	value, ok := os.LookupEnv(envVar)
+	if !ok {
+		return fallback
+	}
+
+	parsed, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || parsed < 0 {
+		diags.AddAttributeError(
+			path.Root(attributeName),
+			"Invalid integer",
+			fmt.Sprintf("The %s environment variable value %q is not a non-negative integer.", envVar, value),
+		)
+
+		return fallback
+	}
+
+	return parsed
+}
+
+// resolveFloat64 reads a non-negative number from a provider attribute or its
+// matching environment variable, returning the fallback if neither is set.
+func resolveFloat64(attribute types.Float64, attributeName, envVar string, fallback float64, diags *diag.Diagnostics) float64 {
+	if !attribute.IsNull() {
+		return attribute.ValueFloat64()
+	}
+
+	value, ok := os.LookupEnv(envVar)
+	if !ok {
+		return fallback
+	}
+
+	parsed, err := strconv.ParseFloat(value, 64)
+	if err != nil || parsed < 0 {
+		diags.AddAttributeError(
+			path.Root(attributeName),
+			"Invalid number",
+			fmt.Sprintf("The %s environment variable value %q is not a non-negative number.", envVar, value),
+		)
+
+		return fallback
+	}
+
+	return parsed
+}
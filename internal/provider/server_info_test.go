@@ -0,0 +1,67 @@
+package provider_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+//nolint:paralleltest // uses t.Setenv
+func TestConfigureDetectsServer(t *testing.T) {
+	tests := []struct {
+		name        string
+		version     string
+		want        api.ServerInfo
+		wantWarning bool
+	}{
+		{
+			name:    "supported server",
+			version: `"3.1.4"`,
+			want:    api.ServerInfo{Flavor: api.ServerFlavorOSS, Version: "3.1.4"},
+		},
+		{
+			name:        "unsupported server",
+			version:     `"2.20.16"`,
+			want:        api.ServerInfo{Flavor: api.ServerFlavorOSS, Version: "2.20.16"},
+			wantWarning: true,
+		},
+		{
+			name:    "undetected server",
+			version: `{}`,
+			want:    api.ServerInfo{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, key := range []string{"PREFECT_API_URL", "PREFECT_API_KEY", "PREFECT_CLOUD_ACCOUNT_ID", "PREFECT_PROFILE"} {
+				unsetenv(t, key)
+			}
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(tt.version))
+			}))
+			t.Cleanup(server.Close)
+
+			resp := configureProvider(t, map[string]string{"endpoint": server.URL + "/api"})
+			require.False(t, resp.Diagnostics.HasError(), "unexpected errors: %v", resp.Diagnostics.Errors())
+
+			if tt.wantWarning {
+				require.Len(t, resp.Diagnostics.Warnings(), 1)
+				assert.Equal(t, "Unsupported Prefect server version", resp.Diagnostics.Warnings()[0].Summary())
+			} else {
+				assert.Empty(t, resp.Diagnostics.Warnings())
+			}
+
+			prefectClient, ok := resp.ResourceData.(*client.Client)
+			require.True(t, ok)
+			assert.Equal(t, tt.want, prefectClient.ServerInfo())
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"math"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// throttleConfig holds the resolved rate and concurrency limits
+// for the API client.
+type throttleConfig struct {
+	rateLimit             float64
+	rateLimitBurst        int
+	maxConcurrentRequests int
+}
+
+// resolveThrottleConfig resolves the rate and concurrency limits from the
+// provider configuration, falling back to environment variables and then
+// defaults. Both limits are disabled by default.
+func resolveThrottleConfig(config *PrefectProviderModel) (throttleConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	throttle := throttleConfig{}
+
+	throttle.rateLimit = resolveFloat64(config.RateLimit, "rate_limit", envRateLimit, 0, &diags)
+
+	// Unless configured, allow a burst of up to one second's worth of requests.
+	defaultBurst := max(int64(math.Ceil(throttle.rateLimit)), 1)
+	throttle.rateLimitBurst = int(resolveInt64(config.RateLimitBurst, "rate_limit_burst", envRateLimitBurst, defaultBurst, &diags))
+	throttle.rateLimitBurst = max(throttle.rateLimitBurst, 1)
+
+	throttle.maxConcurrentRequests = int(resolveInt64(config.MaxConcurrentRequests, "max_concurrent_requests", envMaxConcurrentRequests, 0, &diags))
+
+	return throttle, diags
+}
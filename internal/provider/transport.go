@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/client"
+)
+
+// resolveTransportOptions resolves the TLS, proxy, timeout and header settings
+// from the provider configuration and environment variables, returning the
+// matching client options.
+func resolveTransportOptions(ctx context.Context, config *PrefectProviderModel) ([]client.Option, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	caPEM := resolvePEM(config.CACertPEM, config.CACertFile, "ca_cert_file", envCACertFile, &diags)
+	clientCertPEM := resolvePEM(config.ClientCertPEM, config.ClientCertFile, "client_cert_file", envClientCertFile, &diags)
+	clientKeyPEM := resolvePEM(config.ClientKeyPEM, config.ClientKeyFile, "client_key_file", envClientKeyFile, &diags)
+
+	if (len(clientCertPEM) == 0) != (len(clientKeyPEM) == 0) {
+		diags.AddAttributeError(
+			path.Root("client_cert_pem"),
+			"Incomplete client certificate",
+			"A client certificate and a client key must be configured together, using either the"+
+				" client_cert_file and client_key_file attributes or the client_cert_pem and client_key_pem attributes.",
+		)
+	}
+
+	insecureSkipVerify := false
+	if !config.InsecureSkipVerify.IsNull() {
+		insecureSkipVerify = config.InsecureSkipVerify.ValueBool()
+	} else if value, ok := os.LookupEnv(envInsecureSkipVerify); ok {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("insecure_skip_verify"),
+				"Invalid TLS verification setting defined in "+envInsecureSkipVerify,
+				fmt.Sprintf("The %s value %q is not a valid boolean: %s", envInsecureSkipVerify, value, err),
+			)
+		}
+
+		insecureSkipVerify = parsed
+	}
+
+	requestTimeout := resolveDuration(config.RequestTimeout, "request_timeout", envRequestTimeout, 0, &diags)
+
+	extraHeaders := map[string]string{}
+	if !config.ExtraHeaders.IsNull() {
+		diags.Append(config.ExtraHeaders.ElementsAs(ctx, &extraHeaders, false)...)
+	} else if value, ok := os.LookupEnv(envExtraHeaders); ok {
+		if err := json.Unmarshal([]byte(value), &extraHeaders); err != nil {
+			diags.AddAttributeError(
+				path.Root("extra_headers"),
+				"Invalid extra headers defined in "+envExtraHeaders,
+				fmt.Sprintf("The %s value is not a JSON object of header names to string values: %s", envExtraHeaders, err),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	opts := []client.Option{
+		client.WithCACertificates(caPEM),
+		client.WithClientCertificate(clientCertPEM, clientKeyPEM),
+		client.WithInsecureSkipVerify(insecureSkipVerify),
+		client.WithProxy(config.ProxyURL.ValueString()),
+		client.WithRequestTimeout(requestTimeout),
+		client.WithExtraHeaders(extraHeaders),
+	}
+
+	// Apply the options to a throwaway client, so that invalid certificates,
+	// proxies or headers are reported as configuration errors rather than
+	// as a failure to create the client.
+	if _, err := client.New(opts...); err != nil {
+		diags.AddError(
+			"Invalid TLS, proxy or header configuration",
+			fmt.Sprintf("The provider's TLS, proxy or extra header settings are not valid: %s", err),
+		)
+
+		return nil, diags
+	}
+
+	return opts, diags
+}
+
+// resolvePEM returns PEM data from an inline attribute, or read from the file
+// named by a file attribute or its matching environment variable.
+func resolvePEM(pemAttribute, fileAttribute types.String, fileAttributeName, envVar string, diags *diag.Diagnostics) []byte {
+	if !pemAttribute.IsNull() {
+		return []byte(pemAttribute.ValueString())
+	}
+
+	filename := fileAttribute.ValueString()
+	source := fmt.Sprintf("the %s attribute", fileAttributeName)
+
+	if fileAttribute.IsNull() {
+		value, ok := os.LookupEnv(envVar)
+		if !ok || value == "" {
+			return nil
+		}
+
+		filename = value
+		source = fmt.Sprintf("the %s environment variable", envVar)
+	}
+
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root(fileAttributeName),
+			"Unable to read file",
+			fmt.Sprintf("Could not read the file %q configured in %s: %s", filename, source, err),
+		)
+
+		return nil
+	}
+
+	return data
+}
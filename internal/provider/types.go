@@ -14,9 +14,38 @@ type PrefectProvider struct {
 
 // PrefectProviderModel maps provider schema data to a Go type.
 type PrefectProviderModel struct {
+	Profile      types.String          `tfsdk:"profile"`
 	Endpoint     types.String          `tfsdk:"endpoint"`
 	APIKey       types.String          `tfsdk:"api_key"`
 	BasicAuthKey types.String          `tfsdk:"basic_auth_key"`
 	AccountID    customtypes.UUIDValue `tfsdk:"account_id"`
 	WorkspaceID  customtypes.UUIDValue `tfsdk:"workspace_id"`
+
+	Mode       types.String `tfsdk:"mode"`
+	CloudHosts types.List   `tfsdk:"cloud_hosts"`
+
+	MaxRetries   types.Int64  `tfsdk:"max_retries"`
+	RetryWaitMin types.String `tfsdk:"retry_wait_min"`
+	RetryWaitMax types.String `tfsdk:"retry_wait_max"`
+	RetryTimeout types.String `tfsdk:"retry_timeout"`
+
+	RateLimit             types.Float64 `tfsdk:"rate_limit"`
+	RateLimitBurst        types.Int64   `tfsdk:"rate_limit_burst"`
+	MaxConcurrentRequests types.Int64   `tfsdk:"max_concurrent_requests"`
+
+	HTTPTracing types.Bool `tfsdk:"http_tracing"`
+	ReadCache   types.Bool `tfsdk:"read_cache"`
+
+	AdoptExisting types.Bool `tfsdk:"adopt_existing"`
+
+	CACertFile         types.String `tfsdk:"ca_cert_file"`
+	CACertPEM          types.String `tfsdk:"ca_cert_pem"`
+	ClientCertFile     types.String `tfsdk:"client_cert_file"`
+	ClientCertPEM      types.String `tfsdk:"client_cert_pem"`
+	ClientKeyFile      types.String `tfsdk:"client_key_file"`
+	ClientKeyPEM       types.String `tfsdk:"client_key_pem"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+	ProxyURL           types.String `tfsdk:"proxy_url"`
+	RequestTimeout     types.String `tfsdk:"request_timeout"`
+	ExtraHeaders       types.Map    `tfsdk:"extra_headers"`
 }
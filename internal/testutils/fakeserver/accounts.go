@@ -0,0 +1,366 @@
+package fakeserver
+
+import (
+	"net/http"
+	"slices"
+	"sort"
+
+	"github.com/google/uuid"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+)
+
+func (s *Server) accountRoutes() {
+	s.handleAccount("GET /{$}", s.getAccount)
+	s.handleAccount("PATCH /{$}", s.updateAccount)
+	s.handleAccount("DELETE /{$}", s.deleteAccount)
+	s.handleAccount("PATCH /settings", s.updateAccountSettings)
+	s.handleAccount("GET /domains", s.getAccountDomains)
+	s.handleAccount("PATCH /domains", s.updateAccountDomains)
+
+	s.handleAccount("POST /account_memberships/filter", s.listAccountMemberships)
+	s.handleAccount("PATCH /account_memberships/{id}", s.updateAccountMembership)
+	s.handleAccount("DELETE /account_memberships/{id}", s.deleteAccountMembership)
+
+	s.handleAccount("POST /account_roles/filter", s.listAccountRoles)
+	s.handleAccount("GET /account_roles/{id}", s.getAccountRole)
+
+	s.handleAccount("POST /workspace_roles/{$}", s.createWorkspaceRole)
+	s.handleAccount("POST /workspace_roles/filter", s.listWorkspaceRoles)
+	s.handleAccount("GET /workspace_roles/{id}", s.getWorkspaceRole)
+	s.handleAccount("PATCH /workspace_roles/{id}", s.updateWorkspaceRole)
+	s.handleAccount("DELETE /workspace_roles/{id}", s.deleteWorkspaceRole)
+}
+
+func (s *Server) getAccount(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, s.account)
+}
+
+func (s *Server) updateAccount(w http.ResponseWriter, r *http.Request) {
+	var payload api.AccountUpdate
+	if !decodeBody(w, r, &payload) {
+		return
+	}
+
+	if !requireField(w, payload.Name, "name") || !requireField(w, payload.Handle, "handle") {
+		return
+	}
+
+	s.account.AccountUpdate = payload
+	touch(&s.account.BaseModel)
+
+	writeNoContent(w)
+}
+
+func (s *Server) deleteAccount(w http.ResponseWriter, _ *http.Request) {
+	s.account = nil
+
+	writeNoContent(w)
+}
+
+func (s *Server) updateAccountSettings(w http.ResponseWriter, r *http.Request) {
+	var payload api.AccountSettings
+	if !decodeBody(w, r, &payload) {
+		return
+	}
+
+	s.account.Settings = payload
+	touch(&s.account.BaseModel)
+
+	writeNoContent(w)
+}
+
+func (s *Server) getAccountDomains(w http.ResponseWriter, _ *http.Request) {
+	domains := make([]api.AccountDomain, 0, len(s.account.Domains))
+	for _, name := range s.account.Domains {
+		domains = append(domains, api.AccountDomain{Name: name})
+	}
+
+	writeJSON(w, http.StatusOK, domains)
+}
+
+func (s *Server) updateAccountDomains(w http.ResponseWriter, r *http.Request) {
+	var payload api.AccountDomainsUpdate
+	if !decodeBody(w, r, &payload) {
+		return
+	}
+
+	s.account.Domains = append([]string{}, payload.DomainNames...)
+	touch(&s.account.BaseModel)
+
+	writeNoContent(w)
+}
+
+func (s *Server) listAccountMemberships(w http.ResponseWriter, r *http.Request) {
+	var filter api.AccountMembershipFilter
+	page, ok := decodeFilter(w, r, &filter)
+	if !ok {
+		return
+	}
+
+	emails := filter.AccountMemberships.Email.Any
+
+	memberships := []*api.AccountMembership{}
+	for _, membership := range s.accountMemberships {
+		if len(emails) == 0 || slices.Contains(emails, membership.Email) {
+			memberships = append(memberships, membership)
+		}
+	}
+
+	sort.Slice(memberships, func(i, j int) bool { return memberships[i].Email < memberships[j].Email })
+
+	writeJSON(w, http.StatusOK, paginate(memberships, page))
+}
+
+func (s *Server) updateAccountMembership(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathUUID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	membership, ok := s.accountMemberships[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Account membership not found.")
+
+		return
+	}
+
+	var payload api.AccountMembershipUpdate
+	if !decodeBody(w, r, &payload) {
+		return
+	}
+
+	role, ok := s.accountRoles[payload.AccountRoleID]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Account role not found.")
+
+		return
+	}
+
+	membership.AccountRoleID = role.ID
+	membership.AccountRoleName = role.Name
+
+	writeNoContent(w)
+}
+
+func (s *Server) deleteAccountMembership(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathUUID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	if _, ok := s.accountMemberships[id]; !ok {
+		writeError(w, http.StatusNotFound, "Account membership not found.")
+
+		return
+	}
+
+	delete(s.accountMemberships, id)
+
+	writeNoContent(w)
+}
+
+func (s *Server) listAccountRoles(w http.ResponseWriter, r *http.Request) {
+	var filter api.AccountRoleFilter
+	page, ok := decodeFilter(w, r, &filter)
+	if !ok {
+		return
+	}
+
+	names := filter.AccountRoles.Name.Any
+
+	roles := []*api.AccountRole{}
+	for _, role := range s.accountRoles {
+		if len(names) == 0 || slices.Contains(names, role.Name) {
+			roles = append(roles, role)
+		}
+	}
+
+	sort.Slice(roles, func(i, j int) bool { return roles[i].Name < roles[j].Name })
+
+	writeJSON(w, http.StatusOK, paginate(roles, page))
+}
+
+func (s *Server) getAccountRole(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathUUID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	role, ok := s.accountRoles[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Account role not found.")
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, role)
+}
+
+func (s *Server) createWorkspaceRole(w http.ResponseWriter, r *http.Request) {
+	var payload api.WorkspaceRoleUpsert
+	if !decodeBody(w, r, &payload) {
+		return
+	}
+
+	if !requireField(w, payload.Name, "name") {
+		return
+	}
+
+	for _, existing := range s.workspaceRoles {
+		if existing.Name == payload.Name {
+			writeError(w, http.StatusConflict, "A workspace role with this name already exists.")
+
+			return
+		}
+	}
+
+	role := &api.WorkspaceRole{BaseModel: newBaseModel(), AccountID: &s.account.ID}
+	applyWorkspaceRoleUpsert(role, payload)
+	s.workspaceRoles[role.ID] = role
+
+	writeJSON(w, http.StatusCreated, role)
+}
+
+func applyWorkspaceRoleUpsert(role *api.WorkspaceRole, payload api.WorkspaceRoleUpsert) {
+	description := payload.Description
+
+	role.Name = payload.Name
+	role.Description = &description
+	role.Scopes = append([]string{}, payload.Scopes...)
+	role.InheritedRoleID = payload.InheritedRoleID
+}
+
+func (s *Server) listWorkspaceRoles(w http.ResponseWriter, r *http.Request) {
+	var filter api.WorkspaceRoleFilter
+	page, ok := decodeFilter(w, r, &filter)
+	if !ok {
+		return
+	}
+
+	names := filter.WorkspaceRoles.Name.Any
+
+	roles := []*api.WorkspaceRole{}
+	for _, role := range s.workspaceRoles {
+		if len(names) == 0 || slices.Contains(names, role.Name) {
+			roles = append(roles, role)
+		}
+	}
+
+	sort.Slice(roles, func(i, j int) bool { return roles[i].Name < roles[j].Name })
+
+	writeJSON(w, http.StatusOK, paginate(roles, page))
+}
+
+func (s *Server) getWorkspaceRole(w http.ResponseWriter, r *http.Request) {
+	role, ok := s.findWorkspaceRole(w, r)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, role)
+}
+
+func (s *Server) updateWorkspaceRole(w http.ResponseWriter, r *http.Request) {
+	role, ok := s.findWorkspaceRole(w, r)
+	if !ok {
+		return
+	}
+
+	if role.AccountID == nil {
+		writeError(w, http.StatusForbidden, "System workspace roles cannot be modified.")
+
+		return
+	}
+
+	var payload api.WorkspaceRoleUpsert
+	if !decodeBody(w, r, &payload) {
+		return
+	}
+
+	applyWorkspaceRoleUpsert(role, payload)
+	touch(&role.BaseModel)
+
+	writeNoContent(w)
+}
+
+func (s *Server) deleteWorkspaceRole(w http.ResponseWriter, r *http.Request) {
+	role, ok := s.findWorkspaceRole(w, r)
+	if !ok {
+		return
+	}
+
+	if role.AccountID == nil {
+		writeError(w, http.StatusForbidden, "System workspace roles cannot be deleted.")
+
+		return
+	}
+
+	delete(s.workspaceRoles, role.ID)
+
+	writeNoContent(w)
+}
+
+func (s *Server) findWorkspaceRole(w http.ResponseWriter, r *http.Request) (*api.WorkspaceRole, bool) {
+	id, ok := pathUUID(w, r, "id")
+	if !ok {
+		return nil, false
+	}
+
+	role, ok := s.workspaceRoles[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Workspace role not found.")
+
+		return nil, false
+	}
+
+	return role, true
+}
+
+// actorAccess resolves an actor or team ID to the representation used
+// by the object-level access control endpoints.
+func (s *Server) actorAccess(id string) (api.ObjectActorAccess, bool) {
+	parsed, err := uuid.Parse(id)
+	if err != nil {
+		return api.ObjectActorAccess{}, false
+	}
+
+	for _, user := range s.users {
+		if user.ActorID == id {
+			email := user.Email
+
+			return api.ObjectActorAccess{ID: id, Name: user.Handle, Email: &email, Type: api.UserAccessor}, true
+		}
+	}
+
+	for _, serviceAccount := range s.serviceAccounts {
+		if serviceAccount.ActorID == parsed {
+			return api.ObjectActorAccess{ID: id, Name: serviceAccount.Name, Type: api.ServiceAccountAccessor}, true
+		}
+	}
+
+	if team, ok := s.teams[parsed]; ok {
+		return api.ObjectActorAccess{ID: id, Name: team.Name, Type: api.TeamAccessor}, true
+	}
+
+	return api.ObjectActorAccess{}, false
+}
+
+// resolveActorAccess resolves a list of actor or team IDs, writing a 404
+// if any of them do not exist.
+func (s *Server) resolveActorAccess(w http.ResponseWriter, ids []string) ([]api.ObjectActorAccess, bool) {
+	actors := []api.ObjectActorAccess{}
+
+	for _, id := range ids {
+		actor, ok := s.actorAccess(id)
+		if !ok {
+			writeError(w, http.StatusNotFound, "Actor "+id+" not found.")
+
+			return nil, false
+		}
+
+		actors = append(actors, actor)
+	}
+
+	return actors, true
+}
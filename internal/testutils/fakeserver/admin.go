@@ -0,0 +1,14 @@
+package fakeserver
+
+import "net/http"
+
+// Version is the self-hosted server version reported by the fake.
+const Version = "3.1.4"
+
+func (s *Server) adminRoutes() {
+	s.mux.HandleFunc("GET /api/admin/version", s.getVersion)
+}
+
+func (s *Server) getVersion(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, Version)
+}
@@ -0,0 +1,239 @@
+package fakeserver
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+)
+
+func (s *Server) automationRoutes() {
+	s.handleWorkspace("POST /automations/{$}", s.createAutomation)
+	s.handleWorkspace("POST /automations/filter", s.listAutomations)
+	s.handleWorkspace("GET /automations/{id}", s.getAutomation)
+	s.handleWorkspace("PUT /automations/{id}", s.updateAutomation)
+	s.handleWorkspace("DELETE /automations/{id}", s.deleteAutomation)
+	s.handleWorkspace("GET /automations/related-to/{resource_id}", s.listRelatedAutomations)
+	s.handleWorkspace("DELETE /automations/owned-by/{resource_id}", s.deleteOwnedAutomations)
+
+	s.handleWorkspace("POST /slas/apply-resource-slas/{resource_id}", s.applyResourceSLAs)
+}
+
+func (s *Server) createAutomation(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	var payload api.AutomationUpsert
+	if !decodeBody(w, r, &payload) {
+		return
+	}
+
+	if !requireField(w, payload.Name, "name") {
+		return
+	}
+
+	automation := &api.Automation{
+		BaseModel:        newBaseModel(),
+		AutomationUpsert: payload,
+		AccountID:        ws.accountID(),
+		WorkspaceID:      ws.workspaceID(),
+	}
+	ws.automations[automation.ID] = automation
+
+	writeJSON(w, http.StatusCreated, automation)
+}
+
+func findAutomation(w http.ResponseWriter, r *http.Request, ws *workspaceState) (*api.Automation, bool) {
+	id, ok := pathUUID(w, r, "id")
+	if !ok {
+		return nil, false
+	}
+
+	automation, ok := ws.automations[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Automation not found.")
+
+		return nil, false
+	}
+
+	return automation, true
+}
+
+func (s *Server) listAutomations(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	page, ok := decodeFilter(w, r, &struct{}{})
+	if !ok {
+		return
+	}
+
+	automations := make([]*api.Automation, 0, len(ws.automations))
+	for _, automation := range ws.automations {
+		automations = append(automations, automation)
+	}
+
+	sort.Slice(automations, func(i, j int) bool { return automations[i].Name < automations[j].Name })
+
+	writeJSON(w, http.StatusOK, paginate(automations, page))
+}
+
+func (s *Server) getAutomation(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	automation, ok := findAutomation(w, r, ws)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, automation)
+}
+
+func (s *Server) updateAutomation(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	automation, ok := findAutomation(w, r, ws)
+	if !ok {
+		return
+	}
+
+	var payload api.AutomationUpsert
+	if !decodeBody(w, r, &payload) {
+		return
+	}
+
+	automation.AutomationUpsert = payload
+	touch(&automation.BaseModel)
+
+	writeNoContent(w)
+}
+
+func (s *Server) deleteAutomation(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	automation, ok := findAutomation(w, r, ws)
+	if !ok {
+		return
+	}
+
+	delete(ws.automations, automation.ID)
+
+	writeNoContent(w)
+}
+
+// listRelatedAutomations lists the automations owned by a resource, or
+// with an action on it, ordered by creation.
+func (s *Server) listRelatedAutomations(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	resourceID := r.PathValue("resource_id")
+
+	automations := make([]*api.Automation, 0)
+	for _, automation := range ws.automations {
+		if isRelatedAutomation(automation, resourceID) {
+			automations = append(automations, automation)
+		}
+	}
+
+	sort.Slice(automations, func(i, j int) bool {
+		if !automations[i].Created.Equal(*automations[j].Created) {
+			return automations[i].Created.Before(*automations[j].Created)
+		}
+
+		return automations[i].ID.String() < automations[j].ID.String()
+	})
+
+	writeJSON(w, http.StatusOK, automations)
+}
+
+func (s *Server) deleteOwnedAutomations(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	resourceID := r.PathValue("resource_id")
+
+	for id, automation := range ws.automations {
+		if automation.OwnerResource != nil && *automation.OwnerResource == resourceID {
+			delete(ws.automations, id)
+		}
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func isRelatedAutomation(automation *api.Automation, resourceID string) bool {
+	if automation.OwnerResource != nil && *automation.OwnerResource == resourceID {
+		return true
+	}
+
+	for _, actions := range [][]api.Action{automation.Actions, automation.ActionsOnTrigger, automation.ActionsOnResolve} {
+		for _, action := range actions {
+			if action.DeploymentID != nil && "prefect.deployment."+action.DeploymentID.String() == resourceID {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// applyResourceSLAs replaces the SLAs attached to a resource, matching
+// existing SLAs by name.
+func (s *Server) applyResourceSLAs(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	resourceID := r.PathValue("resource_id")
+
+	var payloads []api.SLAUpsert
+	if !decodeBody(w, r, &payloads) {
+		return
+	}
+
+	existing := ws.slas[resourceID]
+	applied := map[string]*api.SLA{}
+	response := api.SLAResponse{Created: []api.SLA{}, Updated: []api.SLA{}, Deleted: []api.SLA{}}
+
+	for _, payload := range payloads {
+		if !requireField(w, payload.Name, "name") {
+			return
+		}
+
+		sla, ok := existing[payload.Name]
+		if !ok {
+			sla = &api.SLA{
+				Automation: api.Automation{
+					BaseModel:   newBaseModel(),
+					AccountID:   ws.accountID(),
+					WorkspaceID: ws.workspaceID(),
+				},
+			}
+		}
+
+		sla.Name = payload.Name
+		sla.Enabled = payload.Enabled == nil || *payload.Enabled
+		sla.Severity = "high"
+		sla.Type = slaType(payload)
+
+		if payload.Severity != nil {
+			sla.Severity = *payload.Severity
+		}
+
+		touch(&sla.BaseModel)
+		applied[sla.Name] = sla
+
+		if ok {
+			response.Updated = append(response.Updated, *sla)
+		} else {
+			response.Created = append(response.Created, *sla)
+		}
+	}
+
+	for name, sla := range existing {
+		if _, ok := applied[name]; !ok {
+			response.Deleted = append(response.Deleted, *sla)
+		}
+	}
+
+	for _, list := range [][]api.SLA{response.Created, response.Updated, response.Deleted} {
+		sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	}
+
+	ws.slas[resourceID] = applied
+
+	writeJSON(w, http.StatusOK, response)
+}
+
+// slaType infers the type of an SLA from the fields set on it.
+func slaType(payload api.SLAUpsert) string {
+	switch {
+	case payload.Duration != nil:
+		return "time-to-completion"
+	case payload.StaleAfter != nil:
+		return "frequency"
+	case payload.Within != nil && payload.ExpectedEvent != nil:
+		return "lateness"
+	default:
+		return "freshness"
+	}
+}
@@ -0,0 +1,333 @@
+package fakeserver
+
+import (
+	"maps"
+	"net/http"
+	"slices"
+	"sort"
+
+	"github.com/google/uuid"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+)
+
+// blockDocument wraps a block document with the fake's bookkeeping.
+type blockDocument struct {
+	*api.BlockDocument
+
+	// pendingReads is the number of reads that will 404 before the
+	// document becomes visible.
+	pendingReads int
+
+	access api.BlockDocumentAccess
+}
+
+func (s *Server) blockRoutes() {
+	s.handleWorkspace("GET /block_types/slug/{slug}", s.getBlockTypeBySlug)
+	s.handleWorkspace("POST /block_schemas/filter", s.listBlockSchemas)
+
+	s.handleWorkspace("POST /block_documents/{$}", s.createBlockDocument)
+	s.handleWorkspace("POST /block_documents/filter", s.listBlockDocuments)
+	s.handleWorkspace("GET /block_documents/{id}", s.getBlockDocument)
+	s.handleWorkspace("PATCH /block_documents/{id}", s.updateBlockDocument)
+	s.handleWorkspace("DELETE /block_documents/{id}", s.deleteBlockDocument)
+	s.handleWorkspace("GET /block_documents/{id}/access", s.getBlockDocumentAccess)
+	s.handleWorkspace("PUT /block_documents/{id}/access", s.upsertBlockDocumentAccess)
+	s.handleWorkspace("GET /block_types/slug/{slug}/block_documents/name/{name}", s.getBlockDocumentByName)
+}
+
+func (s *Server) blockTypeBySlug(slug string) (*api.BlockType, bool) {
+	for _, blockType := range s.blockTypes {
+		if blockType.Slug == slug {
+			return blockType, true
+		}
+	}
+
+	return nil, false
+}
+
+func (s *Server) getBlockTypeBySlug(w http.ResponseWriter, r *http.Request, _ *workspaceState) {
+	blockType, ok := s.blockTypeBySlug(r.PathValue("slug"))
+	if !ok {
+		writeError(w, http.StatusNotFound, "Block type not found.")
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, blockType)
+}
+
+func (s *Server) listBlockSchemas(w http.ResponseWriter, r *http.Request, _ *workspaceState) {
+	var filter api.BlockSchemaFilter
+	page, ok := decodeFilter(w, r, &filter)
+	if !ok {
+		return
+	}
+
+	blockTypeIDs := filter.BlockSchemas.BlockTypeID.Any
+
+	schemas := []*api.BlockSchema{}
+	for _, schema := range s.blockSchemas {
+		if len(blockTypeIDs) == 0 || slices.Contains(blockTypeIDs, schema.BlockTypeID) {
+			schemas = append(schemas, schema)
+		}
+	}
+
+	sort.Slice(schemas, func(i, j int) bool { return schemas[i].BlockType.Slug < schemas[j].BlockType.Slug })
+
+	writeJSON(w, http.StatusOK, paginate(schemas, page))
+}
+
+func (s *Server) createBlockDocument(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	var payload api.BlockDocumentCreate
+	if !decodeBody(w, r, &payload) {
+		return
+	}
+
+	if !requireField(w, payload.Name, "name") {
+		return
+	}
+
+	blockType, ok := s.blockTypes[payload.BlockTypeID]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Block type not found.")
+
+		return
+	}
+
+	schema, ok := s.blockSchemas[payload.BlockSchemaID]
+	if !ok || schema.BlockTypeID != blockType.ID {
+		writeError(w, http.StatusNotFound, "Block schema not found.")
+
+		return
+	}
+
+	for _, existing := range ws.blockDocuments {
+		if existing.Name == payload.Name && existing.BlockTypeID == blockType.ID {
+			writeError(w, http.StatusConflict, "Block already exists")
+
+			return
+		}
+	}
+
+	blockTypeName := blockType.Slug
+	document := &blockDocument{
+		BlockDocument: &api.BlockDocument{
+			BaseModel:     newBaseModel(),
+			Name:          payload.Name,
+			Data:          copyData(payload.Data),
+			BlockSchemaID: schema.ID,
+			BlockSchema:   schema,
+			BlockTypeID:   blockType.ID,
+			BlockTypeName: &blockTypeName,
+			BlockType:     *blockType,
+		},
+		pendingReads: s.blockDocumentReadDelay,
+		access: api.BlockDocumentAccess{
+			ManageActors: []api.ObjectActorAccess{},
+			ViewActors:   []api.ObjectActorAccess{},
+		},
+	}
+	ws.blockDocuments[document.ID] = document
+
+	writeJSON(w, http.StatusCreated, document.BlockDocument)
+}
+
+// findBlockDocument looks up a block document by ID, writing a 404 if it does
+// not exist or has not become visible yet.
+func findBlockDocument(w http.ResponseWriter, r *http.Request, ws *workspaceState) (*blockDocument, bool) {
+	id, ok := pathUUID(w, r, "id")
+	if !ok {
+		return nil, false
+	}
+
+	document, ok := ws.blockDocuments[id]
+	if !ok || !document.visible() {
+		writeError(w, http.StatusNotFound, "Block document not found")
+
+		return nil, false
+	}
+
+	return document, true
+}
+
+// visible consumes one pending read, reporting whether the document can be read.
+func (d *blockDocument) visible() bool {
+	if d.pendingReads > 0 {
+		d.pendingReads--
+
+		return false
+	}
+
+	return true
+}
+
+// response returns the document as returned by the API, obfuscating
+// secret fields unless the caller asked for them.
+func (d *blockDocument) response(includeSecrets bool) *api.BlockDocument {
+	if includeSecrets {
+		return d.BlockDocument
+	}
+
+	response := *d.BlockDocument
+	response.Data = copyData(d.Data)
+
+	for _, field := range secretFields(d.BlockSchema) {
+		if _, ok := response.Data[field]; ok {
+			response.Data[field] = "**********"
+		}
+	}
+
+	return &response
+}
+
+func secretFields(schema *api.BlockSchema) []string {
+	fields, ok := schema.Fields.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	secrets, _ := fields["secret_fields"].([]string)
+
+	return secrets
+}
+
+func copyData(data map[string]interface{}) map[string]interface{} {
+	if data == nil {
+		return map[string]interface{}{}
+	}
+
+	return maps.Clone(data)
+}
+
+func (s *Server) getBlockDocument(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	document, ok := findBlockDocument(w, r, ws)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, document.response(r.URL.Query().Get("include_secrets") == "true"))
+}
+
+func (s *Server) listBlockDocuments(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	var filter api.BlockDocumentFilter
+	page, ok := decodeFilter(w, r, &filter)
+	if !ok {
+		return
+	}
+
+	slugs := filter.BlockTypes.Slug.Any
+
+	documents := []*blockDocument{}
+	for _, document := range ws.blockDocuments {
+		if len(slugs) == 0 || slices.Contains(slugs, document.BlockType.Slug) {
+			documents = append(documents, document)
+		}
+	}
+
+	sort.Slice(documents, func(i, j int) bool { return documents[i].Name < documents[j].Name })
+
+	responses := []*api.BlockDocument{}
+	for _, document := range paginate(documents, page) {
+		responses = append(responses, document.response(filter.IncludeSecrets))
+	}
+
+	writeJSON(w, http.StatusOK, responses)
+}
+
+func (s *Server) getBlockDocumentByName(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	slug := r.PathValue("slug")
+	name := r.PathValue("name")
+
+	for _, document := range ws.blockDocuments {
+		if document.BlockType.Slug == slug && document.Name == name && document.visible() {
+			writeJSON(w, http.StatusOK, document.response(r.URL.Query().Get("include_secrets") == "true"))
+
+			return
+		}
+	}
+
+	writeError(w, http.StatusNotFound, "Block document not found")
+}
+
+func (s *Server) updateBlockDocument(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	document, ok := findBlockDocument(w, r, ws)
+	if !ok {
+		return
+	}
+
+	var payload api.BlockDocumentUpdate
+	if !decodeBody(w, r, &payload) {
+		return
+	}
+
+	if payload.BlockSchemaID != uuid.Nil {
+		schema, ok := s.blockSchemas[payload.BlockSchemaID]
+		if !ok {
+			writeError(w, http.StatusNotFound, "Block schema not found.")
+
+			return
+		}
+
+		document.BlockSchemaID = schema.ID
+		document.BlockSchema = schema
+	}
+
+	if payload.MergeExistingData {
+		maps.Copy(document.Data, payload.Data)
+	} else {
+		document.Data = copyData(payload.Data)
+	}
+
+	touch(&document.BaseModel)
+
+	writeNoContent(w)
+}
+
+func (s *Server) deleteBlockDocument(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	document, ok := findBlockDocument(w, r, ws)
+	if !ok {
+		return
+	}
+
+	delete(ws.blockDocuments, document.ID)
+
+	writeNoContent(w)
+}
+
+func (s *Server) getBlockDocumentAccess(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	document, ok := findBlockDocument(w, r, ws)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, document.access)
+}
+
+func (s *Server) upsertBlockDocumentAccess(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	document, ok := findBlockDocument(w, r, ws)
+	if !ok {
+		return
+	}
+
+	var payload api.BlockDocumentAccessUpsert
+	if !decodeBody(w, r, &payload) {
+		return
+	}
+
+	control := payload.AccessControl
+
+	manageActors, ok := s.resolveActorAccess(w, append(slices.Clone(control.ManageActorIDs), control.ManageTeamIDs...))
+	if !ok {
+		return
+	}
+
+	viewActors, ok := s.resolveActorAccess(w, append(slices.Clone(control.ViewActorIDs), control.ViewTeamIDs...))
+	if !ok {
+		return
+	}
+
+	document.access = api.BlockDocumentAccess{ManageActors: manageActors, ViewActors: viewActors}
+
+	writeNoContent(w)
+}
@@ -0,0 +1,15 @@
+package fakeserver
+
+import (
+	"net/http"
+)
+
+func (s *Server) collectionRoutes() {
+	// Self-hosted servers and Prefect Cloud expose the same data under different routes.
+	s.handleWorkspace("GET /collections/views/aggregate-worker-metadata", s.getWorkerMetadataViews)
+	s.handleWorkspace("GET /collections/work_pool_types", s.getWorkerMetadataViews)
+}
+
+func (s *Server) getWorkerMetadataViews(w http.ResponseWriter, _ *http.Request, _ *workspaceState) {
+	writeJSON(w, http.StatusOK, s.workerMetadataViews)
+}
@@ -0,0 +1,201 @@
+package fakeserver
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/google/uuid"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+)
+
+func (s *Server) concurrencyLimitRoutes() {
+	s.handleWorkspace("POST /v2/concurrency_limits/{$}", s.createGlobalConcurrencyLimit)
+	s.handleWorkspace("POST /v2/concurrency_limits/filter", s.listGlobalConcurrencyLimits)
+	s.handleWorkspace("GET /v2/concurrency_limits/{id_or_name}", s.getGlobalConcurrencyLimit)
+	s.handleWorkspace("PATCH /v2/concurrency_limits/{id_or_name}", s.updateGlobalConcurrencyLimit)
+	s.handleWorkspace("DELETE /v2/concurrency_limits/{id_or_name}", s.deleteGlobalConcurrencyLimit)
+
+	s.handleWorkspace("POST /concurrency_limits/{$}", s.createTaskRunConcurrencyLimit)
+	s.handleWorkspace("GET /concurrency_limits/{id}", s.getTaskRunConcurrencyLimit)
+	s.handleWorkspace("DELETE /concurrency_limits/{id}", s.deleteTaskRunConcurrencyLimit)
+}
+
+func (s *Server) createGlobalConcurrencyLimit(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	var payload api.GlobalConcurrencyLimitCreate
+	if !decodeBody(w, r, &payload) {
+		return
+	}
+
+	if !requireField(w, payload.Name, "name") {
+		return
+	}
+
+	for _, existing := range ws.globalConcurrencyLimits {
+		if existing.Name == payload.Name {
+			writeError(w, http.StatusConflict, "Concurrency limit with the same name already exists.")
+
+			return
+		}
+	}
+
+	limit := &api.GlobalConcurrencyLimit{
+		BaseModel:          newBaseModel(),
+		AccountID:          ws.accountID(),
+		WorkspaceID:        ws.workspaceID(),
+		Active:             payload.Active,
+		Name:               payload.Name,
+		Limit:              payload.Limit,
+		ActiveSlots:        payload.ActiveSlots,
+		SlotDecayPerSecond: payload.SlotDecayPerSecond,
+	}
+	ws.globalConcurrencyLimits[limit.ID] = limit
+
+	writeJSON(w, http.StatusCreated, limit)
+}
+
+// findGlobalConcurrencyLimit looks up a global concurrency limit by ID or by name,
+// as both are accepted by the API.
+func findGlobalConcurrencyLimit(w http.ResponseWriter, r *http.Request, ws *workspaceState) (*api.GlobalConcurrencyLimit, bool) {
+	idOrName := r.PathValue("id_or_name")
+
+	if id, err := uuid.Parse(idOrName); err == nil {
+		if limit, ok := ws.globalConcurrencyLimits[id]; ok {
+			return limit, true
+		}
+	}
+
+	for _, limit := range ws.globalConcurrencyLimits {
+		if limit.Name == idOrName {
+			return limit, true
+		}
+	}
+
+	writeError(w, http.StatusNotFound, "Concurrency Limit not found")
+
+	return nil, false
+}
+
+func (s *Server) listGlobalConcurrencyLimits(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	page, ok := decodeFilter(w, r, &struct{}{})
+	if !ok {
+		return
+	}
+
+	limits := make([]*api.GlobalConcurrencyLimit, 0, len(ws.globalConcurrencyLimits))
+	for _, limit := range ws.globalConcurrencyLimits {
+		limits = append(limits, limit)
+	}
+
+	sort.Slice(limits, func(i, j int) bool { return limits[i].Name < limits[j].Name })
+
+	writeJSON(w, http.StatusOK, paginate(limits, page))
+}
+
+func (s *Server) getGlobalConcurrencyLimit(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	limit, ok := findGlobalConcurrencyLimit(w, r, ws)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, limit)
+}
+
+func (s *Server) updateGlobalConcurrencyLimit(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	limit, ok := findGlobalConcurrencyLimit(w, r, ws)
+	if !ok {
+		return
+	}
+
+	var payload api.GlobalConcurrencyLimitUpdate
+	if !decodeBody(w, r, &payload) {
+		return
+	}
+
+	setIfNotZero(&limit.Name, payload.Name)
+	limit.Active = payload.Active
+	limit.Limit = payload.Limit
+	limit.ActiveSlots = payload.ActiveSlots
+	limit.SlotDecayPerSecond = payload.SlotDecayPerSecond
+	touch(&limit.BaseModel)
+
+	writeNoContent(w)
+}
+
+func (s *Server) deleteGlobalConcurrencyLimit(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	limit, ok := findGlobalConcurrencyLimit(w, r, ws)
+	if !ok {
+		return
+	}
+
+	delete(ws.globalConcurrencyLimits, limit.ID)
+
+	writeNoContent(w)
+}
+
+func (s *Server) createTaskRunConcurrencyLimit(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	var payload api.TaskRunConcurrencyLimitCreate
+	if !decodeBody(w, r, &payload) {
+		return
+	}
+
+	if !requireField(w, payload.Tag, "tag") {
+		return
+	}
+
+	// Creating a task run concurrency limit is an upsert keyed on the tag.
+	for _, existing := range ws.taskRunConcurrencyLimits {
+		if existing.Tag == payload.Tag {
+			existing.ConcurrencyLimit = payload.ConcurrencyLimit
+			touch(&existing.BaseModel)
+			writeJSON(w, http.StatusOK, existing)
+
+			return
+		}
+	}
+
+	limit := &api.TaskRunConcurrencyLimit{
+		BaseModel:        newBaseModel(),
+		Tag:              payload.Tag,
+		ConcurrencyLimit: payload.ConcurrencyLimit,
+	}
+	ws.taskRunConcurrencyLimits[limit.ID] = limit
+
+	writeJSON(w, http.StatusOK, limit)
+}
+
+func findTaskRunConcurrencyLimit(w http.ResponseWriter, r *http.Request, ws *workspaceState) (*api.TaskRunConcurrencyLimit, bool) {
+	id, ok := pathUUID(w, r, "id")
+	if !ok {
+		return nil, false
+	}
+
+	limit, ok := ws.taskRunConcurrencyLimits[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Concurrency limit not found")
+
+		return nil, false
+	}
+
+	return limit, true
+}
+
+func (s *Server) getTaskRunConcurrencyLimit(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	limit, ok := findTaskRunConcurrencyLimit(w, r, ws)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, limit)
+}
+
+func (s *Server) deleteTaskRunConcurrencyLimit(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	limit, ok := findTaskRunConcurrencyLimit(w, r, ws)
+	if !ok {
+		return
+	}
+
+	delete(ws.taskRunConcurrencyLimits, limit.ID)
+
+	writeJSON(w, http.StatusOK, map[string]string{})
+}
@@ -0,0 +1,574 @@
+package fakeserver
+
+import (
+	"net/http"
+	"slices"
+	"sort"
+
+	"github.com/google/uuid"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+)
+
+// deployment wraps a deployment with the objects nested underneath it.
+type deployment struct {
+	*api.Deployment
+
+	access    api.DeploymentAccessControl
+	schedules map[uuid.UUID]*api.DeploymentSchedule
+}
+
+// response returns the deployment as returned by the API, which includes its schedules.
+func (d *deployment) response() *api.Deployment {
+	response := *d.Deployment
+	response.Schedules = d.sortedSchedules()
+
+	return &response
+}
+
+// sortedSchedules returns the schedules of the deployment, oldest first.
+func (d *deployment) sortedSchedules() []*api.DeploymentSchedule {
+	schedules := make([]*api.DeploymentSchedule, 0, len(d.schedules))
+	for _, schedule := range d.schedules {
+		schedules = append(schedules, schedule)
+	}
+
+	sort.Slice(schedules, func(i, j int) bool { return schedules[i].Created.Before(*schedules[j].Created) })
+
+	return schedules
+}
+
+func (s *Server) deploymentRoutes() {
+	s.handleWorkspace("POST /flows/{$}", s.createFlow)
+	s.handleWorkspace("POST /flows/filter", s.listFlows)
+	s.handleWorkspace("GET /flows/{id}", s.getFlow)
+	s.handleWorkspace("PATCH /flows/{id}", s.updateFlow)
+	s.handleWorkspace("DELETE /flows/{id}", s.deleteFlow)
+
+	s.handleWorkspace("POST /deployments/{$}", s.createDeployment)
+	s.handleWorkspace("POST /deployments/filter", s.listDeployments)
+	s.handleWorkspace("GET /deployments/{id}", s.getDeployment)
+	s.handleWorkspace("GET /deployments/name/{flow_name}/{deployment_name}", s.getDeploymentByName)
+	s.handleWorkspace("PATCH /deployments/{id}", s.updateDeployment)
+	s.handleWorkspace("DELETE /deployments/{id}", s.deleteDeployment)
+
+	s.handleWorkspace("GET /deployments/{id}/access", s.getDeploymentAccess)
+	s.handleWorkspace("PUT /deployments/{id}/access", s.setDeploymentAccess)
+
+	s.handleWorkspace("POST /deployments/{id}/schedules", s.createDeploymentSchedules)
+	s.handleWorkspace("GET /deployments/{id}/schedules", s.listDeploymentSchedules)
+	s.handleWorkspace("PATCH /deployments/{id}/schedules/{schedule_id}", s.updateDeploymentSchedule)
+	s.handleWorkspace("DELETE /deployments/{id}/schedules/{schedule_id}", s.deleteDeploymentSchedule)
+}
+
+func (s *Server) createFlow(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	var payload api.FlowCreate
+	if !decodeBody(w, r, &payload) {
+		return
+	}
+
+	if !requireField(w, payload.Name, "name") {
+		return
+	}
+
+	// Creating a flow is an upsert keyed on the flow name.
+	for _, existing := range ws.flows {
+		if existing.Name == payload.Name {
+			writeJSON(w, http.StatusOK, existing)
+
+			return
+		}
+	}
+
+	flow := &api.Flow{
+		BaseModel:   newBaseModel(),
+		AccountID:   ws.accountID(),
+		WorkspaceID: ws.workspaceID(),
+		Name:        payload.Name,
+		Tags:        nonNilStrings(payload.Tags),
+	}
+	ws.flows[flow.ID] = flow
+
+	writeJSON(w, http.StatusCreated, flow)
+}
+
+func (s *Server) listFlows(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	var filter api.FlowFilter
+	page, ok := decodeFilter(w, r, &filter)
+	if !ok {
+		return
+	}
+
+	names := filter.Flows.Handle.Any
+
+	flows := []*api.Flow{}
+	for _, flow := range ws.flows {
+		if len(names) == 0 || slices.Contains(names, flow.Name) {
+			flows = append(flows, flow)
+		}
+	}
+
+	sort.Slice(flows, func(i, j int) bool { return flows[i].Name < flows[j].Name })
+
+	writeJSON(w, http.StatusOK, paginate(flows, page))
+}
+
+func findFlow(w http.ResponseWriter, r *http.Request, ws *workspaceState) (*api.Flow, bool) {
+	id, ok := pathUUID(w, r, "id")
+	if !ok {
+		return nil, false
+	}
+
+	flow, ok := ws.flows[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Flow not found")
+
+		return nil, false
+	}
+
+	return flow, true
+}
+
+func (s *Server) getFlow(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	flow, ok := findFlow(w, r, ws)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, flow)
+}
+
+func (s *Server) updateFlow(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	flow, ok := findFlow(w, r, ws)
+	if !ok {
+		return
+	}
+
+	var payload api.FlowUpdate
+	if !decodeBody(w, r, &payload) {
+		return
+	}
+
+	flow.Tags = nonNilStrings(payload.Tags)
+	touch(&flow.BaseModel)
+
+	writeNoContent(w)
+}
+
+func (s *Server) deleteFlow(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	flow, ok := findFlow(w, r, ws)
+	if !ok {
+		return
+	}
+
+	// Deleting a flow deletes its deployments.
+	for id, existing := range ws.deployments {
+		if existing.FlowID == flow.ID {
+			delete(ws.deployments, id)
+		}
+	}
+
+	delete(ws.flows, flow.ID)
+
+	writeNoContent(w)
+}
+
+func (s *Server) createDeployment(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	var payload api.DeploymentCreate
+	if !decodeBody(w, r, &payload) {
+		return
+	}
+
+	if !requireField(w, payload.Name, "name") {
+		return
+	}
+
+	if _, ok := ws.flows[payload.FlowID]; !ok {
+		writeError(w, http.StatusNotFound, "Flow not found")
+
+		return
+	}
+
+	if payload.WorkPoolName != "" {
+		if _, ok := ws.workPools[payload.WorkPoolName]; !ok {
+			writeError(w, http.StatusNotFound, "Work pool "+payload.WorkPoolName+" not found")
+
+			return
+		}
+	}
+
+	// Creating a deployment is an upsert keyed on the flow and deployment name.
+	var existing *deployment
+	for _, candidate := range ws.deployments {
+		if candidate.FlowID == payload.FlowID && candidate.Name == payload.Name {
+			existing = candidate
+		}
+	}
+
+	if existing == nil {
+		existing = &deployment{
+			Deployment: &api.Deployment{
+				BaseModel:   newBaseModel(),
+				AccountID:   ws.accountID(),
+				WorkspaceID: ws.workspaceID(),
+				FlowID:      payload.FlowID,
+				Name:        payload.Name,
+			},
+			access: api.DeploymentAccessControl{
+				ManageActors: []api.ObjectActorAccess{},
+				RunActors:    []api.ObjectActorAccess{},
+				ViewActors:   []api.ObjectActorAccess{},
+			},
+			schedules: map[uuid.UUID]*api.DeploymentSchedule{},
+		}
+		ws.deployments[existing.ID] = existing
+	}
+
+	applyDeploymentCreate(existing.Deployment, payload)
+	touch(&existing.BaseModel)
+
+	writeJSON(w, http.StatusCreated, existing.response())
+}
+
+func applyDeploymentCreate(d *api.Deployment, payload api.DeploymentCreate) {
+	d.ConcurrencyLimit = payload.ConcurrencyLimit
+	d.ConcurrencyOptions = payload.ConcurrencyOptions
+	d.Description = payload.Description
+	d.EnforceParameterSchema = payload.EnforceParameterSchema
+	d.Entrypoint = payload.Entrypoint
+	d.JobVariables = nonNilMap(payload.JobVariables)
+	d.ManifestPath = payload.ManifestPath
+	d.ParameterOpenAPISchema = payload.ParameterOpenAPISchema
+	d.Parameters = nonNilMap(payload.Parameters)
+	d.Path = payload.Path
+	d.Paused = payload.Paused
+	d.PullSteps = payload.PullSteps
+	d.Tags = nonNilStrings(payload.Tags)
+	d.Version = payload.Version
+	d.WorkPoolName = payload.WorkPoolName
+	d.WorkQueueName = payload.WorkQueueName
+	d.StorageDocumentID = uuid.Nil
+
+	if payload.StorageDocumentID != nil {
+		d.StorageDocumentID = *payload.StorageDocumentID
+	}
+
+	if d.WorkPoolName != "" && d.WorkQueueName == "" {
+		d.WorkQueueName = "default"
+	}
+
+	d.GlobalConcurrencyLimit = nil
+	if d.ConcurrencyLimit != nil {
+		d.GlobalConcurrencyLimit = &api.CurrentGlobalConcurrencyLimit{Limit: *d.ConcurrencyLimit}
+	}
+}
+
+func findDeployment(w http.ResponseWriter, r *http.Request, ws *workspaceState) (*deployment, bool) {
+	id, ok := pathUUID(w, r, "id")
+	if !ok {
+		return nil, false
+	}
+
+	existing, ok := ws.deployments[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Deployment not found")
+
+		return nil, false
+	}
+
+	return existing, true
+}
+
+func (s *Server) getDeployment(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	existing, ok := findDeployment(w, r, ws)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, existing.response())
+}
+
+func (s *Server) listDeployments(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	var filter api.DeploymentFilter
+	page, ok := decodeFilter(w, r, &filter)
+	if !ok {
+		return
+	}
+
+	flowIDs := filter.Flows.ID.Any
+	deploymentIDs := filter.Deployments.ID.Any
+
+	deployments := []*api.Deployment{}
+	for _, existing := range ws.deployments {
+		if (len(flowIDs) == 0 || slices.Contains(flowIDs, existing.FlowID)) &&
+			(len(deploymentIDs) == 0 || slices.Contains(deploymentIDs, existing.ID)) {
+			deployments = append(deployments, existing.response())
+		}
+	}
+
+	sort.Slice(deployments, func(i, j int) bool { return deployments[i].Name < deployments[j].Name })
+
+	writeJSON(w, http.StatusOK, paginate(deployments, page))
+}
+
+func (s *Server) getDeploymentByName(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	flowName := r.PathValue("flow_name")
+	deploymentName := r.PathValue("deployment_name")
+
+	for _, existing := range ws.deployments {
+		flow, ok := ws.flows[existing.FlowID]
+		if ok && flow.Name == flowName && existing.Name == deploymentName {
+			writeJSON(w, http.StatusOK, existing.response())
+
+			return
+		}
+	}
+
+	writeError(w, http.StatusNotFound, "Deployment not found")
+}
+
+func (s *Server) updateDeployment(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	existing, ok := findDeployment(w, r, ws)
+	if !ok {
+		return
+	}
+
+	var payload api.DeploymentUpdate
+	if !decodeBody(w, r, &payload) {
+		return
+	}
+
+	if payload.WorkPoolName != "" {
+		if _, ok := ws.workPools[payload.WorkPoolName]; !ok {
+			writeError(w, http.StatusNotFound, "Work pool "+payload.WorkPoolName+" not found")
+
+			return
+		}
+	}
+
+	applyDeploymentUpdate(existing.Deployment, payload)
+	touch(&existing.BaseModel)
+
+	writeNoContent(w)
+}
+
+// applyDeploymentUpdate applies the fields that were set in an update payload.
+// The client omits zero values, so they are treated as "unchanged".
+func applyDeploymentUpdate(d *api.Deployment, payload api.DeploymentUpdate) {
+	d.ConcurrencyOptions = payload.ConcurrencyOptions
+
+	if payload.ConcurrencyLimit != nil {
+		d.ConcurrencyLimit = payload.ConcurrencyLimit
+		d.GlobalConcurrencyLimit = &api.CurrentGlobalConcurrencyLimit{Limit: *payload.ConcurrencyLimit}
+	}
+
+	setIfNotZero(&d.Description, payload.Description)
+	setIfNotZero(&d.Entrypoint, payload.Entrypoint)
+	setIfNotZero(&d.ManifestPath, payload.ManifestPath)
+	setIfNotZero(&d.Path, payload.Path)
+	setIfNotZero(&d.Version, payload.Version)
+	setIfNotZero(&d.WorkPoolName, payload.WorkPoolName)
+	setIfNotZero(&d.WorkQueueName, payload.WorkQueueName)
+
+	d.EnforceParameterSchema = payload.EnforceParameterSchema
+	d.Paused = payload.Paused
+
+	if payload.JobVariables != nil {
+		d.JobVariables = payload.JobVariables
+	}
+
+	if payload.ParameterOpenAPISchema != nil {
+		d.ParameterOpenAPISchema = payload.ParameterOpenAPISchema
+	}
+
+	if payload.Parameters != nil {
+		d.Parameters = payload.Parameters
+	}
+
+	if payload.PullSteps != nil {
+		d.PullSteps = payload.PullSteps
+	}
+
+	if payload.StorageDocumentID != nil {
+		d.StorageDocumentID = *payload.StorageDocumentID
+	}
+
+	if payload.Tags != nil {
+		d.Tags = payload.Tags
+	}
+}
+
+func (s *Server) deleteDeployment(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	existing, ok := findDeployment(w, r, ws)
+	if !ok {
+		return
+	}
+
+	delete(ws.deployments, existing.ID)
+	delete(ws.slas, existing.ID.String())
+
+	writeNoContent(w)
+}
+
+func (s *Server) getDeploymentAccess(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	existing, ok := findDeployment(w, r, ws)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, existing.access)
+}
+
+func (s *Server) setDeploymentAccess(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	existing, ok := findDeployment(w, r, ws)
+	if !ok {
+		return
+	}
+
+	var payload api.DeploymentAccessSet
+	if !decodeBody(w, r, &payload) {
+		return
+	}
+
+	control := payload.AccessControl
+
+	manageActors, ok := s.resolveActorAccess(w, append(slices.Clone(control.ManageActorIDs), control.ManageTeamIDs...))
+	if !ok {
+		return
+	}
+
+	runActors, ok := s.resolveActorAccess(w, append(slices.Clone(control.RunActorIDs), control.RunTeamIDs...))
+	if !ok {
+		return
+	}
+
+	viewActors, ok := s.resolveActorAccess(w, append(slices.Clone(control.ViewActorIDs), control.ViewTeamIDs...))
+	if !ok {
+		return
+	}
+
+	existing.access = api.DeploymentAccessControl{
+		ManageActors: manageActors,
+		RunActors:    runActors,
+		ViewActors:   viewActors,
+	}
+
+	writeNoContent(w)
+}
+
+func (s *Server) createDeploymentSchedules(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	existing, ok := findDeployment(w, r, ws)
+	if !ok {
+		return
+	}
+
+	var payloads []api.DeploymentSchedulePayload
+	if !decodeBody(w, r, &payloads) {
+		return
+	}
+
+	schedules := []*api.DeploymentSchedule{}
+	for _, payload := range payloads {
+		if payload.Active == nil {
+			active := true
+			payload.Active = &active
+		}
+
+		schedule := &api.DeploymentSchedule{
+			BaseModel:                 newBaseModel(),
+			AccountID:                 ws.accountID(),
+			WorkspaceID:               ws.workspaceID(),
+			DeploymentID:              existing.ID,
+			DeploymentSchedulePayload: payload,
+		}
+		existing.schedules[schedule.ID] = schedule
+		schedules = append(schedules, schedule)
+	}
+
+	writeJSON(w, http.StatusCreated, schedules)
+}
+
+func (s *Server) listDeploymentSchedules(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	existing, ok := findDeployment(w, r, ws)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, existing.sortedSchedules())
+}
+
+func findDeploymentSchedule(w http.ResponseWriter, r *http.Request, ws *workspaceState) (*deployment, *api.DeploymentSchedule, bool) {
+	existing, ok := findDeployment(w, r, ws)
+	if !ok {
+		return nil, nil, false
+	}
+
+	id, ok := pathUUID(w, r, "schedule_id")
+	if !ok {
+		return nil, nil, false
+	}
+
+	schedule, ok := existing.schedules[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Schedule not found")
+
+		return nil, nil, false
+	}
+
+	return existing, schedule, true
+}
+
+func (s *Server) updateDeploymentSchedule(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	_, schedule, ok := findDeploymentSchedule(w, r, ws)
+	if !ok {
+		return
+	}
+
+	var payload api.DeploymentSchedulePayload
+	if !decodeBody(w, r, &payload) {
+		return
+	}
+
+	if payload.Active == nil {
+		payload.Active = schedule.Active
+	}
+
+	schedule.DeploymentSchedulePayload = payload
+	touch(&schedule.BaseModel)
+
+	writeNoContent(w)
+}
+
+func (s *Server) deleteDeploymentSchedule(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	existing, schedule, ok := findDeploymentSchedule(w, r, ws)
+	if !ok {
+		return
+	}
+
+	delete(existing.schedules, schedule.ID)
+
+	writeNoContent(w)
+}
+
+func setIfNotZero[T comparable](target *T, value T) {
+	var zero T
+	if value != zero {
+		*target = value
+	}
+}
+
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+
+	return values
+}
+
+func nonNilMap(values map[string]interface{}) map[string]interface{} {
+	if values == nil {
+		return map[string]interface{}{}
+	}
+
+	return values
+}
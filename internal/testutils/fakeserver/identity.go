@@ -0,0 +1,523 @@
+package fakeserver
+
+import (
+	"net/http"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+)
+
+// userAPIKey is a user API key, along with the user it belongs to.
+type userAPIKey struct {
+	api.UserAPIKey
+	UserID uuid.UUID
+}
+
+// teamWithMemberships is the response for a team, which includes
+// the team's memberships when read by ID.
+type teamWithMemberships struct {
+	*api.Team
+	Memberships []api.Membership `json:"memberships"`
+}
+
+func (s *Server) identityRoutes() {
+	s.mux.HandleFunc("GET /api/users/{id}", s.getUser)
+	s.mux.HandleFunc("PATCH /api/users/{id}", s.updateUser)
+	s.mux.HandleFunc("POST /api/users/{id}/api_keys", s.createUserAPIKey)
+	s.mux.HandleFunc("GET /api/users/{id}/api_keys/{key_id}", s.getUserAPIKey)
+	s.mux.HandleFunc("DELETE /api/users/{id}/api_keys/{key_id}", s.deleteUserAPIKey)
+
+	s.handleAccount("POST /bots/{$}", s.createServiceAccount)
+	s.handleAccount("POST /bots/filter", s.listServiceAccounts)
+	s.handleAccount("GET /bots/{id}", s.getServiceAccount)
+	s.handleAccount("PATCH /bots/{id}", s.updateServiceAccount)
+	s.handleAccount("DELETE /bots/{id}", s.deleteServiceAccount)
+	s.handleAccount("POST /bots/{id}/rotate_api_key", s.rotateServiceAccountKey)
+
+	s.handleAccount("POST /teams", s.createTeam)
+	s.handleAccount("POST /teams/filter", s.listTeams)
+	s.handleAccount("GET /teams/{id}", s.getTeam)
+	s.handleAccount("PUT /teams/{id}", s.updateTeam)
+	s.handleAccount("DELETE /teams/{id}", s.deleteTeam)
+	s.handleAccount("PUT /teams/{id}/members", s.upsertTeamMembers)
+	s.handleAccount("DELETE /teams/{id}/members/{actor_id}", s.deleteTeamMember)
+}
+
+func (s *Server) findUser(w http.ResponseWriter, r *http.Request) (*api.User, bool) {
+	id, ok := pathUUID(w, r, "id")
+	if !ok {
+		return nil, false
+	}
+
+	user, ok := s.users[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "User not found.")
+
+		return nil, false
+	}
+
+	return user, true
+}
+
+func (s *Server) getUser(w http.ResponseWriter, r *http.Request) {
+	user, ok := s.findUser(w, r)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, user)
+}
+
+func (s *Server) updateUser(w http.ResponseWriter, r *http.Request) {
+	user, ok := s.findUser(w, r)
+	if !ok {
+		return
+	}
+
+	var payload api.UserUpdate
+	if !decodeBody(w, r, &payload) {
+		return
+	}
+
+	user.Handle = payload.Handle
+	user.FirstName = payload.FirstName
+	user.LastName = payload.LastName
+	user.Email = payload.Email
+	touch(&user.BaseModel)
+
+	writeNoContent(w)
+}
+
+func (s *Server) createUserAPIKey(w http.ResponseWriter, r *http.Request) {
+	user, ok := s.findUser(w, r)
+	if !ok {
+		return
+	}
+
+	var payload api.UserAPIKeyCreate
+	if !decodeBody(w, r, &payload) {
+		return
+	}
+
+	if !requireField(w, payload.Name, "name") {
+		return
+	}
+
+	key := &userAPIKey{
+		UserAPIKey: api.UserAPIKey{
+			ID:         uuid.New(),
+			Created:    *now(),
+			Name:       payload.Name,
+			Expiration: payload.Expiration,
+			Key:        "pnu_" + uuid.NewString(),
+		},
+		UserID: user.ID,
+	}
+	s.userAPIKeys[key.ID] = key
+
+	writeJSON(w, http.StatusCreated, key.UserAPIKey)
+}
+
+func (s *Server) findUserAPIKey(w http.ResponseWriter, r *http.Request) (*userAPIKey, bool) {
+	user, ok := s.findUser(w, r)
+	if !ok {
+		return nil, false
+	}
+
+	keyID, ok := pathUUID(w, r, "key_id")
+	if !ok {
+		return nil, false
+	}
+
+	key, ok := s.userAPIKeys[keyID]
+	if !ok || key.UserID != user.ID {
+		writeError(w, http.StatusNotFound, "API key not found.")
+
+		return nil, false
+	}
+
+	return key, true
+}
+
+func (s *Server) getUserAPIKey(w http.ResponseWriter, r *http.Request) {
+	key, ok := s.findUserAPIKey(w, r)
+	if !ok {
+		return
+	}
+
+	// The key value is only returned on creation.
+	response := key.UserAPIKey
+	response.Key = ""
+
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (s *Server) deleteUserAPIKey(w http.ResponseWriter, r *http.Request) {
+	key, ok := s.findUserAPIKey(w, r)
+	if !ok {
+		return
+	}
+
+	delete(s.userAPIKeys, key.ID)
+
+	writeNoContent(w)
+}
+
+func newServiceAccountAPIKey(name string, expiration *time.Time) api.ServiceAccountAPIKey {
+	return api.ServiceAccountAPIKey{
+		ID:         uuid.NewString(),
+		Created:    now(),
+		Expiration: expiration,
+		Name:       name,
+		Key:        "pnb_" + uuid.NewString(),
+	}
+}
+
+func (s *Server) createServiceAccount(w http.ResponseWriter, r *http.Request) {
+	var payload api.ServiceAccountCreateRequest
+	if !decodeBody(w, r, &payload) {
+		return
+	}
+
+	if !requireField(w, payload.Name, "name") {
+		return
+	}
+
+	for _, existing := range s.serviceAccounts {
+		if existing.Name == payload.Name {
+			writeError(w, http.StatusConflict, "A service account with this name already exists.")
+
+			return
+		}
+	}
+
+	var expiration *time.Time
+	if payload.APIKeyExpiration != "" {
+		parsed, err := time.Parse(time.RFC3339, payload.APIKeyExpiration)
+		if err != nil {
+			writeValidationError(w, []string{"body", "api_key_expiration"}, "Input should be a valid datetime", "datetime_parsing")
+
+			return
+		}
+
+		expiration = &parsed
+	}
+
+	role := s.accountRoleByName("Member")
+	if payload.AccountRoleID != nil {
+		var ok bool
+		if role, ok = s.accountRoles[*payload.AccountRoleID]; !ok {
+			writeError(w, http.StatusNotFound, "Account role not found.")
+
+			return
+		}
+	}
+
+	serviceAccount := &api.ServiceAccount{
+		BaseModel:       newBaseModel(),
+		ActorID:         uuid.New(),
+		AccountID:       s.account.ID,
+		Name:            payload.Name,
+		AccountRoleName: role.Name,
+		APIKey:          newServiceAccountAPIKey(payload.Name, expiration),
+	}
+	s.serviceAccounts[serviceAccount.ID] = serviceAccount
+
+	writeJSON(w, http.StatusCreated, serviceAccount)
+}
+
+// serviceAccountWithoutKey returns a copy of the service account
+// with the key value removed, as it is only returned on creation and rotation.
+func serviceAccountWithoutKey(serviceAccount *api.ServiceAccount) api.ServiceAccount {
+	response := *serviceAccount
+	response.APIKey.Key = ""
+
+	return response
+}
+
+func (s *Server) listServiceAccounts(w http.ResponseWriter, r *http.Request) {
+	var filter api.ServiceAccountFilter
+	page, ok := decodeFilter(w, r, &filter)
+	if !ok {
+		return
+	}
+
+	names := filter.ServiceAccounts.Name.Any
+
+	serviceAccounts := []api.ServiceAccount{}
+	for _, serviceAccount := range s.serviceAccounts {
+		if len(names) == 0 || slices.Contains(names, serviceAccount.Name) {
+			serviceAccounts = append(serviceAccounts, serviceAccountWithoutKey(serviceAccount))
+		}
+	}
+
+	sort.Slice(serviceAccounts, func(i, j int) bool { return serviceAccounts[i].Name < serviceAccounts[j].Name })
+
+	writeJSON(w, http.StatusOK, paginate(serviceAccounts, page))
+}
+
+func (s *Server) findServiceAccount(w http.ResponseWriter, r *http.Request) (*api.ServiceAccount, bool) {
+	id, ok := pathUUID(w, r, "id")
+	if !ok {
+		return nil, false
+	}
+
+	serviceAccount, ok := s.serviceAccounts[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Service account not found.")
+
+		return nil, false
+	}
+
+	return serviceAccount, true
+}
+
+func (s *Server) getServiceAccount(w http.ResponseWriter, r *http.Request) {
+	serviceAccount, ok := s.findServiceAccount(w, r)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, serviceAccountWithoutKey(serviceAccount))
+}
+
+func (s *Server) updateServiceAccount(w http.ResponseWriter, r *http.Request) {
+	serviceAccount, ok := s.findServiceAccount(w, r)
+	if !ok {
+		return
+	}
+
+	var payload api.ServiceAccountUpdateRequest
+	if !decodeBody(w, r, &payload) {
+		return
+	}
+
+	if !requireField(w, payload.Name, "name") {
+		return
+	}
+
+	if payload.AccountRoleID != nil {
+		role, ok := s.accountRoles[*payload.AccountRoleID]
+		if !ok {
+			writeError(w, http.StatusNotFound, "Account role not found.")
+
+			return
+		}
+
+		serviceAccount.AccountRoleName = role.Name
+	}
+
+	serviceAccount.Name = payload.Name
+	touch(&serviceAccount.BaseModel)
+
+	writeNoContent(w)
+}
+
+func (s *Server) deleteServiceAccount(w http.ResponseWriter, r *http.Request) {
+	serviceAccount, ok := s.findServiceAccount(w, r)
+	if !ok {
+		return
+	}
+
+	delete(s.serviceAccounts, serviceAccount.ID)
+
+	writeNoContent(w)
+}
+
+func (s *Server) rotateServiceAccountKey(w http.ResponseWriter, r *http.Request) {
+	serviceAccount, ok := s.findServiceAccount(w, r)
+	if !ok {
+		return
+	}
+
+	var payload api.ServiceAccountRotateKeyRequest
+	if !decodeBody(w, r, &payload) {
+		return
+	}
+
+	serviceAccount.APIKey = newServiceAccountAPIKey(serviceAccount.Name, payload.APIKeyExpiration)
+	touch(&serviceAccount.BaseModel)
+
+	writeJSON(w, http.StatusCreated, serviceAccount)
+}
+
+func (s *Server) createTeam(w http.ResponseWriter, r *http.Request) {
+	var payload api.TeamCreate
+	if !decodeBody(w, r, &payload) {
+		return
+	}
+
+	if !requireField(w, payload.Name, "name") {
+		return
+	}
+
+	for _, existing := range s.teams {
+		if existing.Name == payload.Name {
+			writeError(w, http.StatusConflict, "A team with this name already exists.")
+
+			return
+		}
+	}
+
+	team := &api.Team{BaseModel: newBaseModel(), Name: payload.Name, Description: payload.Description}
+	s.teams[team.ID] = team
+	s.teamMembers[team.ID] = map[uuid.UUID]api.Membership{}
+
+	writeJSON(w, http.StatusCreated, team)
+}
+
+func (s *Server) listTeams(w http.ResponseWriter, r *http.Request) {
+	var filter api.TeamFilter
+	page, ok := decodeFilter(w, r, &filter)
+	if !ok {
+		return
+	}
+
+	names := filter.Teams.Name.Any
+
+	teams := []*api.Team{}
+	for _, team := range s.teams {
+		if len(names) == 0 || slices.Contains(names, team.Name) {
+			teams = append(teams, team)
+		}
+	}
+
+	sort.Slice(teams, func(i, j int) bool { return teams[i].Name < teams[j].Name })
+
+	writeJSON(w, http.StatusOK, paginate(teams, page))
+}
+
+func (s *Server) findTeam(w http.ResponseWriter, r *http.Request) (*api.Team, bool) {
+	id, ok := pathUUID(w, r, "id")
+	if !ok {
+		return nil, false
+	}
+
+	team, ok := s.teams[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Team not found.")
+
+		return nil, false
+	}
+
+	return team, true
+}
+
+func (s *Server) getTeam(w http.ResponseWriter, r *http.Request) {
+	team, ok := s.findTeam(w, r)
+	if !ok {
+		return
+	}
+
+	memberships := []api.Membership{}
+	for _, membership := range s.teamMembers[team.ID] {
+		memberships = append(memberships, membership)
+	}
+
+	sort.Slice(memberships, func(i, j int) bool {
+		return memberships[i].ActorID.String() < memberships[j].ActorID.String()
+	})
+
+	writeJSON(w, http.StatusOK, teamWithMemberships{Team: team, Memberships: memberships})
+}
+
+func (s *Server) updateTeam(w http.ResponseWriter, r *http.Request) {
+	team, ok := s.findTeam(w, r)
+	if !ok {
+		return
+	}
+
+	var payload api.TeamUpdate
+	if !decodeBody(w, r, &payload) {
+		return
+	}
+
+	if !requireField(w, payload.Name, "name") {
+		return
+	}
+
+	team.Name = payload.Name
+	team.Description = payload.Description
+	touch(&team.BaseModel)
+
+	writeJSON(w, http.StatusOK, team)
+}
+
+func (s *Server) deleteTeam(w http.ResponseWriter, r *http.Request) {
+	team, ok := s.findTeam(w, r)
+	if !ok {
+		return
+	}
+
+	delete(s.teams, team.ID)
+	delete(s.teamMembers, team.ID)
+
+	writeNoContent(w)
+}
+
+func (s *Server) upsertTeamMembers(w http.ResponseWriter, r *http.Request) {
+	team, ok := s.findTeam(w, r)
+	if !ok {
+		return
+	}
+
+	var payload api.TeamAccessUpsert
+	if !decodeBody(w, r, &payload) {
+		return
+	}
+
+	for _, member := range payload.Members {
+		actorID, ok := s.memberActorID(member)
+		if !ok {
+			writeError(w, http.StatusNotFound, "Member "+member.MemberID.String()+" not found.")
+
+			return
+		}
+
+		s.teamMembers[team.ID][actorID] = api.Membership{ActorID: actorID, Type: member.MemberType}
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{})
+}
+
+// memberActorID resolves a team member's user or service account ID to its actor ID.
+func (s *Server) memberActorID(member api.TeamAccessMember) (uuid.UUID, bool) {
+	switch member.MemberType {
+	case string(api.UserAccessor):
+		if user, ok := s.users[member.MemberID]; ok {
+			return uuid.MustParse(user.ActorID), true
+		}
+	case string(api.ServiceAccountAccessor):
+		if serviceAccount, ok := s.serviceAccounts[member.MemberID]; ok {
+			return serviceAccount.ActorID, true
+		}
+	}
+
+	return uuid.Nil, false
+}
+
+func (s *Server) deleteTeamMember(w http.ResponseWriter, r *http.Request) {
+	team, ok := s.findTeam(w, r)
+	if !ok {
+		return
+	}
+
+	actorID, ok := pathUUID(w, r, "actor_id")
+	if !ok {
+		return
+	}
+
+	if _, ok := s.teamMembers[team.ID][actorID]; !ok {
+		writeError(w, http.StatusNotFound, "Team member not found.")
+
+		return
+	}
+
+	delete(s.teamMembers[team.ID], actorID)
+
+	writeNoContent(w)
+}
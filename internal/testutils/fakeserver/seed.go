@@ -0,0 +1,149 @@
+package fakeserver
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+)
+
+// seededBlockTypes lists the block types available in every workspace,
+// along with the fields of their schema that are treated as secrets.
+var seededBlockTypes = map[string][]string{
+	"aws-credentials":              {"aws_secret_access_key"},
+	"azure-blob-storage-container": {},
+	"gcp-credentials":              {"service_account_info"},
+	"gcs-bucket":                   {},
+	"github-credentials":           {"token"},
+	"github-repository":            {},
+	"json":                         {},
+	"s3-bucket":                    {},
+	"secret":                       {"value"},
+	"string":                       {},
+}
+
+// seededWorkerTypes lists the worker types returned from the collections views,
+// keyed by the package that provides them.
+var seededWorkerTypes = map[string][]string{
+	"prefect":            {"prefect-agent", "process", "prefect:managed"},
+	"prefect-aws":        {"ecs", "ecs:push"},
+	"prefect-azure":      {"azure-container-instance", "azure-container-instance:push"},
+	"prefect-docker":     {"docker"},
+	"prefect-gcp":        {"cloud-run", "cloud-run-v2", "cloud-run:push", "cloud-run-v2:push", "vertex-ai"},
+	"prefect-kubernetes": {"kubernetes"},
+	"prefect-modal":      {"modal:push"},
+}
+
+// seed populates the objects that exist before any requests are made,
+// such as the account, its owner, the system roles, and block types.
+func (s *Server) seed() {
+	s.account = &api.Account{
+		BaseModel: newBaseModel(),
+		AccountUpdate: api.AccountUpdate{
+			Name:   "Fake Account",
+			Handle: "fake-account",
+		},
+		// The acceptance tests expect these domain names, which were
+		// added manually to the account they run against in Prefect Cloud.
+		Domains:  []string{"example.com", "foobar.com"},
+		PlanType: "ENTERPRISE",
+		Features: []string{},
+	}
+	s.accountID = s.account.ID
+
+	for _, name := range []string{"Owner", "Admin", "Member"} {
+		role := &api.AccountRole{
+			BaseModel:    newBaseModel(),
+			Name:         name,
+			Permissions:  []string{},
+			AccountID:    &s.account.ID,
+			IsSystemRole: true,
+		}
+		s.accountRoles[role.ID] = role
+	}
+
+	for _, name := range []string{"Owner", "Developer", "Runner", "Viewer", "Worker"} {
+		role := &api.WorkspaceRole{
+			BaseModel: newBaseModel(),
+			Name:      name,
+			Scopes:    []string{},
+		}
+		s.workspaceRoles[role.ID] = role
+	}
+
+	owner := &api.User{
+		BaseModel: newBaseModel(),
+		ActorID:   uuid.NewString(),
+		Handle:    "fake-user",
+		FirstName: "Fake",
+		LastName:  "User",
+		Email:     "fake-user@example.com",
+	}
+	s.users[owner.ID] = owner
+
+	ownerRole := s.accountRoleByName("Owner")
+	membership := &api.AccountMembership{
+		ID:              uuid.NewString(),
+		ActorID:         uuid.MustParse(owner.ActorID),
+		UserID:          owner.ID,
+		FirstName:       owner.FirstName,
+		LastName:        owner.LastName,
+		Handle:          owner.Handle,
+		Email:           owner.Email,
+		AccountRoleName: ownerRole.Name,
+		AccountRoleID:   ownerRole.ID,
+	}
+	s.accountMemberships[uuid.MustParse(membership.ID)] = membership
+
+	for slug, secretFields := range seededBlockTypes {
+		blockType := &api.BlockType{BaseModel: newBaseModel(), Slug: slug}
+		s.blockTypes[blockType.ID] = blockType
+
+		schema := &api.BlockSchema{
+			BaseModel:    newBaseModel(),
+			BlockType:    *blockType,
+			BlockTypeID:  blockType.ID,
+			Checksum:     "sha256:" + blockType.ID.String(),
+			Capabilities: []string{},
+			Version:      "3.0.0",
+			Fields: map[string]interface{}{
+				"type":          "object",
+				"properties":    map[string]interface{}{},
+				"secret_fields": secretFields,
+			},
+		}
+		s.blockSchemas[schema.ID] = schema
+	}
+
+	s.workerMetadataViews = api.WorkerTypeByPackage{}
+	for pkg, workerTypes := range seededWorkerTypes {
+		metadata := api.MetadataByWorkerType{}
+		for _, workerType := range workerTypes {
+			metadata[workerType] = api.WorkerMetadata{
+				Type:                        workerType,
+				DisplayName:                 workerType,
+				Description:                 "Execute flow runs on " + workerType + " infrastructure.",
+				InstallCommand:              "pip install " + pkg,
+				DefaultBaseJobConfiguration: defaultBaseJobTemplate(),
+			}
+		}
+		s.workerMetadataViews[pkg] = metadata
+	}
+}
+
+// defaultBaseJobTemplate returns the minimal base job template assigned
+// to work pools that are created without one.
+func defaultBaseJobTemplate() json.RawMessage {
+	return json.RawMessage(`{"job_configuration":{"command":"{{ command }}"},"variables":{"type":"object","properties":{"command":{"type":"string"}}}}`)
+}
+
+func (s *Server) accountRoleByName(name string) *api.AccountRole {
+	for _, role := range s.accountRoles {
+		if role.Name == name {
+			return role
+		}
+	}
+
+	return nil
+}
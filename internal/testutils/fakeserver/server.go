@@ -0,0 +1,372 @@
+// Package fakeserver provides an in-memory fake of the Prefect API.
+//
+// The fake implements the routes called by the `internal/client` package,
+// with enough of the real API's semantics (404 for missing objects, 409 for
+// name conflicts, asynchronously available block documents, etc.) that the
+// provider's CRUD logic can be exercised without a Prefect Cloud account.
+//
+// Routes are served both in their Prefect Cloud form
+// (`/api/accounts/<id>/workspaces/<id>/...`) and their self-hosted form
+// (`/api/...`). Self-hosted routes operate on a single implicit workspace.
+package fakeserver
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+)
+
+// Server is an in-memory fake of the Prefect API.
+type Server struct {
+	mu sync.Mutex
+
+	mux    *http.ServeMux
+	server *httptest.Server
+
+	apiKey       string
+	basicAuthKey string
+
+	// blockDocumentReadDelay is the number of reads of a newly created
+	// block document that will return a 404 before it becomes visible.
+	blockDocumentReadDelay int
+
+	accountID           uuid.UUID
+	account             *api.Account
+	accountMemberships  map[uuid.UUID]*api.AccountMembership
+	accountRoles        map[uuid.UUID]*api.AccountRole
+	workspaceRoles      map[uuid.UUID]*api.WorkspaceRole
+	users               map[uuid.UUID]*api.User
+	userAPIKeys         map[uuid.UUID]*userAPIKey
+	serviceAccounts     map[uuid.UUID]*api.ServiceAccount
+	teams               map[uuid.UUID]*api.Team
+	teamMembers         map[uuid.UUID]map[uuid.UUID]api.Membership
+	blockTypes          map[uuid.UUID]*api.BlockType
+	blockSchemas        map[uuid.UUID]*api.BlockSchema
+	workspaces          map[uuid.UUID]*workspaceState
+	defaultWorkspace    *workspaceState
+	workerMetadataViews api.WorkerTypeByPackage
+}
+
+// Option configures a Server.
+type Option func(s *Server)
+
+// WithAPIKey requires requests to authenticate with the given API key.
+func WithAPIKey(apiKey string) Option {
+	return func(s *Server) {
+		s.apiKey = apiKey
+	}
+}
+
+// WithBasicAuthKey requires requests to authenticate with the given basic auth key,
+// as used by self-hosted Prefect servers.
+func WithBasicAuthKey(basicAuthKey string) Option {
+	return func(s *Server) {
+		s.basicAuthKey = basicAuthKey
+	}
+}
+
+// WithBlockDocumentReadDelay configures how many times a newly created
+// block document will return a 404 before it becomes readable. This mimics
+// the asynchronous creation of block documents in Prefect Cloud.
+func WithBlockDocumentReadDelay(reads int) Option {
+	return func(s *Server) {
+		s.blockDocumentReadDelay = reads
+	}
+}
+
+// New returns a new, unstarted fake Prefect API server.
+func New(opts ...Option) *Server {
+	s := &Server{
+		mux:                http.NewServeMux(),
+		accountMemberships: map[uuid.UUID]*api.AccountMembership{},
+		accountRoles:       map[uuid.UUID]*api.AccountRole{},
+		workspaceRoles:     map[uuid.UUID]*api.WorkspaceRole{},
+		users:              map[uuid.UUID]*api.User{},
+		userAPIKeys:        map[uuid.UUID]*userAPIKey{},
+		serviceAccounts:    map[uuid.UUID]*api.ServiceAccount{},
+		teams:              map[uuid.UUID]*api.Team{},
+		teamMembers:        map[uuid.UUID]map[uuid.UUID]api.Membership{},
+		blockTypes:         map[uuid.UUID]*api.BlockType{},
+		blockSchemas:       map[uuid.UUID]*api.BlockSchema{},
+		workspaces:         map[uuid.UUID]*workspaceState{},
+		defaultWorkspace:   newWorkspaceState(),
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	s.seed()
+	s.routes()
+
+	return s
+}
+
+// Start starts the server on a random local port.
+func (s *Server) Start() {
+	s.server = httptest.NewServer(s)
+}
+
+// Close shuts down the server.
+func (s *Server) Close() {
+	if s.server != nil {
+		s.server.Close()
+	}
+}
+
+// URL returns the base URL of the running server, without the `/api` suffix.
+func (s *Server) URL() string {
+	return s.server.URL
+}
+
+// AccountID returns the ID of the account the server was seeded with.
+func (s *Server) AccountID() uuid.UUID {
+	return s.accountID
+}
+
+// ServeHTTP authenticates the request and dispatches it to the matching route.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !s.authorized(r) {
+		writeError(w, http.StatusUnauthorized, "Unauthorized")
+
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.mux.ServeHTTP(w, r)
+}
+
+// authorized checks the Authorization header against the configured credentials.
+func (s *Server) authorized(r *http.Request) bool {
+	header := r.Header.Get("Authorization")
+
+	if s.apiKey != "" && header == "Bearer "+s.apiKey {
+		return true
+	}
+
+	if s.basicAuthKey != "" && header == "Basic "+base64.StdEncoding.EncodeToString([]byte(s.basicAuthKey)) {
+		return true
+	}
+
+	return s.apiKey == "" && s.basicAuthKey == ""
+}
+
+// workspaceHandler is a handler for a workspace-scoped route.
+type workspaceHandler func(w http.ResponseWriter, r *http.Request, ws *workspaceState)
+
+// handleAccount registers an account-scoped route.
+func (s *Server) handleAccount(pattern string, handler http.HandlerFunc) {
+	method, route, _ := strings.Cut(pattern, " ")
+
+	s.mux.HandleFunc(method+" /api/accounts/{account_id}"+route, func(w http.ResponseWriter, r *http.Request) {
+		if !s.accountExists(r.PathValue("account_id")) {
+			writeError(w, http.StatusNotFound, "Account not found.")
+
+			return
+		}
+
+		handler(w, r)
+	})
+}
+
+// handleWorkspace registers a workspace-scoped route under both the
+// Prefect Cloud and self-hosted URL layouts.
+func (s *Server) handleWorkspace(pattern string, handler workspaceHandler) {
+	method, route, _ := strings.Cut(pattern, " ")
+
+	s.mux.HandleFunc(method+" /api/accounts/{account_id}/workspaces/{workspace_id}"+route, func(w http.ResponseWriter, r *http.Request) {
+		if !s.accountExists(r.PathValue("account_id")) {
+			writeError(w, http.StatusNotFound, "Account not found.")
+
+			return
+		}
+
+		ws, ok := s.lookupWorkspace(r.PathValue("workspace_id"))
+		if !ok {
+			writeError(w, http.StatusNotFound, "Workspace not found.")
+
+			return
+		}
+
+		handler(w, r, ws)
+	})
+
+	s.mux.HandleFunc(method+" /api"+route, func(w http.ResponseWriter, r *http.Request) {
+		handler(w, r, s.defaultWorkspace)
+	})
+}
+
+func (s *Server) accountExists(accountID string) bool {
+	return s.account != nil && s.account.ID.String() == accountID
+}
+
+func (s *Server) lookupWorkspace(workspaceID string) (*workspaceState, bool) {
+	id, err := uuid.Parse(workspaceID)
+	if err != nil {
+		return nil, false
+	}
+
+	ws, ok := s.workspaces[id]
+
+	return ws, ok
+}
+
+// routes registers every route implemented by the fake.
+func (s *Server) routes() {
+	s.adminRoutes()
+	s.accountRoutes()
+	s.identityRoutes()
+	s.workspaceRoutes()
+	s.blockRoutes()
+	s.deploymentRoutes()
+	s.workPoolRoutes()
+	s.variableRoutes()
+	s.automationRoutes()
+	s.concurrencyLimitRoutes()
+	s.webhookRoutes()
+	s.collectionRoutes()
+}
+
+// now returns the current time, truncated to microseconds like the API.
+func now() *time.Time {
+	t := time.Now().UTC().Truncate(time.Microsecond)
+
+	return &t
+}
+
+func newBaseModel() api.BaseModel {
+	timestamp := now()
+
+	return api.BaseModel{
+		ID:      uuid.New(),
+		Created: timestamp,
+		Updated: timestamp,
+	}
+}
+
+func touch(model *api.BaseModel) {
+	model.Updated = now()
+}
+
+// pathUUID parses a UUID path parameter, writing a 404 on failure.
+// The real API responds to malformed IDs with a 404 on most routes.
+func pathUUID(w http.ResponseWriter, r *http.Request, name string) (uuid.UUID, bool) {
+	id, err := uuid.Parse(r.PathValue(name))
+	if err != nil {
+		writeError(w, http.StatusNotFound, "Not found.")
+
+		return uuid.Nil, false
+	}
+
+	return id, true
+}
+
+// decodeBody decodes the request body into target, writing a 422 on failure.
+func decodeBody(w http.ResponseWriter, r *http.Request, target any) bool {
+	if err := json.NewDecoder(r.Body).Decode(target); err != nil {
+		writeValidationError(w, []string{"body"}, "Invalid JSON: "+err.Error(), "json_invalid")
+
+		return false
+	}
+
+	return true
+}
+
+// maxPageSize is the largest limit accepted by the real API's filter endpoints.
+const maxPageSize = 200
+
+// listPage holds the pagination fields of a filter request body.
+type listPage struct {
+	Limit  *int `json:"limit"`
+	Offset int  `json:"offset"`
+}
+
+// decodeFilter decodes a filter request body into target, and returns its
+// pagination fields, writing a 422 on failure.
+func decodeFilter(w http.ResponseWriter, r *http.Request, target any) (listPage, bool) {
+	var page listPage
+
+	body, err := io.ReadAll(r.Body)
+	if err == nil {
+		err = json.Unmarshal(body, target)
+	}
+
+	if err == nil {
+		err = json.Unmarshal(body, &page)
+	}
+
+	if err != nil {
+		writeValidationError(w, []string{"body"}, "Invalid JSON: "+err.Error(), "json_invalid")
+
+		return page, false
+	}
+
+	if page.Limit != nil && *page.Limit > maxPageSize {
+		writeValidationError(w, []string{"body", "limit"}, "Input should be less than or equal to 200", "less_than_equal")
+
+		return page, false
+	}
+
+	return page, true
+}
+
+// paginate returns the items selected by the page's limit and offset.
+func paginate[T any](items []T, page listPage) []T {
+	start := min(max(page.Offset, 0), len(items))
+	end := len(items)
+
+	if page.Limit != nil {
+		end = min(start+max(*page.Limit, 0), len(items))
+	}
+
+	return items[start:end]
+}
+
+// writeJSON writes a JSON response with the given status code.
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// writeNoContent writes an empty 204 response.
+func writeNoContent(w http.ResponseWriter) {
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// writeError writes an error response in the FastAPI format used by
+// Prefect for non-validation errors, eg. {"detail": "Not found."}.
+func writeError(w http.ResponseWriter, status int, detail string) {
+	writeJSON(w, status, map[string]string{"detail": detail})
+}
+
+// writeValidationError writes a 422 response in the FastAPI request
+// validation format, eg. {"detail": [{"loc": [...], "msg": "...", "type": "..."}]}.
+func writeValidationError(w http.ResponseWriter, loc []string, msg, errType string) {
+	writeJSON(w, http.StatusUnprocessableEntity, api.ErrorResponse{
+		Detail: []api.ErrorDetail{{Loc: loc, Msg: msg, Type: errType}},
+	})
+}
+
+// requireField writes a 422 for a missing required body field.
+func requireField(w http.ResponseWriter, value string, field ...string) bool {
+	if value != "" {
+		return true
+	}
+
+	writeValidationError(w, append([]string{"body"}, field...), "Field required", "missing")
+
+	return false
+}
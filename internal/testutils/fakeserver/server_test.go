@@ -0,0 +1,314 @@
+package fakeserver_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/client"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils/fakeserver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testAPIKey = "pnu_fake"
+
+func newServer(t *testing.T, opts ...fakeserver.Option) *fakeserver.Server {
+	t.Helper()
+
+	server := fakeserver.New(append([]fakeserver.Option{fakeserver.WithAPIKey(testAPIKey)}, opts...)...)
+	server.Start()
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func newClient(t *testing.T, server *fakeserver.Server, accountID uuid.UUID) *client.Client {
+	t.Helper()
+
+	prefectClient, err := client.New(
+		client.WithEndpoint(server.URL()+"/api", server.URL()),
+		client.WithAPIKey(testAPIKey),
+		client.WithDefaults(accountID, uuid.Nil),
+	)
+	require.NoError(t, err)
+
+	return prefectClient
+}
+
+func newWorkspace(t *testing.T, prefectClient *client.Client) *api.Workspace {
+	t.Helper()
+
+	workspaces, err := prefectClient.Workspaces(uuid.Nil)
+	require.NoError(t, err)
+
+	workspace, err := workspaces.Create(context.Background(), api.WorkspaceCreate{Name: "test", Handle: "test"})
+	require.NoError(t, err)
+
+	return workspace
+}
+
+func TestServerRequiresAuthentication(t *testing.T) {
+	t.Parallel()
+
+	server := newServer(t)
+
+	tests := []struct {
+		name   string
+		header string
+		want   int
+	}{
+		{
+			name:   "missing key",
+			header: "",
+			want:   http.StatusUnauthorized,
+		},
+		{
+			name:   "wrong key",
+			header: "Bearer pnu_wrong",
+			want:   http.StatusUnauthorized,
+		},
+		{
+			name:   "correct key",
+			header: "Bearer " + testAPIKey,
+			want:   http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL()+"/api/accounts/"+server.AccountID().String()+"/", http.NoBody)
+			require.NoError(t, err)
+
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.want, resp.StatusCode)
+		})
+	}
+}
+
+func TestServerWorkPoolLifecycle(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server := newServer(t)
+	prefectClient := newClient(t, server, server.AccountID())
+	workspace := newWorkspace(t, prefectClient)
+
+	workPools, err := prefectClient.WorkPools(uuid.Nil, workspace.ID)
+	require.NoError(t, err)
+
+	created, err := workPools.Create(ctx, api.WorkPoolCreate{Name: "pool", Type: "kubernetes"})
+	require.NoError(t, err)
+	assert.Equal(t, "pool", created.Name)
+	assert.NotEqual(t, uuid.Nil, created.DefaultQueueID)
+	assert.NotEmpty(t, created.BaseJobTemplate)
+
+	_, err = workPools.Create(ctx, api.WorkPoolCreate{Name: "pool", Type: "kubernetes"})
+	require.ErrorIs(t, err, api.ErrConflict)
+
+	paused := true
+	err = workPools.Update(ctx, "pool", api.WorkPoolUpdate{IsPaused: &paused})
+	require.NoError(t, err)
+
+	fetched, err := workPools.Get(ctx, "pool")
+	require.NoError(t, err)
+	assert.True(t, fetched.IsPaused)
+
+	workQueues, err := prefectClient.WorkQueues(uuid.Nil, workspace.ID, "pool")
+	require.NoError(t, err)
+
+	queues, err := workQueues.List(ctx, api.WorkQueueFilter{})
+	require.NoError(t, err)
+	require.Len(t, queues, 1)
+	assert.Equal(t, "default", queues[0].Name)
+
+	err = workPools.Delete(ctx, "pool")
+	require.NoError(t, err)
+
+	pools, err := workPools.List(ctx, api.WorkPoolFilter{})
+	require.NoError(t, err)
+	assert.Empty(t, pools)
+}
+
+func TestServerSelfHostedLayout(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server := newServer(t)
+	prefectClient := newClient(t, server, uuid.Nil)
+
+	variables, err := prefectClient.Variables(uuid.Nil, uuid.Nil)
+	require.NoError(t, err)
+
+	created, err := variables.Create(ctx, api.VariableCreate{Name: "greeting", Value: "hello"})
+	require.NoError(t, err)
+
+	fetched, err := variables.GetByName(ctx, "greeting")
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, fetched.ID)
+	assert.Equal(t, "hello", fetched.Value)
+}
+
+func TestServerPaginatesVariables(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server := newServer(t)
+	prefectClient := newClient(t, server, uuid.Nil)
+
+	variables, err := prefectClient.Variables(uuid.Nil, uuid.Nil)
+	require.NoError(t, err)
+
+	for i := range 450 {
+		_, err := variables.Create(ctx, api.VariableCreate{Name: fmt.Sprintf("variable-%03d", i), Value: i})
+		require.NoError(t, err)
+	}
+
+	all, err := variables.List(ctx, api.VariableFilter{})
+	require.NoError(t, err)
+	require.Len(t, all, 450)
+
+	window, err := api.Collect(variables.Iterate(ctx, api.VariableFilter{}, api.ListOptions{Offset: 195, Limit: 10}))
+	require.NoError(t, err)
+	require.Len(t, window, 10)
+	assert.Equal(t, "variable-195", window[0].Name)
+	assert.Equal(t, "variable-204", window[9].Name)
+}
+
+func TestServerListsDeploymentsByFlow(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server := newServer(t)
+	prefectClient := newClient(t, server, uuid.Nil)
+
+	flows, err := prefectClient.Flows(uuid.Nil, uuid.Nil)
+	require.NoError(t, err)
+
+	deployments, err := prefectClient.Deployments(uuid.Nil, uuid.Nil)
+	require.NoError(t, err)
+
+	flowIDs := make([]uuid.UUID, 0, 2)
+	for _, name := range []string{"etl", "reports"} {
+		flow, err := flows.Create(ctx, api.FlowCreate{Name: name})
+		require.NoError(t, err)
+
+		flowIDs = append(flowIDs, flow.ID)
+
+		for _, suffix := range []string{"daily", "hourly"} {
+			_, err := deployments.Create(ctx, api.DeploymentCreate{Name: name + "-" + suffix, FlowID: flow.ID})
+			require.NoError(t, err)
+		}
+	}
+
+	all, err := deployments.List(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, all, 4)
+
+	reports, err := deployments.List(ctx, flowIDs[1:])
+	require.NoError(t, err)
+	require.Len(t, reports, 2)
+	assert.Equal(t, "reports-daily", reports[0].Name)
+	assert.Equal(t, "reports-hourly", reports[1].Name)
+}
+
+func TestServerDetection(t *testing.T) {
+	t.Parallel()
+
+	server := newServer(t)
+
+	tests := []struct {
+		name      string
+		accountID uuid.UUID
+		want      api.ServerInfo
+	}{
+		{
+			name:      "Prefect Cloud layout",
+			accountID: server.AccountID(),
+			want:      api.ServerInfo{Flavor: api.ServerFlavorCloud, PlanType: "ENTERPRISE"},
+		},
+		{
+			name:      "self-hosted layout",
+			accountID: uuid.Nil,
+			want:      api.ServerInfo{Flavor: api.ServerFlavorOSS, Version: fakeserver.Version},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			info, err := newClient(t, server, tt.accountID).DetectServer(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, info)
+		})
+	}
+}
+
+func TestServerBlockDocumentReadDelay(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server := newServer(t, fakeserver.WithBlockDocumentReadDelay(1))
+	prefectClient := newClient(t, server, server.AccountID())
+	workspace := newWorkspace(t, prefectClient)
+
+	blockTypes, err := prefectClient.BlockTypes(uuid.Nil, workspace.ID)
+	require.NoError(t, err)
+
+	blockType, err := blockTypes.GetBySlug(ctx, "secret")
+	require.NoError(t, err)
+
+	blockSchemas, err := prefectClient.BlockSchemas(uuid.Nil, workspace.ID)
+	require.NoError(t, err)
+
+	schemas, err := blockSchemas.List(ctx, []uuid.UUID{blockType.ID})
+	require.NoError(t, err)
+	require.Len(t, schemas, 1)
+
+	blockDocuments, err := prefectClient.BlockDocuments(uuid.Nil, workspace.ID)
+	require.NoError(t, err)
+
+	created, err := blockDocuments.Create(ctx, api.BlockDocumentCreate{
+		Name:          "token",
+		Data:          map[string]interface{}{"value": "hunter2"},
+		BlockSchemaID: schemas[0].ID,
+		BlockTypeID:   blockType.ID,
+	})
+	require.NoError(t, err)
+
+	// The first read returns a 404, which the client retries.
+	fetched, err := blockDocuments.Get(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "hunter2", fetched.Data["value"])
+}
+
+func TestServerUnknownObjectReturnsNotFound(t *testing.T) {
+	t.Parallel()
+
+	server := newServer(t)
+
+	url := server.URL() + "/api/variables/" + uuid.NewString()
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, url, http.NoBody)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer "+testAPIKey)
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
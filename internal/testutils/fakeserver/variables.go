@@ -0,0 +1,150 @@
+package fakeserver
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+)
+
+func (s *Server) variableRoutes() {
+	s.handleWorkspace("POST /variables/{$}", s.createVariable)
+	s.handleWorkspace("POST /variables/filter", s.listVariables)
+	s.handleWorkspace("GET /variables/{id}", s.getVariable)
+	s.handleWorkspace("GET /variables/name/{name}", s.getVariableByName)
+	s.handleWorkspace("PATCH /variables/{id}", s.updateVariable)
+	s.handleWorkspace("DELETE /variables/{id}", s.deleteVariable)
+}
+
+func (s *Server) createVariable(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	var payload api.VariableCreate
+	if !decodeBody(w, r, &payload) {
+		return
+	}
+
+	if !requireField(w, payload.Name, "name") {
+		return
+	}
+
+	if _, ok := variableByName(ws, payload.Name); ok {
+		writeError(w, http.StatusConflict, "A variable with the name "+payload.Name+" already exists.")
+
+		return
+	}
+
+	variable := &api.Variable{
+		BaseModel: newBaseModel(),
+		Name:      payload.Name,
+		Value:     payload.Value,
+		Tags:      nonNilStrings(payload.Tags),
+	}
+	ws.variables[variable.ID] = variable
+
+	writeJSON(w, http.StatusCreated, variable)
+}
+
+func variableByName(ws *workspaceState, name string) (*api.Variable, bool) {
+	for _, variable := range ws.variables {
+		if variable.Name == name {
+			return variable, true
+		}
+	}
+
+	return nil, false
+}
+
+func (s *Server) listVariables(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	var filter api.VariableFilterSettings
+	page, ok := decodeFilter(w, r, &filter)
+	if !ok {
+		return
+	}
+
+	variables := []*api.Variable{}
+	for _, variable := range ws.variables {
+		if filter.Variables != nil && filter.Variables.Name != nil && filter.Variables.Name.Any != "" && filter.Variables.Name.Any != variable.Name {
+			continue
+		}
+
+		variables = append(variables, variable)
+	}
+
+	sort.Slice(variables, func(i, j int) bool { return variables[i].Name < variables[j].Name })
+
+	writeJSON(w, http.StatusOK, paginate(variables, page))
+}
+
+func findVariable(w http.ResponseWriter, r *http.Request, ws *workspaceState) (*api.Variable, bool) {
+	id, ok := pathUUID(w, r, "id")
+	if !ok {
+		return nil, false
+	}
+
+	variable, ok := ws.variables[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Variable not found.")
+
+		return nil, false
+	}
+
+	return variable, true
+}
+
+func (s *Server) getVariable(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	variable, ok := findVariable(w, r, ws)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, variable)
+}
+
+func (s *Server) getVariableByName(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	variable, ok := variableByName(ws, r.PathValue("name"))
+	if !ok {
+		writeError(w, http.StatusNotFound, "Variable not found.")
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, variable)
+}
+
+func (s *Server) updateVariable(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	variable, ok := findVariable(w, r, ws)
+	if !ok {
+		return
+	}
+
+	var payload api.VariableUpdate
+	if !decodeBody(w, r, &payload) {
+		return
+	}
+
+	if payload.Name != "" && payload.Name != variable.Name {
+		if _, ok := variableByName(ws, payload.Name); ok {
+			writeError(w, http.StatusConflict, "A variable with the name "+payload.Name+" already exists.")
+
+			return
+		}
+
+		variable.Name = payload.Name
+	}
+
+	variable.Value = payload.Value
+	variable.Tags = nonNilStrings(payload.Tags)
+	touch(&variable.BaseModel)
+
+	writeNoContent(w)
+}
+
+func (s *Server) deleteVariable(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	variable, ok := findVariable(w, r, ws)
+	if !ok {
+		return
+	}
+
+	delete(ws.variables, variable.ID)
+
+	writeNoContent(w)
+}
@@ -0,0 +1,149 @@
+package fakeserver
+
+import (
+	"net/http"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+)
+
+func (s *Server) webhookRoutes() {
+	s.handleWorkspace("POST /webhooks/{$}", s.createWebhook)
+	s.handleWorkspace("GET /webhooks/{$}", s.listWebhooks)
+	s.handleWorkspace("POST /webhooks/filter", s.filterWebhooks)
+	s.handleWorkspace("GET /webhooks/{id}", s.getWebhook)
+	s.handleWorkspace("PUT /webhooks/{id}", s.updateWebhook)
+	s.handleWorkspace("DELETE /webhooks/{id}", s.deleteWebhook)
+}
+
+func (s *Server) createWebhook(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	var payload api.WebhookCreateRequest
+	if !decodeBody(w, r, &payload) {
+		return
+	}
+
+	if !requireField(w, payload.Name, "name") || !requireField(w, payload.Template, "template") {
+		return
+	}
+
+	if !s.webhookServiceAccountExists(w, payload.ServiceAccountID) {
+		return
+	}
+
+	webhook := &api.Webhook{
+		BaseModel:   newBaseModel(),
+		WebhookCore: payload.WebhookCore,
+		AccountID:   ws.accountID(),
+		WorkspaceID: ws.workspaceID(),
+		Slug:        strings.ReplaceAll(uuid.NewString(), "-", ""),
+	}
+	ws.webhooks[webhook.ID] = webhook
+
+	writeJSON(w, http.StatusCreated, webhook)
+}
+
+func (s *Server) webhookServiceAccountExists(w http.ResponseWriter, id *uuid.UUID) bool {
+	if id == nil {
+		return true
+	}
+
+	if _, ok := s.serviceAccounts[*id]; !ok {
+		writeError(w, http.StatusNotFound, "Service account not found.")
+
+		return false
+	}
+
+	return true
+}
+
+func (s *Server) listWebhooks(w http.ResponseWriter, _ *http.Request, ws *workspaceState) {
+	webhooks := make([]*api.Webhook, 0, len(ws.webhooks))
+	for _, webhook := range ws.webhooks {
+		webhooks = append(webhooks, webhook)
+	}
+
+	sort.Slice(webhooks, func(i, j int) bool { return webhooks[i].Name < webhooks[j].Name })
+
+	writeJSON(w, http.StatusOK, webhooks)
+}
+
+func (s *Server) filterWebhooks(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	var filter api.WebhookFilter
+
+	page, ok := decodeFilter(w, r, &filter)
+	if !ok {
+		return
+	}
+
+	webhooks := make([]*api.Webhook, 0, len(ws.webhooks))
+	for _, webhook := range ws.webhooks {
+		if len(filter.Webhooks.Name.Any) == 0 || slices.Contains(filter.Webhooks.Name.Any, webhook.Name) {
+			webhooks = append(webhooks, webhook)
+		}
+	}
+
+	sort.Slice(webhooks, func(i, j int) bool { return webhooks[i].Name < webhooks[j].Name })
+
+	writeJSON(w, http.StatusOK, paginate(webhooks, page))
+}
+
+func findWebhook(w http.ResponseWriter, r *http.Request, ws *workspaceState) (*api.Webhook, bool) {
+	id, ok := pathUUID(w, r, "id")
+	if !ok {
+		return nil, false
+	}
+
+	webhook, ok := ws.webhooks[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Webhook not found.")
+
+		return nil, false
+	}
+
+	return webhook, true
+}
+
+func (s *Server) getWebhook(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	webhook, ok := findWebhook(w, r, ws)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, webhook)
+}
+
+func (s *Server) updateWebhook(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	webhook, ok := findWebhook(w, r, ws)
+	if !ok {
+		return
+	}
+
+	var payload api.WebhookUpdateRequest
+	if !decodeBody(w, r, &payload) {
+		return
+	}
+
+	if !s.webhookServiceAccountExists(w, payload.ServiceAccountID) {
+		return
+	}
+
+	webhook.WebhookCore = payload.WebhookCore
+	touch(&webhook.BaseModel)
+
+	writeNoContent(w)
+}
+
+func (s *Server) deleteWebhook(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	webhook, ok := findWebhook(w, r, ws)
+	if !ok {
+		return
+	}
+
+	delete(ws.webhooks, webhook.ID)
+
+	writeNoContent(w)
+}
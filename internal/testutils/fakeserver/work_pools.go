@@ -0,0 +1,363 @@
+package fakeserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"slices"
+	"sort"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+)
+
+// workPool wraps a work pool with the objects nested underneath it.
+type workPool struct {
+	*api.WorkPool
+
+	access api.WorkPoolAccessControl
+	queues map[string]*api.WorkQueue
+}
+
+func (s *Server) workPoolRoutes() {
+	s.handleWorkspace("POST /work_pools/{$}", s.createWorkPool)
+	s.handleWorkspace("POST /work_pools/filter", s.listWorkPools)
+	s.handleWorkspace("GET /work_pools/{name}", s.getWorkPool)
+	s.handleWorkspace("PATCH /work_pools/{name}", s.updateWorkPool)
+	s.handleWorkspace("DELETE /work_pools/{name}", s.deleteWorkPool)
+
+	s.handleWorkspace("GET /work_pools/{name}/access", s.getWorkPoolAccess)
+	s.handleWorkspace("PUT /work_pools/{name}/access", s.setWorkPoolAccess)
+
+	s.handleWorkspace("POST /work_pools/{name}/queues/{$}", s.createWorkQueue)
+	s.handleWorkspace("POST /work_pools/{name}/queues/filter", s.listWorkQueues)
+	s.handleWorkspace("GET /work_pools/{name}/queues/{queue_name}", s.getWorkQueue)
+	s.handleWorkspace("PATCH /work_pools/{name}/queues/{queue_name}", s.updateWorkQueue)
+	s.handleWorkspace("DELETE /work_pools/{name}/queues/{queue_name}", s.deleteWorkQueue)
+}
+
+func (s *Server) createWorkPool(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	var payload api.WorkPoolCreate
+	if !decodeBody(w, r, &payload) {
+		return
+	}
+
+	if !requireField(w, payload.Name, "name") {
+		return
+	}
+
+	if _, ok := ws.workPools[payload.Name]; ok {
+		writeError(w, http.StatusConflict, "A work pool with this name already exists.")
+
+		return
+	}
+
+	pool := &workPool{
+		WorkPool: &api.WorkPool{
+			BaseModel:        newBaseModel(),
+			Name:             payload.Name,
+			Description:      payload.Description,
+			Type:             payload.Type,
+			IsPaused:         payload.IsPaused,
+			ConcurrencyLimit: payload.ConcurrencyLimit,
+		},
+		access: api.WorkPoolAccessControl{
+			ManageActors: []api.ObjectActorAccess{},
+			RunActors:    []api.ObjectActorAccess{},
+			ViewActors:   []api.ObjectActorAccess{},
+		},
+		queues: map[string]*api.WorkQueue{},
+	}
+
+	if pool.Type == "" {
+		pool.Type = "prefect-agent"
+	}
+
+	if payload.BaseJobTemplate != nil {
+		pool.BaseJobTemplate = *payload.BaseJobTemplate
+	} else {
+		pool.BaseJobTemplate = map[string]interface{}{}
+		_ = json.Unmarshal(defaultBaseJobTemplate(), &pool.BaseJobTemplate)
+	}
+
+	// Every work pool is created with a default queue.
+	defaultQueue := &api.WorkQueue{
+		BaseModel:    newBaseModel(),
+		Name:         "default",
+		WorkPoolName: pool.Name,
+		Priority:     ptr(int64(1)),
+	}
+	defaultQueue.QueueID = defaultQueue.ID
+	pool.queues[defaultQueue.Name] = defaultQueue
+	pool.DefaultQueueID = defaultQueue.ID
+
+	ws.workPools[pool.Name] = pool
+
+	writeJSON(w, http.StatusCreated, pool.WorkPool)
+}
+
+func (s *Server) listWorkPools(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	var filter api.WorkPoolFilter
+	page, ok := decodeFilter(w, r, &filter)
+	if !ok {
+		return
+	}
+
+	pools := []*api.WorkPool{}
+	for _, pool := range ws.workPools {
+		if len(filter.Any) == 0 || slices.Contains(filter.Any, pool.ID) {
+			pools = append(pools, pool.WorkPool)
+		}
+	}
+
+	sort.Slice(pools, func(i, j int) bool { return pools[i].Name < pools[j].Name })
+
+	writeJSON(w, http.StatusOK, paginate(pools, page))
+}
+
+func findWorkPool(w http.ResponseWriter, r *http.Request, ws *workspaceState) (*workPool, bool) {
+	name := r.PathValue("name")
+
+	pool, ok := ws.workPools[name]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Work pool \""+name+"\" not found.")
+
+		return nil, false
+	}
+
+	return pool, true
+}
+
+func (s *Server) getWorkPool(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	pool, ok := findWorkPool(w, r, ws)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, pool.WorkPool)
+}
+
+func (s *Server) updateWorkPool(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	pool, ok := findWorkPool(w, r, ws)
+	if !ok {
+		return
+	}
+
+	var payload api.WorkPoolUpdate
+	if !decodeBody(w, r, &payload) {
+		return
+	}
+
+	pool.Description = payload.Description
+	pool.ConcurrencyLimit = payload.ConcurrencyLimit
+
+	if payload.IsPaused != nil {
+		pool.IsPaused = *payload.IsPaused
+	}
+
+	if payload.BaseJobTemplate != nil {
+		pool.BaseJobTemplate = *payload.BaseJobTemplate
+	}
+
+	touch(&pool.BaseModel)
+
+	writeNoContent(w)
+}
+
+func (s *Server) deleteWorkPool(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	pool, ok := findWorkPool(w, r, ws)
+	if !ok {
+		return
+	}
+
+	delete(ws.workPools, pool.Name)
+
+	writeNoContent(w)
+}
+
+func (s *Server) getWorkPoolAccess(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	pool, ok := findWorkPool(w, r, ws)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, pool.access)
+}
+
+func (s *Server) setWorkPoolAccess(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	pool, ok := findWorkPool(w, r, ws)
+	if !ok {
+		return
+	}
+
+	var payload api.WorkPoolAccessSet
+	if !decodeBody(w, r, &payload) {
+		return
+	}
+
+	control := payload.AccessControl
+
+	manageActors, ok := s.resolveActorAccess(w, append(slices.Clone(control.ManageActorIDs), control.ManageTeamIDs...))
+	if !ok {
+		return
+	}
+
+	runActors, ok := s.resolveActorAccess(w, append(slices.Clone(control.RunActorIDs), control.RunTeamIDs...))
+	if !ok {
+		return
+	}
+
+	viewActors, ok := s.resolveActorAccess(w, append(slices.Clone(control.ViewActorIDs), control.ViewTeamIDs...))
+	if !ok {
+		return
+	}
+
+	pool.access = api.WorkPoolAccessControl{
+		ManageActors: manageActors,
+		RunActors:    runActors,
+		ViewActors:   viewActors,
+	}
+
+	writeNoContent(w)
+}
+
+func (s *Server) createWorkQueue(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	pool, ok := findWorkPool(w, r, ws)
+	if !ok {
+		return
+	}
+
+	var payload api.WorkQueueCreate
+	if !decodeBody(w, r, &payload) {
+		return
+	}
+
+	if !requireField(w, payload.Name, "name") {
+		return
+	}
+
+	if _, ok := pool.queues[payload.Name]; ok {
+		writeError(w, http.StatusConflict, "A work queue with this name already exists.")
+
+		return
+	}
+
+	queue := &api.WorkQueue{
+		BaseModel:        newBaseModel(),
+		Name:             payload.Name,
+		WorkPoolName:     pool.Name,
+		Description:      payload.Description,
+		ConcurrencyLimit: payload.ConcurrencyLimit,
+		Priority:         payload.Priority,
+	}
+	queue.QueueID = queue.ID
+
+	if payload.IsPaused != nil {
+		queue.IsPaused = *payload.IsPaused
+	}
+
+	if queue.Priority == nil {
+		queue.Priority = ptr(int64(len(pool.queues) + 1))
+	}
+
+	pool.queues[queue.Name] = queue
+
+	writeJSON(w, http.StatusCreated, queue)
+}
+
+func (s *Server) listWorkQueues(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	pool, ok := findWorkPool(w, r, ws)
+	if !ok {
+		return
+	}
+
+	var filter api.WorkQueueFilter
+	page, ok := decodeFilter(w, r, &filter)
+	if !ok {
+		return
+	}
+
+	queues := []*api.WorkQueue{}
+	for _, queue := range pool.queues {
+		if len(filter.Any) == 0 || slices.Contains(filter.Any, queue.ID) {
+			queues = append(queues, queue)
+		}
+	}
+
+	sort.Slice(queues, func(i, j int) bool { return queues[i].Name < queues[j].Name })
+
+	writeJSON(w, http.StatusOK, paginate(queues, page))
+}
+
+func findWorkQueue(w http.ResponseWriter, r *http.Request, ws *workspaceState) (*workPool, *api.WorkQueue, bool) {
+	pool, ok := findWorkPool(w, r, ws)
+	if !ok {
+		return nil, nil, false
+	}
+
+	name := r.PathValue("queue_name")
+
+	queue, ok := pool.queues[name]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Work queue \""+name+"\" not found.")
+
+		return nil, nil, false
+	}
+
+	return pool, queue, true
+}
+
+func (s *Server) getWorkQueue(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	_, queue, ok := findWorkQueue(w, r, ws)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, queue)
+}
+
+func (s *Server) updateWorkQueue(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	_, queue, ok := findWorkQueue(w, r, ws)
+	if !ok {
+		return
+	}
+
+	var payload api.WorkQueueUpdate
+	if !decodeBody(w, r, &payload) {
+		return
+	}
+
+	queue.Description = payload.Description
+	queue.ConcurrencyLimit = payload.ConcurrencyLimit
+
+	if payload.IsPaused != nil {
+		queue.IsPaused = *payload.IsPaused
+	}
+
+	if payload.Priority != nil {
+		queue.Priority = payload.Priority
+	}
+
+	touch(&queue.BaseModel)
+
+	writeNoContent(w)
+}
+
+func (s *Server) deleteWorkQueue(w http.ResponseWriter, r *http.Request, ws *workspaceState) {
+	pool, queue, ok := findWorkQueue(w, r, ws)
+	if !ok {
+		return
+	}
+
+	if queue.ID == pool.DefaultQueueID {
+		writeError(w, http.StatusBadRequest, "Can't delete a pool's default queue.")
+
+		return
+	}
+
+	delete(pool.queues, queue.Name)
+
+	writeNoContent(w)
+}
+
+func ptr[T any](value T) *T {
+	return &value
+}
@@ -0,0 +1,401 @@
+package fakeserver
+
+import (
+	"net/http"
+	"slices"
+	"sort"
+
+	"github.com/google/uuid"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+)
+
+// workspaceState holds every workspace-scoped object.
+type workspaceState struct {
+	workspace *api.Workspace
+
+	access map[uuid.UUID]*api.WorkspaceAccess
+
+	automations              map[uuid.UUID]*api.Automation
+	blockDocuments           map[uuid.UUID]*blockDocument
+	deployments              map[uuid.UUID]*deployment
+	flows                    map[uuid.UUID]*api.Flow
+	globalConcurrencyLimits  map[uuid.UUID]*api.GlobalConcurrencyLimit
+	slas                     map[string]map[string]*api.SLA
+	taskRunConcurrencyLimits map[uuid.UUID]*api.TaskRunConcurrencyLimit
+	variables                map[uuid.UUID]*api.Variable
+	webhooks                 map[uuid.UUID]*api.Webhook
+	workPools                map[string]*workPool
+}
+
+func newWorkspaceState() *workspaceState {
+	return &workspaceState{
+		access:                   map[uuid.UUID]*api.WorkspaceAccess{},
+		automations:              map[uuid.UUID]*api.Automation{},
+		blockDocuments:           map[uuid.UUID]*blockDocument{},
+		deployments:              map[uuid.UUID]*deployment{},
+		flows:                    map[uuid.UUID]*api.Flow{},
+		globalConcurrencyLimits:  map[uuid.UUID]*api.GlobalConcurrencyLimit{},
+		slas:                     map[string]map[string]*api.SLA{},
+		taskRunConcurrencyLimits: map[uuid.UUID]*api.TaskRunConcurrencyLimit{},
+		variables:                map[uuid.UUID]*api.Variable{},
+		webhooks:                 map[uuid.UUID]*api.Webhook{},
+		workPools:                map[string]*workPool{},
+	}
+}
+
+// accountID returns the account that owns the workspace, if any.
+func (ws *workspaceState) accountID() uuid.UUID {
+	if ws.workspace == nil {
+		return uuid.Nil
+	}
+
+	return ws.workspace.AccountID
+}
+
+// workspaceID returns the ID of the workspace, if any.
+func (ws *workspaceState) workspaceID() uuid.UUID {
+	if ws.workspace == nil {
+		return uuid.Nil
+	}
+
+	return ws.workspace.ID
+}
+
+func (s *Server) workspaceRoutes() {
+	s.handleAccount("POST /workspaces/{$}", s.createWorkspace)
+	s.handleAccount("POST /workspaces/filter", s.listWorkspaces)
+	s.handleAccount("GET /workspaces/{workspace_id}", s.getWorkspace)
+	s.handleAccount("PATCH /workspaces/{workspace_id}", s.updateWorkspace)
+	s.handleAccount("DELETE /workspaces/{workspace_id}", s.deleteWorkspace)
+
+	s.handleAccount("POST /workspaces/{workspace_id}/user_access/{$}", s.upsertWorkspaceAccess(api.UserAccessor))
+	s.handleAccount("GET /workspaces/{workspace_id}/user_access/{id}", s.getWorkspaceAccess)
+	s.handleAccount("DELETE /workspaces/{workspace_id}/user_access/{id}", s.deleteWorkspaceAccess)
+	s.handleAccount("POST /workspaces/{workspace_id}/bot_access/{$}", s.upsertWorkspaceAccess(api.ServiceAccountAccessor))
+	s.handleAccount("GET /workspaces/{workspace_id}/bot_access/{id}", s.getWorkspaceAccess)
+	s.handleAccount("DELETE /workspaces/{workspace_id}/bot_access/{id}", s.deleteWorkspaceAccess)
+	s.handleAccount("PUT /workspaces/{workspace_id}/team_access/{$}", s.upsertWorkspaceAccess(api.TeamAccessor))
+	s.handleAccount("POST /workspaces/{workspace_id}/team_access/filter", s.listWorkspaceTeamAccess)
+	s.handleAccount("DELETE /workspaces/{workspace_id}/team_access/{team_id}", s.deleteWorkspaceTeamAccess)
+}
+
+func (s *Server) createWorkspace(w http.ResponseWriter, r *http.Request) {
+	var payload api.WorkspaceCreate
+	if !decodeBody(w, r, &payload) {
+		return
+	}
+
+	if !requireField(w, payload.Name, "name") || !requireField(w, payload.Handle, "handle") {
+		return
+	}
+
+	for _, existing := range s.workspaces {
+		if existing.workspace.Handle == payload.Handle {
+			writeError(w, http.StatusConflict, "A workspace with this handle already exists.")
+
+			return
+		}
+	}
+
+	ws := newWorkspaceState()
+	ws.workspace = &api.Workspace{
+		BaseModel:              newBaseModel(),
+		AccountID:              s.account.ID,
+		Name:                   payload.Name,
+		Description:            payload.Description,
+		Handle:                 payload.Handle,
+		DefaultWorkspaceRoleID: s.workspaceRoleByName("Viewer").ID,
+	}
+	s.workspaces[ws.workspace.ID] = ws
+
+	writeJSON(w, http.StatusCreated, ws.workspace)
+}
+
+func (s *Server) workspaceRoleByName(name string) *api.WorkspaceRole {
+	for _, role := range s.workspaceRoles {
+		if role.Name == name && role.AccountID == nil {
+			return role
+		}
+	}
+
+	return nil
+}
+
+func (s *Server) listWorkspaces(w http.ResponseWriter, r *http.Request) {
+	var filter api.WorkspaceFilter
+	page, ok := decodeFilter(w, r, &filter)
+	if !ok {
+		return
+	}
+
+	handles := filter.Workspaces.Handle.Any
+
+	workspaces := []*api.Workspace{}
+	for _, ws := range s.workspaces {
+		if len(handles) == 0 || slices.Contains(handles, ws.workspace.Handle) {
+			workspaces = append(workspaces, ws.workspace)
+		}
+	}
+
+	sort.Slice(workspaces, func(i, j int) bool { return workspaces[i].Handle < workspaces[j].Handle })
+
+	writeJSON(w, http.StatusOK, paginate(workspaces, page))
+}
+
+func (s *Server) findWorkspace(w http.ResponseWriter, r *http.Request) (*workspaceState, bool) {
+	ws, ok := s.lookupWorkspace(r.PathValue("workspace_id"))
+	if !ok {
+		writeError(w, http.StatusNotFound, "Workspace not found.")
+
+		return nil, false
+	}
+
+	return ws, true
+}
+
+func (s *Server) getWorkspace(w http.ResponseWriter, r *http.Request) {
+	ws, ok := s.findWorkspace(w, r)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, ws.workspace)
+}
+
+func (s *Server) updateWorkspace(w http.ResponseWriter, r *http.Request) {
+	ws, ok := s.findWorkspace(w, r)
+	if !ok {
+		return
+	}
+
+	var payload api.WorkspaceUpdate
+	if !decodeBody(w, r, &payload) {
+		return
+	}
+
+	if payload.Name != nil {
+		ws.workspace.Name = *payload.Name
+	}
+
+	if payload.Description != nil {
+		ws.workspace.Description = payload.Description
+	}
+
+	if payload.Handle != nil {
+		ws.workspace.Handle = *payload.Handle
+	}
+
+	if payload.DefaultWorkspaceRoleID != nil {
+		if _, ok := s.workspaceRoles[*payload.DefaultWorkspaceRoleID]; !ok {
+			writeError(w, http.StatusNotFound, "Workspace role not found.")
+
+			return
+		}
+
+		ws.workspace.DefaultWorkspaceRoleID = *payload.DefaultWorkspaceRoleID
+	}
+
+	touch(&ws.workspace.BaseModel)
+
+	writeNoContent(w)
+}
+
+func (s *Server) deleteWorkspace(w http.ResponseWriter, r *http.Request) {
+	ws, ok := s.findWorkspace(w, r)
+	if !ok {
+		return
+	}
+
+	// Deleting a workspace deletes everything inside of it.
+	delete(s.workspaces, ws.workspace.ID)
+
+	writeNoContent(w)
+}
+
+// upsertWorkspaceAccess handles the user, bot, and team access upsert routes,
+// which take a list of access payloads and return the resulting access objects.
+func (s *Server) upsertWorkspaceAccess(accessorType api.AccessActorType) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ws, ok := s.findWorkspace(w, r)
+		if !ok {
+			return
+		}
+
+		var payloads []api.WorkspaceAccessUpsert
+		if !decodeBody(w, r, &payloads) {
+			return
+		}
+
+		accesses := []*api.WorkspaceAccess{}
+		for _, payload := range payloads {
+			if _, ok := s.workspaceRoles[payload.WorkspaceRoleID]; !ok {
+				writeError(w, http.StatusNotFound, "Workspace role not found.")
+
+				return
+			}
+
+			access, ok := s.newWorkspaceAccess(ws, accessorType, payload)
+			if !ok {
+				writeError(w, http.StatusNotFound, "Accessor not found.")
+
+				return
+			}
+
+			// An accessor can only have a single access object in a workspace,
+			// so an upsert for an existing accessor updates it in place.
+			for _, existing := range ws.access {
+				if sameAccessor(existing, access) {
+					access.BaseModel = existing.BaseModel
+				}
+			}
+
+			touch(&access.BaseModel)
+			ws.access[access.ID] = access
+			accesses = append(accesses, access)
+		}
+
+		writeJSON(w, http.StatusOK, accesses)
+	}
+}
+
+func (s *Server) newWorkspaceAccess(ws *workspaceState, accessorType api.AccessActorType, payload api.WorkspaceAccessUpsert) (*api.WorkspaceAccess, bool) {
+	access := &api.WorkspaceAccess{
+		BaseModel:       newBaseModel(),
+		WorkspaceID:     ws.workspace.ID,
+		WorkspaceRoleID: payload.WorkspaceRoleID,
+	}
+
+	switch accessorType {
+	case api.UserAccessor:
+		if payload.UserID == nil {
+			return nil, false
+		}
+
+		user, ok := s.users[*payload.UserID]
+		if !ok {
+			return nil, false
+		}
+
+		actorID := uuid.MustParse(user.ActorID)
+		access.UserID = &user.ID
+		access.ActorID = &actorID
+	case api.ServiceAccountAccessor:
+		if payload.BotID == nil {
+			return nil, false
+		}
+
+		serviceAccount, ok := s.serviceAccounts[*payload.BotID]
+		if !ok {
+			return nil, false
+		}
+
+		access.BotID = &serviceAccount.ID
+		access.ActorID = &serviceAccount.ActorID
+	case api.TeamAccessor:
+		if payload.TeamID == nil {
+			return nil, false
+		}
+
+		team, ok := s.teams[*payload.TeamID]
+		if !ok {
+			return nil, false
+		}
+
+		access.TeamID = &team.ID
+	case api.AllAccessors:
+		return nil, false
+	}
+
+	return access, true
+}
+
+func sameAccessor(a, b *api.WorkspaceAccess) bool {
+	equal := func(x, y *uuid.UUID) bool { return x != nil && y != nil && *x == *y }
+
+	return equal(a.UserID, b.UserID) || equal(a.BotID, b.BotID) || equal(a.TeamID, b.TeamID)
+}
+
+func (s *Server) getWorkspaceAccess(w http.ResponseWriter, r *http.Request) {
+	ws, ok := s.findWorkspace(w, r)
+	if !ok {
+		return
+	}
+
+	id, ok := pathUUID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	access, ok := ws.access[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Workspace access not found.")
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, access)
+}
+
+func (s *Server) deleteWorkspaceAccess(w http.ResponseWriter, r *http.Request) {
+	ws, ok := s.findWorkspace(w, r)
+	if !ok {
+		return
+	}
+
+	id, ok := pathUUID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	if _, ok := ws.access[id]; !ok {
+		writeError(w, http.StatusNotFound, "Workspace access not found.")
+
+		return
+	}
+
+	delete(ws.access, id)
+
+	writeNoContent(w)
+}
+
+func (s *Server) listWorkspaceTeamAccess(w http.ResponseWriter, r *http.Request) {
+	ws, ok := s.findWorkspace(w, r)
+	if !ok {
+		return
+	}
+
+	accesses := []*api.WorkspaceAccess{}
+	for _, access := range ws.access {
+		if access.TeamID != nil {
+			accesses = append(accesses, access)
+		}
+	}
+
+	sort.Slice(accesses, func(i, j int) bool { return accesses[i].ID.String() < accesses[j].ID.String() })
+
+	writeJSON(w, http.StatusOK, accesses)
+}
+
+func (s *Server) deleteWorkspaceTeamAccess(w http.ResponseWriter, r *http.Request) {
+	ws, ok := s.findWorkspace(w, r)
+	if !ok {
+		return
+	}
+
+	teamID, ok := pathUUID(w, r, "team_id")
+	if !ok {
+		return
+	}
+
+	for id, access := range ws.access {
+		if access.TeamID != nil && *access.TeamID == teamID {
+			delete(ws.access, id)
+			writeNoContent(w)
+
+			return
+		}
+	}
+
+	writeError(w, http.StatusNotFound, "Workspace access not found.")
+}
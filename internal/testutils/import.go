@@ -2,6 +2,7 @@ package testutils
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -110,3 +111,25 @@ func GetResourceWorkspaceImportStateIDByName(resourceName string) resource.Impor
 		return fmt.Sprintf("%s,%s", fetchedResourceID, workspaceID), nil
 	}
 }
+
+// GetResourceUIURLImportStateID returns the import state ID for a resource
+// as a link to it in the Prefect Cloud UI, such as
+// https://app.prefect.cloud/account/<id>/workspace/<id>/deployments/deployment/<id>,
+// for use in import tests. The object path is the path of the resource in
+// the UI, such as "deployments/deployment".
+func GetResourceUIURLImportStateID(resourceName, objectPath string) resource.ImportStateIdFunc {
+	return func(state *terraform.State) (string, error) {
+		workspaceID, err := GetResourceWorkspaceIDFromState(state)
+		if err != nil {
+			return "", fmt.Errorf("unable to get workspaceID from state: %w", err)
+		}
+
+		fetchedResourceID, err := GetResourceIDFromState(state, resourceName)
+		if err != nil {
+			return "", fmt.Errorf("unable to get resource from state: %w", err)
+		}
+
+		return fmt.Sprintf("https://app.prefect.cloud/account/%s/workspace/%s/%s/%s",
+			os.Getenv("PREFECT_CLOUD_ACCOUNT_ID"), workspaceID, objectPath, fetchedResourceID), nil
+	}
+}
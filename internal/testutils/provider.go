@@ -11,10 +11,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/prefecthq/terraform-provider-prefect/internal/api"
 	"github.com/prefecthq/terraform-provider-prefect/internal/client"
 	prefectProvider "github.com/prefecthq/terraform-provider-prefect/internal/provider"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils/fakeserver"
 )
 
 const (
@@ -28,8 +30,22 @@ const (
 
 	// WorkspaceResourceName is the name of the workspace resource.
 	WorkspaceResourceName = "prefect_workspace.test"
+
+	// FakeServerEnvVar is the environment variable that, when set, runs the
+	// acceptance tests against an in-memory fake of the Prefect API instead
+	// of the API configured via PREFECT_API_URL.
+	FakeServerEnvVar = "PREFECT_TEST_FAKE_SERVER"
 )
 
+// init starts the fake Prefect API when FakeServerEnvVar is set. This happens
+// before any test runs, as test cases read the provider configuration
+// environment variables when they are built, before their PreCheck runs.
+func init() {
+	if os.Getenv(FakeServerEnvVar) != "" {
+		startFakeServer()
+	}
+}
+
 // TestAccProvider defines the actual Provider, which is used during acceptance testing.
 // This is the same Provider that is used by the CLI, and is used by
 // custom test functions, primarily to access the underlying HTTP client.
@@ -43,12 +59,21 @@ var TestAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServe
 	"prefect": providerserver.NewProtocol6WithError(TestAccProvider),
 }
 
+// TestAccProtoV6ProviderFactoriesWithEcho adds the echo provider to the
+// acceptance testing providers, so that tests can write the results of
+// ephemeral resources to state and check them.
+var TestAccProtoV6ProviderFactoriesWithEcho = map[string]func() (tfprotov6.ProviderServer, error){
+	"prefect": providerserver.NewProtocol6WithError(TestAccProvider),
+	"echo":    echoprovider.NewProviderServer(),
+}
+
 // AccTestPreCheck is a utility hook, which every test suite will call
 // in order to verify if the necessary provider configurations are passed
 // through the environment variables.
 // https://developer.hashicorp.com/terraform/plugin/testing/acceptance-tests/testcase#precheck
 func AccTestPreCheck(t *testing.T) {
 	t.Helper()
+
 	neededVars := []string{"PREFECT_API_URL", "PREFECT_API_KEY", "PREFECT_CLOUD_ACCOUNT_ID"}
 	for _, key := range neededVars {
 		if v := os.Getenv(key); v == "" {
@@ -57,6 +82,20 @@ func AccTestPreCheck(t *testing.T) {
 	}
 }
 
+// startFakeServer starts the fake Prefect API and points the provider
+// configuration environment variables at it. A single server is shared by
+// every test in the test binary, and lives until the binary exits.
+func startFakeServer() {
+	apiKey := "pnu_" + strings.ReplaceAll(uuid.NewString(), "-", "")
+
+	server := fakeserver.New(fakeserver.WithAPIKey(apiKey))
+	server.Start()
+
+	_ = os.Setenv("PREFECT_API_URL", server.URL()+"/api")
+	_ = os.Setenv("PREFECT_API_KEY", apiKey)
+	_ = os.Setenv("PREFECT_CLOUD_ACCOUNT_ID", server.AccountID().String())
+}
+
 // NewTestClient returns a new Prefect API client instance
 // to be used in acceptance tests.
 // The plugin-framework does not currently expose a way to extract
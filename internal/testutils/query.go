@@ -0,0 +1,32 @@
+package testutils
+
+import (
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var _ = config.Variable(&StateValueVariable{})
+
+// StateValueVariable is a configuration variable whose value is captured
+// from the state of an earlier test step. It lets configurations that cannot
+// reference resources, such as the list blocks of a query, use their values.
+type StateValueVariable struct {
+	value string
+}
+
+// Capture returns a check that stores the value of a resource attribute
+// in the variable.
+func (v *StateValueVariable) Capture(resourceName, attribute string) resource.TestCheckFunc {
+	return resource.TestCheckResourceAttrWith(resourceName, attribute, func(value string) error {
+		v.value = value
+
+		return nil
+	})
+}
+
+// MarshalJSON returns the captured value as a JSON string.
+func (v *StateValueVariable) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.value)
+}